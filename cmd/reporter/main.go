@@ -3,50 +3,115 @@ package main
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"log"
+	"net/url"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"runtime/debug"
+	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
+	"github.com/openshift-hyperfleet/status-reporter/pkg/callback"
 	"github.com/openshift-hyperfleet/status-reporter/pkg/config"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/email"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/fleet"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/nats"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/objectstore"
 	"github.com/openshift-hyperfleet/status-reporter/pkg/reporter"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/retry"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/watcher"
 )
 
 const (
-	shutdownTimeout = 5 * time.Second
+	shutdownTimeout  = 5 * time.Second
+	preflightTimeout = 30 * time.Second
+
+	// finalStatusMarker prefixes the FINAL_STATUS_LINE
+	finalStatusMarker = "STATUS_REPORTER_RESULT"
+
+	// resultArchiveMaxRetries is the number of additional attempts of each archive upload
+	resultArchiveMaxRetries = 3
 )
 
 func main() {
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
-	log.Println("Status Reporter starting...")
+
+	fromStdin := flag.Bool("from-stdin", false, "read a single adapter result from stdin, update the Job condition and exit (same as MODE=stdin)")
+	wrap := flag.Bool("wrap", false, "run the adapter command given after -- as a child process and report its outcome (same as MODE=wrap)")
+	flag.Parse()
+	if *fromStdin && *wrap {
+		log.Fatalf("--from-stdin and --wrap cannot be combined")
+	}
+	if mode := flagMode(*fromStdin, *wrap); mode != "" {
+		if err := os.Setenv(config.EnvMode, mode); err != nil {
+			log.Fatalf("Failed to set %s: %v", config.EnvMode, err)
+		}
+	}
 
 	cfg, err := config.Load()
 	if err != nil {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
 
-	logConfig(cfg)
+	final := &finalStatus{enabled: cfg.FinalStatusLine}
 
-	rep, err := reporter.NewReporter(
-		cfg.ResultsPath,
-		cfg.GetPollInterval(),
-		cfg.GetMaxWaitTime(),
-		cfg.ConditionType,
-		cfg.PodName,
-		cfg.AdapterContainerName,
-		cfg.JobName,
-		cfg.JobNamespace,
-	)
+	if !cfg.QuietStartup {
+		log.Println("Status Reporter starting...")
+		logConfig(cfg)
+	}
+
+	if cfg.ValidateOnly || cfg.StartupPreflight {
+		passed := reportPreflight(runPreflight(cfg))
+		if cfg.ValidateOnly {
+			if !passed {
+				final.exit(1, "PreflightFailed")
+			}
+			final.exit(0, "PreflightPassed")
+		}
+		if !passed {
+			log.Println("Warning: preflight checks failed; continuing startup")
+		}
+	}
+
+	if reportingDisabled(cfg.SkipSentinelPath) {
+		log.Printf("Skip sentinel %s present; reporting is disabled, exiting without updating the Job", cfg.SkipSentinelPath)
+		final.exit(0, "ReportingDisabled")
+	}
+
+	opts, err := reporterOptions(cfg)
+	if err != nil {
+		log.Printf("Failed to configure reporter: %v", err)
+		final.exit(1, "SetupFailed")
+	}
+	if final.enabled {
+		opts = append(opts, reporter.WithOutcomeHook(final.record))
+	}
+
+	if cfg.Mode == config.ModeWrap && flag.NArg() == 0 {
+		log.Printf("Wrap mode requires the adapter command as arguments, e.g. status-reporter --wrap -- /adapter --check")
+		final.exit(1, "SetupFailed")
+	}
+
+	run, rep, err := newRunner(cfg, opts)
 	if err != nil {
-		log.Fatalf("Failed to create reporter: %v", err)
+		log.Printf("Failed to create reporter: %v", err)
+		final.exit(1, "SetupFailed")
 	}
 
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGTERM, syscall.SIGINT)
-	defer signal.Stop(sigChan)
+	// In wrap mode signals are forwarded to the adapter instead, whose exit ends the run
+	var sigChan chan os.Signal
+	if cfg.Mode != config.ModeWrap {
+		sigChan = make(chan os.Signal, 1)
+		signal.Notify(sigChan, syscall.SIGTERM, syscall.SIGINT)
+		defer signal.Stop(sigChan)
+	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -57,14 +122,108 @@ func main() {
 		defer func() {
 			if r := recover(); r != nil {
 				log.Printf("PANIC in reporter: %v\nStack trace:\n%s", r, debug.Stack())
+				if cfg.ReportOnPanic && cfg.Mode != config.ModeNamespace {
+					reportPanic(cfg, rep, r)
+				}
 				done <- fmt.Errorf("reporter panicked: %v", r)
 			}
 		}()
-		done <- rep.Run(ctx)
+		done <- run(ctx)
 	}()
 
 	// Wait for completion or interruption and exit
-	os.Exit(waitForCompletion(sigChan, cancel, done))
+	code := waitForCompletion(sigChan, cancel, done)
+	if code != 0 {
+		final.exit(code, "ReporterError")
+	}
+	final.exit(code, "NoConditionReported")
+}
+
+// flagMode returns the mode selected by the command line flags, if any
+func flagMode(fromStdin, wrap bool) string {
+	switch {
+	case fromStdin:
+		return config.ModeStdin
+	case wrap:
+		return config.ModeWrap
+	}
+	return ""
+}
+
+// finalStatus emits the FINAL_STATUS_LINE marker as the last line before the process exits
+type finalStatus struct {
+	enabled bool
+	outcome atomic.Pointer[reporter.Outcome]
+}
+
+// record remembers the run outcome; it is called from the reporter goroutine
+func (f *finalStatus) record(outcome reporter.Outcome) {
+	f.outcome.Store(&outcome)
+}
+
+// exit writes the final status line when enabled and exits with the code. fallbackReason is
+// used when no condition was reported.
+func (f *finalStatus) exit(code int, fallbackReason string) {
+	if f.enabled {
+		_, _ = fmt.Fprintln(log.Writer(), finalStatusLine(code, f.outcome.Load(), fallbackReason))
+	}
+	os.Exit(code)
+}
+
+// finalStatusLine formats the stable, greppable terminal marker. The status follows the reported
+// condition (True is success, False is failure) or, without one, the exit code.
+func finalStatusLine(code int, outcome *reporter.Outcome, fallbackReason string) string {
+	status, reason := "success", fallbackReason
+	if code != 0 {
+		status = "failure"
+	}
+	if outcome != nil && outcome.Status != "" {
+		reason = outcome.Reason
+		switch outcome.Status {
+		case reporter.ConditionStatusTrue:
+			status = "success"
+		case reporter.ConditionStatusFalse:
+			status = "failure"
+		default:
+			status = "unknown"
+		}
+	}
+	return fmt.Sprintf("%s status=%s reason=%s exit=%d", finalStatusMarker, status, strings.Join(strings.Fields(reason), "_"), code)
+}
+
+// reportPanic makes a best-effort attempt to leave a terminal condition on the Job after the
+// reporter panicked, so watchers are not left waiting for a condition that will never come. A
+// terminal condition the run already wrote is left in place. The panic condition is written
+// without the run ID, so it is never skipped as a duplicate of the run's earlier updates.
+func reportPanic(cfg *config.Config, rep *reporter.StatusReporter, recovered any) {
+	if rep != nil && rep.TerminalConditionWritten() {
+		log.Printf("Terminal condition already written; not reporting the panic on the Job")
+		return
+	}
+	opts := append(k8sClientOptions(cfg), k8s.WithRunID(""))
+	client, err := k8s.NewClient(cfg.JobNamespace, cfg.JobName, opts...)
+	if err != nil {
+		log.Printf("Failed to report panic on the Job: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := client.UpdateJobStatus(ctx, panicCondition(cfg.ConditionType, recovered)); err != nil {
+		log.Printf("Failed to report panic on the Job: %v", err)
+		return
+	}
+	log.Printf("Job status updated: %s=False (reason: %s)", cfg.ConditionType, reporter.ReasonStatusReporterError)
+}
+
+// panicCondition builds the condition reported after an internal panic
+func panicCondition(conditionType string, recovered any) k8s.JobCondition {
+	return k8s.JobCondition{
+		Type:    conditionType,
+		Status:  reporter.ConditionStatusFalse,
+		Reason:  reporter.ReasonStatusReporterError,
+		Message: fmt.Sprintf("Status reporter failed with an internal panic: %v", recovered),
+	}
 }
 
 // waitForCompletion handles both normal completion and signal-driven shutdown.
@@ -119,6 +278,512 @@ func handleShutdown(sig os.Signal, cancel context.CancelFunc, done <-chan error)
 	}
 }
 
+// reportingDisabled reports whether the skip sentinel file exists.
+// Errors other than "not exist" are logged and treated as absent so a broken mount can't silently disable reporting.
+func reportingDisabled(sentinelPath string) bool {
+	if sentinelPath == "" {
+		return false
+	}
+
+	if _, err := os.Stat(sentinelPath); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Printf("Warning: failed to check skip sentinel path=%s: %v", sentinelPath, err)
+		}
+		return false
+	}
+	return true
+}
+
+// runPreflight runs the enabled preflight checks. Configuration has already been validated by
+// the time this runs; cluster checks only apply to the sidecar's own Job.
+func runPreflight(cfg *config.Config) []k8s.PreflightResult {
+	ctx, cancel := context.WithTimeout(context.Background(), preflightTimeout)
+	defer cancel()
+
+	results := []k8s.PreflightResult{{Name: "config"}}
+	// Without a shared volume, as with a result from stdin or a wrapped adapter, there is nothing to check
+	if cfg.Mode != config.ModeStdin && cfg.Mode != config.ModeWrap {
+		resultsPath := cfg.ResultsPath
+		if cfg.ResultsDir != "" {
+			// The check files are written directly into the results directory
+			resultsPath = filepath.Join(cfg.ResultsDir, "*")
+		}
+		results = append(results, k8s.PreflightResult{Name: "results volume", Err: checkResultsDir(resultsPath)})
+	}
+	// Without a Job, as for an Argo Workflow step reporting to its node, there is no Job to check
+	if cfg.Mode == config.ModeNamespace || cfg.JobName == "" {
+		return results
+	}
+
+	client, err := k8s.NewClient(cfg.JobNamespace, cfg.JobName, k8sClientOptions(cfg)...)
+	if err != nil {
+		return append(results, k8s.PreflightResult{Name: "kubernetes client", Err: err})
+	}
+	podName := cfg.PodName
+	if cfg.PodNameIsPrefix {
+		podName = ""
+	}
+	checkDeadline := cfg.ActiveDeadlineCheck != "" && cfg.ActiveDeadlineCheck != reporter.ActiveDeadlineCheckOff
+	return append(results, client.Preflight(ctx, podName, cfg.GetMaxWaitTime(), checkDeadline)...)
+}
+
+// checkResultsDir ensures the directory holding the result file is mounted
+func checkResultsDir(resultsPath string) error {
+	dir := filepath.Dir(resultsPath)
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("results directory %s is not accessible: %w", dir, err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("results path parent %s is not a directory", dir)
+	}
+	return nil
+}
+
+// reportPreflight logs a consolidated pass/fail report and returns whether every check passed
+func reportPreflight(results []k8s.PreflightResult) bool {
+	passed := true
+	log.Println("Preflight report:")
+	for _, r := range results {
+		if r.Err != nil {
+			passed = false
+			log.Printf("  [FAIL] %s: %v", r.Name, r.Err)
+			continue
+		}
+		log.Printf("  [PASS] %s", r.Name)
+	}
+	return passed
+}
+
+// newRunner creates the reporting loop for the configured mode: a single reporter for the
+// sidecar's own Job, a watcher that runs a reporter for each matching Job in the namespace, a
+// single report of the result read from stdin, or a report of the wrapped adapter command. The
+// reporter is returned too, except in namespace mode, where each Job's reporter and Kubernetes client
+// get options built for them instead of opts so that no sink client, outcome hook or audit log is
+// shared across Jobs.
+func newRunner(cfg *config.Config, opts []reporter.Option) (func(context.Context) error, *reporter.StatusReporter, error) {
+	if cfg.Mode == config.ModeNamespace {
+		clientset, err := k8s.NewInClusterClientset()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var recorder *k8s.EventRecorder
+		if cfg.EmitEvents {
+			recorder = k8s.NewEventRecorder(clientset)
+		}
+		w, err := watcher.New(clientset, watcher.Config{
+			Namespace:            cfg.JobNamespace,
+			LabelSelector:        cfg.JobLabelSelector,
+			ResultsPath:          cfg.ResultsPath,
+			ConditionType:        cfg.ConditionType,
+			AdapterContainerName: cfg.AdapterContainerName,
+			PollInterval:         cfg.GetPollInterval(),
+			MaxWaitTime:          cfg.GetMaxWaitTime(),
+			ReporterOptions:      func() ([]reporter.Option, error) { return reporterOptions(cfg) },
+			K8sClientOptions: func() ([]k8s.ClientOption, error) {
+				clientOpts := k8sClientOptions(cfg)
+				if recorder != nil {
+					clientOpts = append(clientOpts, k8s.WithEventRecorder(recorder))
+				}
+				return clientOpts, nil
+			},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return withEventRecorderShutdown(w.Run, recorder), nil, nil
+	}
+
+	var recorder *k8s.EventRecorder
+	if cfg.EmitEvents {
+		clientset, err := k8s.NewInClusterClientset()
+		if err != nil {
+			return nil, nil, err
+		}
+		recorder = k8s.NewEventRecorder(clientset)
+		opts = append(opts, reporter.WithK8sClientOptions(k8s.WithClientset(clientset), k8s.WithEventRecorder(recorder)))
+	}
+	rep, err := reporter.NewReporter(
+		cfg.ResultsPath,
+		cfg.GetPollInterval(),
+		cfg.GetMaxWaitTime(),
+		cfg.ConditionType,
+		cfg.PodName,
+		cfg.AdapterContainerName,
+		cfg.JobName,
+		cfg.JobNamespace,
+		opts...,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	run := rep.Run
+	switch cfg.Mode {
+	case config.ModeStdin:
+		run = func(ctx context.Context) error { return rep.RunFromReader(ctx, os.Stdin) }
+	case config.ModeWrap:
+		run = func(ctx context.Context) error { return rep.RunWrapped(ctx, flag.Args()) }
+	}
+	return withEventRecorderShutdown(run, recorder), rep, nil
+}
+
+// withEventRecorderShutdown shuts the recorder down once run returns; run is returned as is
+// without a recorder
+func withEventRecorderShutdown(run func(context.Context) error, recorder *k8s.EventRecorder) func(context.Context) error {
+	if recorder == nil {
+		return run
+	}
+	return func(ctx context.Context) error {
+		defer recorder.Shutdown()
+		return run(ctx)
+	}
+}
+
+// k8sClientOptions maps optional configuration onto Kubernetes client options
+func k8sClientOptions(cfg *config.Config) []k8s.ClientOption {
+	opts := []k8s.ClientOption{
+		k8s.WithServerSideApply(cfg.ServerSideApply),
+		k8s.WithSpecUpdateFallback(cfg.AllowSpecUpdateFallback),
+		k8s.WithRunID(cfg.RunID),
+		k8s.WithAuditLog(cfg.AuditLogPath, cfg.PodName),
+		k8s.WithReportingStateFile(cfg.ReportingStatePath),
+		k8s.WithDuplicateConditionCollapse(cfg.CollapseDuplicateConditions),
+	}
+	if patterns := cfg.GetRetryableErrorPatterns(); len(patterns) > 0 {
+		opts = append(opts, k8s.WithRetryableErrors(k8s.NewRetryableErrorMatcher(patterns)))
+	}
+	if cfg.TargetKind != "" {
+		opts = append(opts, k8s.WithStatusTarget(k8s.StatusTarget{
+			Group:     cfg.TargetGroup,
+			Version:   cfg.TargetVersion,
+			Kind:      cfg.TargetKind,
+			Namespace: cfg.TargetNamespace,
+			Name:      cfg.TargetName,
+		}))
+	}
+	if cfg.JobSetRollup != "" {
+		opts = append(opts, k8s.WithJobSetRollup(k8s.JobSetRollupMode(cfg.JobSetRollup)))
+	}
+	if cfg.ReportToOwner {
+		opts = append(opts, k8s.WithOwnerReporting(k8s.OwnerReportMode(cfg.ReportToOwnerMode)))
+	}
+	return opts
+}
+
+// reporterOptions maps optional configuration onto reporter options
+func reporterOptions(cfg *config.Config) ([]reporter.Option, error) {
+	opts := []reporter.Option{
+		reporter.WithSingleAdapter(cfg.SingleAdapter),
+		reporter.WithMaxResultAge(cfg.GetMaxResultAge()),
+		reporter.WithCheckOnContainerChange(cfg.CheckOnContainerChange),
+		reporter.WithQuietStartup(cfg.QuietStartup),
+		reporter.WithExpectFailure(cfg.ExpectFailure),
+		reporter.WithDebounceTermination(cfg.DebounceTermination),
+		reporter.WithConfirmSuccessStable(cfg.ConfirmSuccessStable),
+		reporter.WithExitOnPodTerminating(cfg.ExitOnPodTerminating),
+		reporter.WithResultParseSettleDelay(cfg.GetResultParseSettleDelay()),
+		reporter.WithParseFailureNote(cfg.NoteParseFailure),
+		reporter.WithCleanupFailurePolicy(cfg.CleanupFailurePolicy, cfg.GetCleanupGracePeriod()),
+		reporter.WithMemoryLimitReport(cfg.ReportMemoryLimit),
+		reporter.WithDeadlinePolling(cfg.DeadlinePolling),
+		reporter.WithMessageKVSuffix(cfg.GetMessageKVSuffixKeys()...),
+		reporter.WithCorrelation(reporter.Correlation{ID: cfg.CorrelationID, ObservedGeneration: int64(cfg.ObservedGeneration)}),
+		reporter.WithStopPollingOnTermination(cfg.StopPollingOnTermination),
+		reporter.WithPodNamePrefix(cfg.PodNameIsPrefix),
+		reporter.WithMinFailureSeverity(cfg.MinFailureSeverity),
+		reporter.WithTimeoutStatus(cfg.TimeoutStatus),
+		reporter.WithInProgressCondition(cfg.ReportInProgress),
+		reporter.WithProgressingCondition(cfg.ProgressingCondition, cfg.ProgressingConditionType),
+		reporter.WithAdapterImageAnnotation(cfg.RecordAdapterImage),
+		reporter.WithRestartCountAnnotation(cfg.RecordRestarts),
+		reporter.WithPodCondition(cfg.PublishPodCondition),
+		reporter.WithTimeoutGrowthGrace(cfg.GetTimeoutGrowthGrace()),
+		reporter.WithResultFileWatch(cfg.ResultFileWatch),
+		reporter.WithResultHTTP(cfg.ResultHTTPAddr),
+		reporter.WithResultHTTPToken(cfg.ResultHTTPTokenFile),
+		reporter.WithResultSocket(cfg.ResultSocketPath),
+		reporter.WithTerminationMessageResult(cfg.ResultFromTerminationMessage),
+		reporter.WithHealthProbe(cfg.AdapterHealthURL, cfg.AdapterHealthMode == config.AdapterHealthModeReplace),
+		reporter.WithOutcomeSocket(cfg.OutcomeSocketPath, cfg.OutcomeSocketStrict),
+		reporter.WithSinkFailurePolicy(cfg.SinkFailurePolicy),
+		reporter.WithInitialStatusRetry(cfg.InitialStatusRetries, cfg.GetInitialStatusRetryDelay()),
+		reporter.WithLogDedupInterval(cfg.GetLogDedupInterval()),
+		reporter.WithNonTerminalReasons(cfg.GetNonTerminalReasons()...),
+		reporter.WithTimingOutput(cfg.TimingOutputPath),
+		reporter.WithActiveDeadlineCheck(cfg.ActiveDeadlineCheck),
+		reporter.WithK8sClientOptions(k8sClientOptions(cfg)...),
+		reporter.WithParserOptions(
+			result.WithFormat(cfg.ResultFormat),
+			result.WithSingleLineMessage(cfg.MessageSingleLine),
+			result.WithSharedLock(cfg.UseFileLock),
+			result.WithRequiredReasonMessage(cfg.RequireReasonMessage),
+			result.WithFieldPointers(result.FieldPointers{
+				Status:             cfg.StatusPointer,
+				Reason:             cfg.ReasonPointer,
+				Message:            cfg.MessagePointer,
+				Details:            cfg.DetailsPointer,
+				Severity:           cfg.SeverityPointer,
+				Conditions:         cfg.ConditionsPointer,
+				CorrelationID:      cfg.CorrelationIDPointer,
+				ObservedGeneration: cfg.ObservedGenerationPointer,
+			}),
+		),
+	}
+
+	routes, err := cfg.GetConditionTypeRoutes()
+	if err != nil {
+		return nil, err
+	}
+	if len(routes) > 0 {
+		opts = append(opts, reporter.WithConditionRoutes(routes...))
+	}
+
+	if cfg.ResultStream {
+		opts = append(opts, reporter.WithResultStream(cfg.ResultStreamProgress))
+	}
+
+	if cfg.IsResultsGlob() {
+		opts = append(opts, reporter.WithResultGlob(cfg.ResultsExpectedCount))
+	}
+	opts = append(opts, reporter.WithAggregateConcurrency(cfg.AggregateConcurrency))
+
+	if cfg.RequireDoneFile {
+		opts = append(opts, reporter.WithDoneFile(cfg.GetResultDoneFile()))
+	}
+
+	if cfg.ResultProjectedVolume {
+		opts = append(opts, reporter.WithProjectedVolume(true))
+	}
+
+	if cfg.ResultsDir != "" {
+		checks, err := cfg.GetResultsDirConditions()
+		if err != nil {
+			return nil, err
+		}
+		resultChecks := make([]reporter.ResultCheck, 0, len(checks))
+		for _, check := range checks {
+			resultChecks = append(resultChecks, reporter.ResultCheck{File: check.File, ConditionType: check.ConditionType})
+		}
+		opts = append(opts, reporter.WithResultChecks(cfg.ResultsDir, resultChecks...))
+	}
+
+	if cfg.VerifyChecksum && cfg.ChecksumStrict {
+		opts = append(opts, reporter.WithRequiredResultChecksum(cfg.ChecksumSuffix))
+	} else if cfg.VerifyChecksum {
+		opts = append(opts, reporter.WithParserOptions(result.WithChecksumVerification(cfg.ChecksumSuffix, false)))
+	}
+
+	if cfg.AllowedResultsBase != "" {
+		opts = append(opts, reporter.WithParserOptions(result.WithAllowedBase(cfg.AllowedResultsBase)))
+	}
+
+	if cfg.DegradedDetailsPointer != "" {
+		opts = append(opts, reporter.WithDegradedRule(&reporter.DegradedRule{
+			DetailsPointer: cfg.DegradedDetailsPointer,
+			Status:         cfg.DegradedStatus,
+			Reason:         cfg.DegradedReason,
+		}))
+	}
+
+	if cfg.CallbackURL != "" {
+		callbackClient, err := callback.NewClient(callback.Config{
+			URL:             cfg.CallbackURL,
+			BearerToken:     cfg.CallbackToken,
+			BearerTokenFile: cfg.CallbackTokenFile,
+			CAFile:          cfg.CallbackCAFile,
+			ClientCertFile:  cfg.CallbackClientCertFile,
+			ClientKeyFile:   cfg.CallbackClientKeyFile,
+			Timeout:         cfg.GetCallbackTimeout(),
+			Retry:           retry.Policy{MaxRetries: cfg.CallbackMaxRetries},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create callback client: %w", err)
+		}
+		opts = append(opts,
+			reporter.WithCallback(callbackClient, cfg.CallbackFailurePolicy == config.CallbackFailurePolicyFatal),
+			reporter.WithCallbackResult(cfg.CallbackIncludeResult),
+		)
+	}
+
+	if cfg.CloudEventsSink != "" {
+		sinkClient, err := callback.NewClient(callback.Config{
+			URL:         cfg.CloudEventsSink,
+			ContentType: reporter.CloudEventsContentType,
+			CAFile:      cfg.CallbackCAFile,
+			Timeout:     cfg.GetCallbackTimeout(),
+			Retry:       retry.Policy{MaxRetries: cfg.CallbackMaxRetries},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create CloudEvents sink client: %w", err)
+		}
+		if cfg.CloudEventsMode == config.CloudEventsModeBinary {
+			opts = append(opts, reporter.WithBinaryCloudEvents(sinkClient, false))
+		} else {
+			opts = append(opts, reporter.WithCloudEvents(sinkClient, false))
+		}
+	}
+
+	if cfg.OTLPLogsEndpoint != "" {
+		otlpClient, err := callback.NewClient(callback.Config{
+			URL:     cfg.OTLPLogsEndpoint,
+			Timeout: cfg.GetCallbackTimeout(),
+			Retry:   retry.Policy{MaxRetries: cfg.CallbackMaxRetries},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP logs client: %w", err)
+		}
+		opts = append(opts, reporter.WithOTLPLogs(otlpClient, cfg.OTelServiceName))
+	}
+
+	if cfg.CommitStatusRepo != "" {
+		statusClient, err := callback.NewClient(callback.Config{
+			URL:             cfg.GetCommitStatusURL(),
+			BearerTokenFile: cfg.CommitStatusTokenFile,
+			Timeout:         cfg.GetCommitStatusTimeout(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create commit status client: %w", err)
+		}
+		opts = append(opts, reporter.WithCommitStatus(statusClient))
+	}
+
+	if cfg.AggregatorName != "" {
+		gvr, err := cfg.GetAggregatorGVR()
+		if err != nil {
+			return nil, err
+		}
+		aggregatorClient, err := k8s.NewAggregatorClient(gvr, cfg.AggregatorNamespace, cfg.AggregatorName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create aggregator client: %w", err)
+		}
+		opts = append(opts, reporter.WithAggregator(aggregatorClient))
+	}
+
+	if cfg.NotifyWebhookURL != "" {
+		notifyClient, err := callback.NewClient(callback.Config{
+			URL:     cfg.NotifyWebhookURL,
+			Timeout: cfg.GetCallbackTimeout(),
+			Retry:   retry.Policy{MaxRetries: cfg.CallbackMaxRetries},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create notification client: %w", err)
+		}
+		opts = append(opts, reporter.WithNotification(notifyClient, cfg.NotifyWebhookFormat, cfg.NotifyOnSuccess))
+	}
+
+	if cfg.EmailSMTPSecretDir != "" {
+		emailClient, err := email.NewClient(email.Config{
+			SecretDir: cfg.EmailSMTPSecretDir,
+			Retry:     retry.Policy{MaxRetries: cfg.CallbackMaxRetries},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create email client: %w", err)
+		}
+		opts = append(opts, reporter.WithEmailNotification(emailClient, cfg.GetEmailOnReasons()))
+	}
+
+	if cfg.MessageBus != "" {
+		busClient, err := newMessageBusClient(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create message bus client: %w", err)
+		}
+		opts = append(opts, reporter.WithMessageBus(busClient, cfg.MessageBus))
+	}
+
+	if cfg.FleetManagerEndpoint != "" {
+		fleetClient, err := fleet.NewClient(fleet.Config{
+			Endpoint:              cfg.FleetManagerEndpoint,
+			Method:                cfg.FleetManagerMethod,
+			TokenFile:             cfg.FleetManagerTokenFile,
+			TokenExchangeURL:      cfg.FleetManagerTokenExchangeURL,
+			TokenExchangeAudience: cfg.FleetManagerTokenAudience,
+			TokenExchangeScopes:   cfg.GetFleetManagerTokenScopes(),
+			CAFile:                cfg.FleetManagerCAFile,
+			Timeout:               cfg.GetFleetManagerTimeout(),
+			Retry:                 retry.Policy{MaxRetries: cfg.FleetManagerMaxRetries},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create fleet manager client: %w", err)
+		}
+		opts = append(opts, reporter.WithFleetManager(fleetClient, cfg.FleetManagerClusterID, cfg.FleetManagerFatal))
+	}
+
+	if cfg.ResultAnnotation {
+		opts = append(opts, reporter.WithResultAnnotation(cfg.ResultAnnotationKey, cfg.ResultAnnotationDigest))
+	}
+	if cfg.ResultConfigMap {
+		opts = append(opts, reporter.WithResultConfigMap())
+	}
+	if cfg.ArgoOutputsDir != "" {
+		opts = append(opts, reporter.WithArgoOutputs(cfg.ArgoOutputsDir))
+	}
+	if cfg.LeaseHeartbeat {
+		leaseClient, err := k8s.NewLeaseClient(cfg.JobNamespace, cfg.JobName, cfg.PodName, cfg.GetPollInterval())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create lease client: %w", err)
+		}
+		opts = append(opts, reporter.WithHeartbeat(leaseClient))
+	}
+	if cfg.ArgoWorkflowName != "" {
+		workflowClient, err := k8s.NewWorkflowClient(cfg.JobNamespace, cfg.ArgoWorkflowName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create workflow client: %w", err)
+		}
+		opts = append(opts, reporter.WithArgoNodeStatus(workflowClient, cfg.ArgoNodeID, cfg.JobName == ""))
+	}
+	if cfg.ResultArchiveBucket != "" {
+		store, err := objectstore.NewClient(objectstore.Config{
+			Endpoint:             cfg.ResultArchiveEndpoint,
+			Region:               cfg.ResultArchiveRegion,
+			Bucket:               cfg.ResultArchiveBucket,
+			Addressing:           cfg.ResultArchiveAddressing,
+			AccessKeyFile:        cfg.ResultArchiveAccessKeyFile,
+			SecretKeyFile:        cfg.ResultArchiveSecretKeyFile,
+			WebIdentityTokenFile: cfg.ResultArchiveWebIdentityFile,
+			RoleARN:              cfg.ResultArchiveRoleARN,
+			STSEndpoint:          cfg.ResultArchiveSTSEndpoint,
+			Retry:                retry.Policy{MaxRetries: resultArchiveMaxRetries},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create result archive client: %w", err)
+		}
+		opts = append(opts, reporter.WithResultArchive(store, cfg.ResultArchivePrefix, int64(cfg.ResultArchiveLogTailLines)))
+	}
+
+	return opts, nil
+}
+
+// kafkaRESTContentType is the produce request content type of the Kafka REST Proxy v2 JSON API
+const kafkaRESTContentType = "application/vnd.kafka.json.v2+json"
+
+// newMessageBusClient creates the client for the configured message bus: a NATS connection, or
+// produce requests to a Kafka REST Proxy topic
+func newMessageBusClient(cfg *config.Config) (reporter.CallbackClient, error) {
+	if cfg.MessageBus == reporter.MessageBusNATS {
+		return nats.NewClient(nats.Config{
+			URL:          cfg.MessageBusURL,
+			Subject:      cfg.MessageBusTopic,
+			TokenFile:    cfg.MessageBusTokenFile,
+			CredsFile:    cfg.MessageBusCredsFile,
+			NKeySeedFile: cfg.MessageBusNKeySeedFile,
+			User:         cfg.MessageBusUser,
+			PasswordFile: cfg.MessageBusPasswordFile,
+			CAFile:       cfg.MessageBusCAFile,
+			Timeout:      cfg.GetMessageBusTimeout(),
+			Retry:        retry.Policy{MaxRetries: cfg.MessageBusMaxRetries},
+		})
+	}
+	return callback.NewClient(callback.Config{
+		URL:             strings.TrimSuffix(cfg.MessageBusURL, "/") + "/topics/" + url.PathEscape(cfg.MessageBusTopic),
+		BearerTokenFile: cfg.MessageBusTokenFile,
+		CAFile:          cfg.MessageBusCAFile,
+		Timeout:         cfg.GetMessageBusTimeout(),
+		Retry:           retry.Policy{MaxRetries: cfg.MessageBusMaxRetries},
+		ContentType:     kafkaRESTContentType,
+	})
+}
+
 // logConfig logs the loaded configuration
 func logConfig(cfg *config.Config) {
 	log.Println("Configuration:")
@@ -130,9 +795,274 @@ func logConfig(cfg *config.Config) {
 	} else {
 		log.Printf("  ADAPTER_CONTAINER_NAME: (auto-detect)")
 	}
+	log.Printf("  SINGLE_ADAPTER: %t", cfg.SingleAdapter)
 	log.Printf("  RESULTS_PATH: %s", cfg.ResultsPath)
 	log.Printf("  POLL_INTERVAL_SECONDS: %d", cfg.PollIntervalSeconds)
 	log.Printf("  MAX_WAIT_TIME_SECONDS: %d", cfg.MaxWaitTimeSeconds)
+	log.Printf("  TIMEOUT_STATUS: %s", cfg.TimeoutStatus)
+	log.Printf("  REPORT_IN_PROGRESS: %t", cfg.ReportInProgress)
+	log.Printf("  PROGRESSING_CONDITION: %t", cfg.ProgressingCondition)
+	log.Printf("  LEASE_HEARTBEAT: %t", cfg.LeaseHeartbeat)
+	if cfg.ProgressingCondition {
+		log.Printf("  PROGRESSING_CONDITION_TYPE: %s", cfg.ProgressingConditionType)
+	}
 	log.Printf("  CONDITION_TYPE: %s", cfg.ConditionType)
 	log.Printf("  LOG_LEVEL: %s", cfg.LogLevel)
+	log.Printf("  MESSAGE_SINGLE_LINE: %t", cfg.MessageSingleLine)
+	log.Printf("  SINK_FAILURE_POLICY: %s", cfg.SinkFailurePolicy)
+	if cfg.CallbackURL != "" {
+		log.Printf("  CALLBACK_URL: %s", cfg.CallbackURL)
+		log.Printf("  CALLBACK_FAILURE_POLICY: %s", cfg.CallbackFailurePolicy)
+		log.Printf("  CALLBACK_INCLUDE_RESULT: %t", cfg.CallbackIncludeResult)
+		if cfg.CallbackTokenFile != "" {
+			log.Printf("  CALLBACK_TOKEN_FILE: %s", cfg.CallbackTokenFile)
+		} else if cfg.CallbackToken != "" {
+			log.Printf("  CALLBACK_TOKEN: (set)")
+		}
+		if cfg.CallbackClientCertFile != "" {
+			log.Printf("  CALLBACK_CLIENT_CERT_FILE: %s", cfg.CallbackClientCertFile)
+			log.Printf("  CALLBACK_CLIENT_KEY_FILE: %s", cfg.CallbackClientKeyFile)
+		}
+	} else {
+		log.Printf("  CALLBACK_URL: (disabled)")
+	}
+	if cfg.UsesCallbackSettings() {
+		log.Printf("  CALLBACK_TIMEOUT_SECONDS: %d", cfg.CallbackTimeoutSeconds)
+		log.Printf("  CALLBACK_MAX_RETRIES: %d", cfg.CallbackMaxRetries)
+		if cfg.CallbackCAFile != "" {
+			log.Printf("  CALLBACK_CA_FILE: %s", cfg.CallbackCAFile)
+		}
+	}
+	if cfg.SkipSentinelPath != "" {
+		log.Printf("  SKIP_SENTINEL_PATH: %s", cfg.SkipSentinelPath)
+	}
+	log.Printf("  MAX_RESULT_AGE_SECONDS: %d", cfg.MaxResultAgeSeconds)
+	log.Printf("  CHECK_ON_CONTAINER_CHANGE: %t", cfg.CheckOnContainerChange)
+	log.Printf("  SERVER_SIDE_APPLY: %t", cfg.ServerSideApply)
+	log.Printf("  ALLOW_SPEC_UPDATE_FALLBACK: %t", cfg.AllowSpecUpdateFallback)
+	log.Printf("  QUIET_STARTUP: %t", cfg.QuietStartup)
+	log.Printf("  EXPECT_FAILURE: %t", cfg.ExpectFailure)
+	log.Printf("  DEBOUNCE_TERMINATION: %t", cfg.DebounceTermination)
+	if cfg.OutcomeSocketPath != "" {
+		log.Printf("  OUTCOME_SOCKET_PATH: %s", cfg.OutcomeSocketPath)
+		log.Printf("  OUTCOME_SOCKET_STRICT: %t", cfg.OutcomeSocketStrict)
+	}
+	log.Printf("  INITIAL_STATUS_RETRIES: %d", cfg.InitialStatusRetries)
+	log.Printf("  INITIAL_STATUS_RETRY_DELAY_SECONDS: %d", cfg.InitialStatusRetryDelaySeconds)
+	log.Printf("  CONFIRM_SUCCESS_STABLE: %t", cfg.ConfirmSuccessStable)
+	log.Printf("  MODE: %s", cfg.Mode)
+	if cfg.Mode == config.ModeNamespace {
+		log.Printf("  JOB_LABEL_SELECTOR: %s", cfg.JobLabelSelector)
+	}
+	log.Printf("  LOG_DEDUP_INTERVAL_SECONDS: %d", cfg.LogDedupIntervalSeconds)
+	for _, p := range []struct{ name, value string }{
+		{"STATUS_POINTER", cfg.StatusPointer},
+		{"REASON_POINTER", cfg.ReasonPointer},
+		{"MESSAGE_POINTER", cfg.MessagePointer},
+		{"DETAILS_POINTER", cfg.DetailsPointer},
+		{"SEVERITY_POINTER", cfg.SeverityPointer},
+		{"CONDITIONS_POINTER", cfg.ConditionsPointer},
+		{"CORRELATION_ID_POINTER", cfg.CorrelationIDPointer},
+		{"OBSERVED_GENERATION_POINTER", cfg.ObservedGenerationPointer},
+	} {
+		if p.value != "" {
+			log.Printf("  %s: %s", p.name, p.value)
+		}
+	}
+	if cfg.NonTerminalReasons != "" {
+		log.Printf("  NON_TERMINAL_REASONS: %s", cfg.NonTerminalReasons)
+	}
+	if cfg.TimingOutputPath != "" {
+		log.Printf("  TIMING_OUTPUT_PATH: %s", cfg.TimingOutputPath)
+	}
+	log.Printf("  ACTIVE_DEADLINE_CHECK: %s", cfg.ActiveDeadlineCheck)
+	if cfg.DegradedDetailsPointer != "" {
+		log.Printf("  DEGRADED_DETAILS_POINTER: %s", cfg.DegradedDetailsPointer)
+		log.Printf("  DEGRADED_STATUS: %s", cfg.DegradedStatus)
+		log.Printf("  DEGRADED_REASON: %s", cfg.DegradedReason)
+	}
+	log.Printf("  EXIT_ON_POD_TERMINATING: %t", cfg.ExitOnPodTerminating)
+	log.Printf("  RESULT_PARSE_SETTLE_SECONDS: %d", cfg.ResultParseSettleSeconds)
+	log.Printf("  RUN_ID: %s", cfg.RunID)
+	if cfg.CorrelationID != "" {
+		log.Printf("  CORRELATION_ID: %s", cfg.CorrelationID)
+	}
+	if cfg.ObservedGeneration != 0 {
+		log.Printf("  OBSERVED_GENERATION: %d", cfg.ObservedGeneration)
+	}
+	log.Printf("  NOTE_PARSE_FAILURE: %t", cfg.NoteParseFailure)
+	log.Printf("  CLEANUP_FAILURE_POLICY: %s", cfg.CleanupFailurePolicy)
+	log.Printf("  CLEANUP_GRACE_SECONDS: %d", cfg.CleanupGraceSeconds)
+	if cfg.AuditLogPath != "" {
+		log.Printf("  AUDIT_LOG_PATH: %s", cfg.AuditLogPath)
+	}
+	if cfg.RetryableErrorPatterns != "" {
+		log.Printf("  RETRYABLE_ERROR_PATTERNS: %s", cfg.RetryableErrorPatterns)
+	}
+	log.Printf("  REPORT_MEMORY_LIMIT: %t", cfg.ReportMemoryLimit)
+	log.Printf("  DEADLINE_POLLING: %t", cfg.DeadlinePolling)
+	if cfg.MessageKVSuffix != "" {
+		log.Printf("  MESSAGE_KV_SUFFIX: %s", cfg.MessageKVSuffix)
+	}
+	log.Printf("  STOP_POLLING_ON_TERMINATION: %t", cfg.StopPollingOnTermination)
+	log.Printf("  POD_NAME_IS_PREFIX: %t", cfg.PodNameIsPrefix)
+	if cfg.ReportingStatePath != "" {
+		log.Printf("  REPORTING_STATE_PATH: %s", cfg.ReportingStatePath)
+	}
+	if cfg.AllowedResultsBase != "" {
+		log.Printf("  ALLOWED_RESULTS_BASE: %s", cfg.AllowedResultsBase)
+	}
+	if cfg.CloudEventsSink != "" {
+		log.Printf("  CLOUDEVENTS_SINK: %s", cfg.CloudEventsSink)
+		log.Printf("  CLOUDEVENTS_MODE: %s", cfg.CloudEventsMode)
+	}
+	log.Printf("  COLLAPSE_DUPLICATE_CONDITIONS: %t", cfg.CollapseDuplicateConditions)
+	log.Printf("  VALIDATE_ONLY: %t", cfg.ValidateOnly)
+	log.Printf("  STARTUP_PREFLIGHT: %t", cfg.StartupPreflight)
+	if cfg.CommitStatusRepo != "" {
+		log.Printf("  COMMIT_STATUS_REPO: %s", cfg.CommitStatusRepo)
+		log.Printf("  COMMIT_STATUS_SHA: %s", cfg.CommitStatusSHA)
+		log.Printf("  COMMIT_STATUS_API_URL: %s", cfg.CommitStatusAPIURL)
+		log.Printf("  COMMIT_STATUS_TIMEOUT_SECONDS: %d", cfg.CommitStatusTimeoutSeconds)
+	}
+	if cfg.MinFailureSeverity != "" {
+		log.Printf("  MIN_FAILURE_SEVERITY: %s", cfg.MinFailureSeverity)
+	}
+	log.Printf("  USE_FILE_LOCK: %t", cfg.UseFileLock)
+	log.Printf("  RECORD_ADAPTER_IMAGE: %t", cfg.RecordAdapterImage)
+	log.Printf("  FINAL_STATUS_LINE: %t", cfg.FinalStatusLine)
+	log.Printf("  VERIFY_CHECKSUM: %t", cfg.VerifyChecksum)
+	if cfg.VerifyChecksum {
+		log.Printf("  CHECKSUM_SUFFIX: %s", cfg.ChecksumSuffix)
+		log.Printf("  CHECKSUM_STRICT: %t", cfg.ChecksumStrict)
+	}
+	if cfg.OTLPLogsEndpoint != "" {
+		log.Printf("  OTEL_EXPORTER_OTLP_LOGS_ENDPOINT: %s", cfg.OTLPLogsEndpoint)
+		log.Printf("  OTEL_SERVICE_NAME: %s", cfg.OTelServiceName)
+	}
+	log.Printf("  REPORT_ON_PANIC: %t", cfg.ReportOnPanic)
+	if cfg.ConditionTypeRoutes != "" {
+		log.Printf("  CONDITION_TYPE_ROUTES: %s", cfg.ConditionTypeRoutes)
+	}
+	log.Printf("  RECORD_RESTARTS: %t", cfg.RecordRestarts)
+	log.Printf("  PUBLISH_POD_CONDITION: %t", cfg.PublishPodCondition)
+	log.Printf("  EMIT_EVENTS: %t", cfg.EmitEvents)
+	log.Printf("  REQUIRE_REASON_MESSAGE: %t", cfg.RequireReasonMessage)
+	log.Printf("  TIMEOUT_GROWTH_GRACE_SECONDS: %d", cfg.TimeoutGrowthGraceSeconds)
+	if cfg.AdapterHealthURL != "" {
+		log.Printf("  ADAPTER_HEALTH_URL: %s", cfg.AdapterHealthURL)
+		log.Printf("  ADAPTER_HEALTH_MODE: %s", cfg.AdapterHealthMode)
+	}
+	if cfg.AggregatorName != "" {
+		log.Printf("  AGGREGATOR_RESOURCE: %s", cfg.AggregatorResource)
+		log.Printf("  AGGREGATOR_NAME: %s", cfg.AggregatorName)
+		log.Printf("  AGGREGATOR_NAMESPACE: %s", cfg.AggregatorNamespace)
+	}
+	log.Printf("  RESULT_FILE_WATCH: %t", cfg.ResultFileWatch)
+	if cfg.ResultHTTPAddr != "" {
+		log.Printf("  RESULT_HTTP_ADDR: %s", cfg.ResultHTTPAddr)
+	}
+	if cfg.ResultHTTPTokenFile != "" {
+		log.Printf("  RESULT_HTTP_TOKEN_FILE: %s", cfg.ResultHTTPTokenFile)
+	}
+	if cfg.ResultSocketPath != "" {
+		log.Printf("  RESULT_SOCKET_PATH: %s", cfg.ResultSocketPath)
+	}
+	log.Printf("  RESULT_FROM_TERMINATION_MESSAGE: %t", cfg.ResultFromTerminationMessage)
+	log.Printf("  RESULT_FORMAT: %s", cfg.ResultFormat)
+	if cfg.IsResultsGlob() {
+		log.Printf("  RESULTS_EXPECTED_COUNT: %d", cfg.ResultsExpectedCount)
+	}
+	if cfg.IsResultsGlob() || cfg.ResultsDir != "" {
+		log.Printf("  AGGREGATE_CONCURRENCY: %d", cfg.AggregateConcurrency)
+	}
+	log.Printf("  RESULT_STREAM: %t", cfg.ResultStream)
+	if cfg.ResultStream {
+		log.Printf("  RESULT_STREAM_PROGRESS: %t", cfg.ResultStreamProgress)
+	}
+	if cfg.ResultsDir != "" {
+		log.Printf("  RESULTS_DIR: %s", cfg.ResultsDir)
+		log.Printf("  RESULTS_DIR_CONDITIONS: %s", cfg.ResultsDirConditions)
+	}
+	log.Printf("  REQUIRE_DONE_FILE: %t", cfg.RequireDoneFile)
+	if cfg.RequireDoneFile {
+		log.Printf("  RESULT_DONE_FILE: %s", cfg.GetResultDoneFile())
+	}
+	log.Printf("  RESULT_PROJECTED_VOLUME: %t", cfg.ResultProjectedVolume)
+	if cfg.TargetKind != "" {
+		log.Printf("  TARGET_GROUP: %s", cfg.TargetGroup)
+		log.Printf("  TARGET_VERSION: %s", cfg.TargetVersion)
+		log.Printf("  TARGET_KIND: %s", cfg.TargetKind)
+		log.Printf("  TARGET_NAME: %s", cfg.TargetName)
+		log.Printf("  TARGET_NAMESPACE: %s", cfg.TargetNamespace)
+	}
+	if cfg.JobSetRollup != "" {
+		log.Printf("  JOBSET_ROLLUP: %s", cfg.JobSetRollup)
+	}
+	if cfg.ReportToOwner {
+		log.Printf("  REPORT_TO_OWNER: %t", cfg.ReportToOwner)
+		log.Printf("  REPORT_TO_OWNER_MODE: %s", cfg.ReportToOwnerMode)
+	}
+	log.Printf("  RESULT_ANNOTATION: %t", cfg.ResultAnnotation)
+	if cfg.ResultAnnotation {
+		log.Printf("  RESULT_ANNOTATION_KEY: %s", cfg.ResultAnnotationKey)
+		log.Printf("  RESULT_ANNOTATION_DIGEST: %t", cfg.ResultAnnotationDigest)
+	}
+	log.Printf("  RESULT_CONFIGMAP: %t", cfg.ResultConfigMap)
+	if cfg.ResultArchiveBucket != "" {
+		log.Printf("  RESULT_ARCHIVE_BUCKET: %s", cfg.ResultArchiveBucket)
+		log.Printf("  RESULT_ARCHIVE_ENDPOINT: %s", cfg.ResultArchiveEndpoint)
+		log.Printf("  RESULT_ARCHIVE_REGION: %s", cfg.ResultArchiveRegion)
+		log.Printf("  RESULT_ARCHIVE_PREFIX: %s", cfg.ResultArchivePrefix)
+		log.Printf("  RESULT_ARCHIVE_ACCESS_KEY_FILE: %s", cfg.ResultArchiveAccessKeyFile)
+		log.Printf("  RESULT_ARCHIVE_SECRET_KEY_FILE: %s", cfg.ResultArchiveSecretKeyFile)
+		log.Printf("  RESULT_ARCHIVE_ADDRESSING: %s", cfg.ResultArchiveAddressing)
+		log.Printf("  RESULT_ARCHIVE_WEB_IDENTITY_TOKEN_FILE: %s", cfg.ResultArchiveWebIdentityFile)
+		log.Printf("  RESULT_ARCHIVE_ROLE_ARN: %s", cfg.ResultArchiveRoleARN)
+		log.Printf("  RESULT_ARCHIVE_STS_ENDPOINT: %s", cfg.ResultArchiveSTSEndpoint)
+		log.Printf("  RESULT_ARCHIVE_LOG_TAIL_LINES: %d", cfg.ResultArchiveLogTailLines)
+	}
+	if cfg.NotifyWebhookURL != "" {
+		log.Printf("  NOTIFY_WEBHOOK_FORMAT: %s", cfg.NotifyWebhookFormat)
+		log.Printf("  NOTIFY_ON_SUCCESS: %t", cfg.NotifyOnSuccess)
+	}
+	if cfg.EmailSMTPSecretDir != "" {
+		log.Printf("  EMAIL_SMTP_SECRET_DIR: %s", cfg.EmailSMTPSecretDir)
+		log.Printf("  EMAIL_ON_REASONS: %s", cfg.EmailOnReasons)
+	}
+	if cfg.MessageBus != "" {
+		log.Printf("  MESSAGE_BUS: %s", cfg.MessageBus)
+		log.Printf("  MESSAGE_BUS_URL: %s", cfg.MessageBusURL)
+		log.Printf("  MESSAGE_BUS_TOPIC: %s", cfg.MessageBusTopic)
+		log.Printf("  MESSAGE_BUS_TOKEN_FILE: %s", cfg.MessageBusTokenFile)
+		log.Printf("  MESSAGE_BUS_CREDS_FILE: %s", cfg.MessageBusCredsFile)
+		log.Printf("  MESSAGE_BUS_NKEY_SEED_FILE: %s", cfg.MessageBusNKeySeedFile)
+		log.Printf("  MESSAGE_BUS_USER: %s", cfg.MessageBusUser)
+		log.Printf("  MESSAGE_BUS_PASSWORD_FILE: %s", cfg.MessageBusPasswordFile)
+		log.Printf("  MESSAGE_BUS_CA_FILE: %s", cfg.MessageBusCAFile)
+		log.Printf("  MESSAGE_BUS_MAX_RETRIES: %d", cfg.MessageBusMaxRetries)
+		log.Printf("  MESSAGE_BUS_TIMEOUT_SECONDS: %d", cfg.MessageBusTimeoutSeconds)
+	}
+	if cfg.FleetManagerEndpoint != "" {
+		log.Printf("  FLEET_MANAGER_ENDPOINT: %s", cfg.FleetManagerEndpoint)
+		log.Printf("  FLEET_MANAGER_CLUSTER_ID: %s", cfg.FleetManagerClusterID)
+		log.Printf("  FLEET_MANAGER_METHOD: %s", cfg.FleetManagerMethod)
+		log.Printf("  FLEET_MANAGER_TOKEN_FILE: %s", cfg.FleetManagerTokenFile)
+		log.Printf("  FLEET_MANAGER_CA_FILE: %s", cfg.FleetManagerCAFile)
+		if cfg.FleetManagerTokenExchangeURL != "" {
+			log.Printf("  FLEET_MANAGER_TOKEN_EXCHANGE_URL: %s", cfg.FleetManagerTokenExchangeURL)
+			log.Printf("  FLEET_MANAGER_TOKEN_AUDIENCE: %s", cfg.FleetManagerTokenAudience)
+			log.Printf("  FLEET_MANAGER_TOKEN_SCOPES: %s", cfg.FleetManagerTokenScopes)
+		}
+		log.Printf("  FLEET_MANAGER_FATAL: %t", cfg.FleetManagerFatal)
+		log.Printf("  FLEET_MANAGER_MAX_RETRIES: %d", cfg.FleetManagerMaxRetries)
+		log.Printf("  FLEET_MANAGER_TIMEOUT_SECONDS: %d", cfg.FleetManagerTimeoutSeconds)
+	}
+	if cfg.ArgoOutputsDir != "" {
+		log.Printf("  ARGO_OUTPUTS_DIR: %s", cfg.ArgoOutputsDir)
+	}
+	if cfg.ArgoWorkflowName != "" {
+		log.Printf("  ARGO_WORKFLOW_NAME: %s", cfg.ArgoWorkflowName)
+		log.Printf("  ARGO_NODE_ID: %s", cfg.ArgoNodeID)
+	}
 }
@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"os"
+	"path/filepath"
 	"syscall"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/reporter"
 )
 
 var _ = Describe("Main", func() {
@@ -118,6 +122,71 @@ var _ = Describe("Main", func() {
 		})
 	})
 
+	Describe("reportingDisabled", func() {
+		It("returns false when no sentinel path is configured", func() {
+			Expect(reportingDisabled("")).To(BeFalse())
+		})
+
+		It("returns false when the sentinel file is absent", func() {
+			Expect(reportingDisabled(filepath.Join(GinkgoT().TempDir(), "skip"))).To(BeFalse())
+		})
+
+		It("returns true when the sentinel file exists", func() {
+			sentinel := filepath.Join(GinkgoT().TempDir(), "skip")
+			Expect(os.WriteFile(sentinel, nil, 0644)).To(Succeed())
+			Expect(reportingDisabled(sentinel)).To(BeTrue())
+		})
+	})
+
+	Describe("panicCondition", func() {
+		It("reports the panic as a StatusReporterError", func() {
+			condition := panicCondition("Available", "index out of range")
+			Expect(condition.Type).To(Equal("Available"))
+			Expect(condition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(condition.Reason).To(Equal(reporter.ReasonStatusReporterError))
+			Expect(condition.Message).To(ContainSubstring("index out of range"))
+		})
+	})
+
+	Describe("finalStatusLine", func() {
+		It("reports the condition of the run outcome", func() {
+			outcome := &reporter.Outcome{Status: "False", Reason: "AdapterTimeout"}
+			Expect(finalStatusLine(0, outcome, "NoConditionReported")).To(Equal("STATUS_REPORTER_RESULT status=failure reason=AdapterTimeout exit=0"))
+		})
+
+		It("falls back to the exit code and reason without an outcome", func() {
+			Expect(finalStatusLine(1, nil, "SetupFailed")).To(Equal("STATUS_REPORTER_RESULT status=failure reason=SetupFailed exit=1"))
+			Expect(finalStatusLine(0, nil, "ReportingDisabled")).To(Equal("STATUS_REPORTER_RESULT status=success reason=ReportingDisabled exit=0"))
+		})
+
+		It("keeps the line parseable when the reason contains spaces", func() {
+			outcome := &reporter.Outcome{Status: "Unknown", Reason: "Minor lint issues"}
+			Expect(finalStatusLine(0, outcome, "")).To(Equal("STATUS_REPORTER_RESULT status=unknown reason=Minor_lint_issues exit=0"))
+		})
+	})
+
+	Describe("checkResultsDir", func() {
+		It("accepts a mounted results directory", func() {
+			Expect(checkResultsDir(filepath.Join(GinkgoT().TempDir(), "result.json"))).To(Succeed())
+		})
+
+		It("rejects a missing results directory", func() {
+			err := checkResultsDir(filepath.Join(GinkgoT().TempDir(), "missing", "result.json"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("not accessible"))
+		})
+	})
+
+	Describe("reportPreflight", func() {
+		It("returns true when every check passed", func() {
+			Expect(reportPreflight([]k8s.PreflightResult{{Name: "config"}, {Name: "job exists"}})).To(BeTrue())
+		})
+
+		It("returns false when any check failed", func() {
+			Expect(reportPreflight([]k8s.PreflightResult{{Name: "config"}, {Name: "job exists", Err: errors.New("not found")}})).To(BeFalse())
+		})
+	})
+
 	Describe("waitForCompletion", Serial, func() {
 		var (
 			sigChan chan os.Signal
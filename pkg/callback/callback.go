@@ -0,0 +1,223 @@
+package callback
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/retry"
+)
+
+const (
+	// DefaultTimeout bounds a single callback request
+	DefaultTimeout = 10 * time.Second
+
+	// DefaultContentType is the Content-Type of callback requests
+	DefaultContentType = "application/json"
+
+	// maxResponseBodyLength limits how much of an error response body is included in errors
+	maxResponseBodyLength = 512
+)
+
+// Config configures the callback client
+type Config struct {
+	// URL is the endpoint the outcome is POSTed to
+	URL string
+
+	// BearerToken is sent in the Authorization header when set
+	BearerToken string
+
+	// BearerTokenFile is read before every request so rotated tokens are picked up; takes precedence over BearerToken
+	BearerTokenFile string
+
+	// CAFile is a PEM bundle used to verify the server certificate instead of the system roots
+	CAFile string
+
+	// ClientCertFile and ClientKeyFile enable mutual TLS when both are set
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// Timeout bounds a single request (DefaultTimeout when zero)
+	Timeout time.Duration
+
+	// Retry bounds the retries of network errors, 429 and 5xx responses
+	Retry retry.Policy
+
+	// ContentType is sent as the request Content-Type (DefaultContentType when empty)
+	ContentType string
+}
+
+// Client posts JSON payloads to an authenticated HTTP callback endpoint
+type Client struct {
+	url             string
+	bearerToken     string
+	bearerTokenFile string
+	retry           retry.Policy
+	contentType     string
+	httpClient      *http.Client
+}
+
+// Message is a payload that carries its own request headers, such as a binary-mode CloudEvent:
+// Post sends Body as the JSON request body and sets Headers on top of the client's defaults
+type Message interface {
+	Headers() map[string]string
+	Body() any
+}
+
+// StatusError is returned when the callback endpoint responds with a non-2xx status
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("callback returned status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("callback returned status %d: %s", e.StatusCode, e.Body)
+}
+
+// retryable reports whether the request may succeed if attempted again
+func (e *StatusError) retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// NewClient creates a callback client, loading any TLS material up front so misconfiguration fails fast
+func NewClient(cfg Config) (*Client, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("callback URL is required")
+	}
+
+	tlsConfig, err := buildTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	contentType := cfg.ContentType
+	if contentType == "" {
+		contentType = DefaultContentType
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &Client{
+		url:             cfg.URL,
+		bearerToken:     cfg.BearerToken,
+		bearerTokenFile: cfg.BearerTokenFile,
+		retry:           cfg.Retry,
+		contentType:     contentType,
+		httpClient: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+		},
+	}, nil
+}
+
+func buildTLSConfig(cfg Config) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if cfg.CAFile != "" {
+		caData, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read callback CA file path=%s: %w", cfg.CAFile, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caData) {
+			return nil, fmt.Errorf("no valid certificates found in callback CA file path=%s", cfg.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCertFile != "" || cfg.ClientKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCertFile, cfg.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load callback client certificate cert=%s key=%s: %w", cfg.ClientCertFile, cfg.ClientKeyFile, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// Post marshals payload as JSON and POSTs it to the callback URL, retrying transient failures.
+// A Message payload also sets its headers on every attempt.
+func (c *Client) Post(ctx context.Context, payload any) error {
+	var headers map[string]string
+	if msg, ok := payload.(Message); ok {
+		headers = msg.Headers()
+		payload = msg.Body()
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal callback payload: %w", err)
+	}
+
+	return retry.Do(ctx, "callback", c.retry, func(ctx context.Context) error {
+		err := c.post(ctx, body, headers)
+		if statusErr, ok := err.(*StatusError); ok && !statusErr.retryable() {
+			return retry.Permanent(err)
+		}
+		return err
+	})
+}
+
+func (c *Client) post(ctx context.Context, body []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create callback request: %w", err)
+	}
+	req.Header.Set("Content-Type", c.contentType)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	token, err := c.token()
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("callback request failed url=%s: %w", c.url, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodyLength))
+		return &StatusError{StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(respBody))}
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// token returns the bearer token, preferring the token file so rotated credentials are used
+func (c *Client) token() (string, error) {
+	if c.bearerTokenFile == "" {
+		return c.bearerToken, nil
+	}
+
+	data, err := os.ReadFile(c.bearerTokenFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read callback token file path=%s: %w", c.bearerTokenFile, err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
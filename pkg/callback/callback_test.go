@@ -0,0 +1,199 @@
+package callback_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/callback"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/retry"
+)
+
+var _ = Describe("Client", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	Describe("NewClient", func() {
+		It("requires a URL", func() {
+			_, err := callback.NewClient(callback.Config{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("URL is required"))
+		})
+
+		It("returns error for missing CA file", func() {
+			_, err := callback.NewClient(callback.Config{URL: "https://example.com", CAFile: "/nonexistent/ca.pem"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to read callback CA file"))
+		})
+	})
+
+	Describe("Post", func() {
+		It("posts JSON with the bearer token", func() {
+			var gotAuth, gotContentType string
+			var gotBody map[string]string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				gotAuth = req.Header.Get("Authorization")
+				gotContentType = req.Header.Get("Content-Type")
+				body, _ := io.ReadAll(req.Body)
+				_ = json.Unmarshal(body, &gotBody)
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client, err := callback.NewClient(callback.Config{URL: server.URL, BearerToken: "secret"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.Post(ctx, map[string]string{"reason": "AllChecksPassed"})).To(Succeed())
+			Expect(gotAuth).To(Equal("Bearer secret"))
+			Expect(gotContentType).To(Equal("application/json"))
+			Expect(gotBody).To(HaveKeyWithValue("reason", "AllChecksPassed"))
+		})
+
+		It("sends the configured content type", func() {
+			var gotContentType string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				gotContentType = req.Header.Get("Content-Type")
+			}))
+			defer server.Close()
+
+			client, err := callback.NewClient(callback.Config{URL: server.URL, ContentType: "application/cloudevents+json"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.Post(ctx, map[string]string{})).To(Succeed())
+			Expect(gotContentType).To(Equal("application/cloudevents+json"))
+		})
+
+		It("sends the headers and body of a message", func() {
+			var gotHeader, gotContentType string
+			var gotBody map[string]string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				gotHeader = req.Header.Get("Ce-Type")
+				gotContentType = req.Header.Get("Content-Type")
+				body, _ := io.ReadAll(req.Body)
+				_ = json.Unmarshal(body, &gotBody)
+			}))
+			defer server.Close()
+
+			client, err := callback.NewClient(callback.Config{URL: server.URL})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.Post(ctx, testMessage{
+				headers: map[string]string{"ce-type": "io.example.test"},
+				body:    map[string]string{"reason": "AllChecksPassed"},
+			})).To(Succeed())
+			Expect(gotHeader).To(Equal("io.example.test"))
+			Expect(gotContentType).To(Equal("application/json"))
+			Expect(gotBody).To(Equal(map[string]string{"reason": "AllChecksPassed"}))
+		})
+
+		It("reads the bearer token from the token file", func() {
+			tokenFile := filepath.Join(GinkgoT().TempDir(), "token")
+			Expect(os.WriteFile(tokenFile, []byte("file-token\n"), 0600)).To(Succeed())
+
+			var gotAuth string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				gotAuth = req.Header.Get("Authorization")
+			}))
+			defer server.Close()
+
+			client, err := callback.NewClient(callback.Config{URL: server.URL, BearerToken: "env-token", BearerTokenFile: tokenFile})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.Post(ctx, map[string]string{})).To(Succeed())
+			Expect(gotAuth).To(Equal("Bearer file-token"))
+		})
+
+		It("retries server errors", func() {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				if calls.Add(1) < 3 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client, err := callback.NewClient(callback.Config{URL: server.URL, Retry: retry.Policy{MaxRetries: 3, Interval: 10 * time.Millisecond}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.Post(ctx, map[string]string{})).To(Succeed())
+			Expect(calls.Load()).To(Equal(int32(3)))
+		})
+
+		It("gives up after the configured retries", func() {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				calls.Add(1)
+				w.WriteHeader(http.StatusInternalServerError)
+			}))
+			defer server.Close()
+
+			client, err := callback.NewClient(callback.Config{URL: server.URL, Retry: retry.Policy{MaxRetries: 2, Interval: 10 * time.Millisecond}})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = client.Post(ctx, map[string]string{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("after 3 attempt(s)"))
+			Expect(calls.Load()).To(Equal(int32(3)))
+		})
+
+		It("does not retry client errors", func() {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				calls.Add(1)
+				http.Error(w, "bad token", http.StatusUnauthorized)
+			}))
+			defer server.Close()
+
+			client, err := callback.NewClient(callback.Config{URL: server.URL, Retry: retry.Policy{MaxRetries: 3, Interval: 10 * time.Millisecond}})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = client.Post(ctx, map[string]string{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("status 401"))
+			Expect(err.Error()).To(ContainSubstring("bad token"))
+			Expect(calls.Load()).To(Equal(int32(1)))
+		})
+
+		It("verifies the server against the configured CA", func() {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {}))
+			defer server.Close()
+
+			caFile := filepath.Join(GinkgoT().TempDir(), "ca.pem")
+			caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+			Expect(os.WriteFile(caFile, caPEM, 0600)).To(Succeed())
+
+			client, err := callback.NewClient(callback.Config{URL: server.URL, CAFile: caFile})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.Post(ctx, map[string]string{})).To(Succeed())
+
+			untrusted, err := callback.NewClient(callback.Config{URL: server.URL})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(untrusted.Post(ctx, map[string]string{})).NotTo(Succeed())
+		})
+	})
+})
+
+// testMessage is a callback.Message with fixed headers and body
+type testMessage struct {
+	headers map[string]string
+	body    any
+}
+
+func (m testMessage) Headers() map[string]string { return m.headers }
+
+func (m testMessage) Body() any { return m.body }
@@ -2,125 +2,1923 @@ package config
 
 import (
 	"fmt"
+	"net"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/objectstore"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/reporter"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+)
+
+// Adapter health probe modes
+const (
+	AdapterHealthModeCoexist = "coexist"
+	AdapterHealthModeReplace = "replace"
+)
+
+// CloudEvents content modes
+const (
+	CloudEventsModeStructured = "structured"
+	CloudEventsModeBinary     = "binary"
+)
+
+// JobSet roll-up modes
+const (
+	JobSetRollupStatus     = "status"
+	JobSetRollupAnnotation = "annotation"
+)
+
+// Owner reporting modes
+const (
+	ReportToOwnerReplace    = "replace"
+	ReportToOwnerAdditional = "additional"
+)
+
+// otlpLogsPath is appended to OTEL_EXPORTER_OTLP_ENDPOINT to form the OTLP/HTTP logs endpoint
+const otlpLogsPath = "/v1/logs"
+
+// Deployment modes
+const (
+	// ModeSidecar reports on the Job of the pod the reporter runs in
+	ModeSidecar = "sidecar"
+
+	// ModeNamespace watches all matching Jobs in a namespace and reports on each
+	ModeNamespace = "namespace"
+
+	// ModeStdin reads a single result from stdin, reports it on the Job and exits
+	ModeStdin = "stdin"
+
+	// ModeWrap runs the adapter command as a child process and reports its outcome on the Job
+	ModeWrap = "wrap"
+)
+
+// Config represents the status reporter configuration
+type Config struct {
+	JobName                        string
+	JobNamespace                   string
+	PodName                        string
+	ResultsPath                    string
+	PollIntervalSeconds            int
+	MaxWaitTimeSeconds             int
+	ConditionType                  string
+	LogLevel                       string
+	AdapterContainerName           string
+	SingleAdapter                  bool
+	MessageSingleLine              bool
+	CallbackURL                    string
+	CallbackToken                  string
+	CallbackTokenFile              string
+	CallbackCAFile                 string
+	CallbackClientCertFile         string
+	CallbackClientKeyFile          string
+	CallbackTimeoutSeconds         int
+	CallbackMaxRetries             int
+	CallbackFailurePolicy          string
+	SkipSentinelPath               string
+	MaxResultAgeSeconds            int
+	CheckOnContainerChange         bool
+	AllowSpecUpdateFallback        bool
+	QuietStartup                   bool
+	ExpectFailure                  bool
+	DebounceTermination            bool
+	OutcomeSocketPath              string
+	OutcomeSocketStrict            bool
+	InitialStatusRetries           int
+	InitialStatusRetryDelaySeconds int
+	ConfirmSuccessStable           bool
+	Mode                           string
+	JobLabelSelector               string
+	LogDedupIntervalSeconds        int
+	StatusPointer                  string
+	ReasonPointer                  string
+	MessagePointer                 string
+	DetailsPointer                 string
+	SeverityPointer                string
+	ConditionsPointer              string
+	CorrelationIDPointer           string
+	ObservedGenerationPointer      string
+	NonTerminalReasons             string
+	TimingOutputPath               string
+	ActiveDeadlineCheck            string
+	DegradedDetailsPointer         string
+	DegradedStatus                 string
+	DegradedReason                 string
+	ExitOnPodTerminating           bool
+	ResultParseSettleSeconds       int
+	RunID                          string
+	NoteParseFailure               bool
+	CleanupFailurePolicy           string
+	CleanupGraceSeconds            int
+	AuditLogPath                   string
+	RetryableErrorPatterns         string
+	ReportMemoryLimit              bool
+	DeadlinePolling                bool
+	MessageKVSuffix                string
+	StopPollingOnTermination       bool
+	PodNameIsPrefix                bool
+	ReportingStatePath             string
+	AllowedResultsBase             string
+	CloudEventsSink                string
+	CollapseDuplicateConditions    bool
+	ValidateOnly                   bool
+	StartupPreflight               bool
+	CommitStatusRepo               string
+	CommitStatusSHA                string
+	CommitStatusAPIURL             string
+	CommitStatusTokenFile          string
+	CommitStatusTimeoutSeconds     int
+	MinFailureSeverity             string
+	UseFileLock                    bool
+	RecordAdapterImage             bool
+	FinalStatusLine                bool
+	VerifyChecksum                 bool
+	ChecksumSuffix                 string
+	ChecksumStrict                 bool
+	OTLPLogsEndpoint               string
+	OTelServiceName                string
+	ReportOnPanic                  bool
+	ConditionTypeRoutes            string
+	RecordRestarts                 bool
+	RequireReasonMessage           bool
+	TimeoutGrowthGraceSeconds      int
+	AdapterHealthURL               string
+	AdapterHealthMode              string
+	AggregatorResource             string
+	AggregatorName                 string
+	AggregatorNamespace            string
+	ResultFileWatch                bool
+	ResultHTTPAddr                 string
+	ResultHTTPTokenFile            string
+	ResultSocketPath               string
+	ResultFromTerminationMessage   bool
+	ResultFormat                   string
+	ResultsExpectedCount           int
+	AggregateConcurrency           int
+	ResultStream                   bool
+	ResultStreamProgress           bool
+	ResultsDir                     string
+	ResultsDirConditions           string
+	RequireDoneFile                bool
+	ResultDoneFile                 string
+	ResultProjectedVolume          bool
+	TargetGroup                    string
+	TargetVersion                  string
+	TargetKind                     string
+	TargetName                     string
+	TargetNamespace                string
+	PublishPodCondition            bool
+	EmitEvents                     bool
+	ResultAnnotation               bool
+	ResultAnnotationKey            string
+	ResultAnnotationDigest         bool
+	ResultConfigMap                bool
+	CallbackIncludeResult          bool
+	NotifyWebhookURL               string
+	NotifyWebhookFormat            string
+	NotifyOnSuccess                bool
+	MessageBus                     string
+	MessageBusURL                  string
+	MessageBusTopic                string
+	MessageBusTokenFile            string
+	MessageBusCredsFile            string
+	MessageBusNKeySeedFile         string
+	MessageBusUser                 string
+	MessageBusPasswordFile         string
+	MessageBusCAFile               string
+	MessageBusMaxRetries           int
+	MessageBusTimeoutSeconds       int
+	CloudEventsMode                string
+	ResultArchiveBucket            string
+	ResultArchiveEndpoint          string
+	ResultArchiveRegion            string
+	ResultArchivePrefix            string
+	ResultArchiveAccessKeyFile     string
+	ResultArchiveSecretKeyFile     string
+	ResultArchiveAddressing        string
+	ResultArchiveWebIdentityFile   string
+	ResultArchiveRoleARN           string
+	ResultArchiveSTSEndpoint       string
+	ResultArchiveLogTailLines      int
+	SinkFailurePolicy              string
+	ArgoOutputsDir                 string
+	ArgoWorkflowName               string
+	ArgoNodeID                     string
+	JobSetRollup                   string
+	ReportToOwner                  bool
+	ReportToOwnerMode              string
+	ServerSideApply                bool
+	ReportInProgress               bool
+	TimeoutStatus                  string
+	FleetManagerEndpoint           string
+	FleetManagerClusterID          string
+	FleetManagerMethod             string
+	FleetManagerTokenFile          string
+	FleetManagerCAFile             string
+	FleetManagerTokenExchangeURL   string
+	FleetManagerTokenAudience      string
+	FleetManagerTokenScopes        string
+	FleetManagerFatal              bool
+	FleetManagerMaxRetries         int
+	FleetManagerTimeoutSeconds     int
+	ProgressingCondition           bool
+	ProgressingConditionType       string
+	CorrelationID                  string
+	ObservedGeneration             int
+	LeaseHeartbeat                 bool
+	EmailSMTPSecretDir             string
+	EmailOnReasons                 string
+}
+
+const (
+	DefaultResultsPath                    = "/results/adapter-result.json"
+	DefaultPollIntervalSeconds            = 2
+	DefaultMaxWaitTimeSeconds             = 300
+	DefaultConditionType                  = "Available"
+	DefaultLogLevel                       = "info"
+	DefaultAdapterContainerName           = ""
+	DefaultSingleAdapter                  = false
+	DefaultMessageSingleLine              = false
+	DefaultCallbackURL                    = ""
+	DefaultCallbackToken                  = ""
+	DefaultCallbackTokenFile              = ""
+	DefaultCallbackCAFile                 = ""
+	DefaultCallbackClientCertFile         = ""
+	DefaultCallbackClientKeyFile          = ""
+	DefaultCallbackTimeoutSeconds         = 10
+	DefaultCallbackMaxRetries             = 3
+	DefaultCallbackFailurePolicy          = "best-effort"
+	DefaultSkipSentinelPath               = ""
+	DefaultMaxResultAgeSeconds            = 0
+	DefaultCheckOnContainerChange         = false
+	DefaultAllowSpecUpdateFallback        = false
+	DefaultQuietStartup                   = false
+	DefaultExpectFailure                  = false
+	DefaultDebounceTermination            = false
+	DefaultOutcomeSocketPath              = ""
+	DefaultOutcomeSocketStrict            = false
+	DefaultInitialStatusRetries           = 3
+	DefaultInitialStatusRetryDelaySeconds = 1
+	DefaultConfirmSuccessStable           = false
+	DefaultMode                           = ModeSidecar
+	DefaultJobLabelSelector               = "hyperfleet.io/status-reporter=true"
+	DefaultLogDedupIntervalSeconds        = 60
+	DefaultStatusPointer                  = ""
+	DefaultReasonPointer                  = ""
+	DefaultMessagePointer                 = ""
+	DefaultDetailsPointer                 = ""
+	DefaultSeverityPointer                = ""
+	DefaultConditionsPointer              = ""
+	DefaultCorrelationIDPointer           = ""
+	DefaultObservedGenerationPointer      = ""
+	DefaultNonTerminalReasons             = ""
+	DefaultTimingOutputPath               = ""
+	DefaultActiveDeadlineCheck            = reporter.ActiveDeadlineCheckOff
+	DefaultDegradedDetailsPointer         = ""
+	DefaultDegradedStatus                 = "False"
+	DefaultDegradedReason                 = "PartialFailure"
+	DefaultExitOnPodTerminating           = false
+	DefaultResultParseSettleSeconds       = 0
+	DefaultRunID                          = ""
+	DefaultNoteParseFailure               = false
+	DefaultCleanupFailurePolicy           = reporter.CleanupFailurePolicyIgnore
+	DefaultCleanupGraceSeconds            = 30
+	DefaultAuditLogPath                   = ""
+	DefaultRetryableErrorPatterns         = ""
+	DefaultReportMemoryLimit              = false
+	DefaultDeadlinePolling                = false
+	DefaultMessageKVSuffix                = ""
+	DefaultStopPollingOnTermination       = false
+	DefaultPodNameIsPrefix                = false
+	DefaultReportingStatePath             = ""
+	DefaultAllowedResultsBase             = ""
+	DefaultCloudEventsSink                = ""
+	DefaultCollapseDuplicateConditions    = false
+	DefaultValidateOnly                   = false
+	DefaultStartupPreflight               = false
+	DefaultCommitStatusRepo               = ""
+	DefaultCommitStatusSHA                = ""
+	DefaultCommitStatusAPIURL             = "https://api.github.com"
+	DefaultCommitStatusTokenFile          = ""
+	DefaultCommitStatusTimeoutSeconds     = 10
+	DefaultMinFailureSeverity             = ""
+	DefaultUseFileLock                    = false
+	DefaultRecordAdapterImage             = false
+	DefaultFinalStatusLine                = false
+	DefaultVerifyChecksum                 = false
+	DefaultChecksumSuffix                 = result.DefaultChecksumSuffix
+	DefaultChecksumStrict                 = false
+	DefaultRequireResultChecksum          = false
+	DefaultOTLPLogsEndpoint               = ""
+	DefaultOTelServiceName                = "status-reporter"
+	DefaultReportOnPanic                  = true
+	DefaultConditionTypeRoutes            = ""
+	DefaultRecordRestarts                 = false
+	DefaultRequireReasonMessage           = false
+	DefaultTimeoutGrowthGraceSeconds      = 0
+	DefaultAdapterHealthURL               = ""
+	DefaultAdapterHealthMode              = AdapterHealthModeCoexist
+	DefaultAggregatorResource             = ""
+	DefaultAggregatorName                 = ""
+	DefaultAggregatorNamespace            = ""
+	DefaultResultFileWatch                = true
+	DefaultResultHTTPAddr                 = ""
+	DefaultResultHTTPTokenFile            = ""
+	DefaultResultSocketPath               = ""
+	DefaultResultFromTerminationMessage   = false
+	DefaultResultFormat                   = result.FormatJSON
+	DefaultResultsExpectedCount           = 0
+	DefaultAggregateConcurrency           = reporter.DefaultAggregateConcurrency
+	DefaultResultStream                   = false
+	DefaultResultStreamProgress           = false
+	DefaultResultsDir                     = ""
+	DefaultResultsDirConditions           = ""
+	DefaultRequireDoneFile                = false
+	DefaultResultDoneFile                 = ""
+	DefaultResultProjectedVolume          = false
+	DefaultTargetGroup                    = ""
+	DefaultTargetVersion                  = ""
+	DefaultTargetKind                     = ""
+	DefaultTargetName                     = ""
+	DefaultTargetNamespace                = ""
+	DefaultPublishPodCondition            = false
+	DefaultEmitEvents                     = false
+	DefaultResultAnnotation               = false
+	DefaultResultAnnotationKey            = k8s.ResultAnnotation
+	DefaultResultAnnotationDigest         = false
+	DefaultResultConfigMap                = false
+	DefaultCallbackIncludeResult          = false
+	DefaultNotifyWebhookURL               = ""
+	DefaultNotifyWebhookFormat            = reporter.NotifyFormatSlack
+	DefaultNotifyOnSuccess                = false
+	DefaultMessageBus                     = ""
+	DefaultMessageBusURL                  = ""
+	DefaultMessageBusTopic                = ""
+	DefaultMessageBusTokenFile            = ""
+	DefaultMessageBusCredsFile            = ""
+	DefaultMessageBusNKeySeedFile         = ""
+	DefaultMessageBusUser                 = ""
+	DefaultMessageBusPasswordFile         = ""
+	DefaultMessageBusCAFile               = ""
+	DefaultMessageBusMaxRetries           = 3
+	DefaultMessageBusTimeoutSeconds       = 10
+	DefaultCloudEventsMode                = CloudEventsModeStructured
+	DefaultResultArchiveBucket            = ""
+	DefaultResultArchiveEndpoint          = "https://s3.amazonaws.com"
+	DefaultResultArchiveRegion            = "us-east-1"
+	DefaultResultArchivePrefix            = ""
+	DefaultResultArchiveAccessKeyFile     = ""
+	DefaultResultArchiveSecretKeyFile     = ""
+	DefaultResultArchiveAddressing        = objectstore.AddressingAuto
+	DefaultResultArchiveWebIdentityFile   = ""
+	DefaultResultArchiveRoleARN           = ""
+	DefaultResultArchiveSTSEndpoint       = ""
+	DefaultResultArchiveLogTailLines      = 200
+	DefaultSinkFailurePolicy              = reporter.SinkFailurePolicyFatal
+	DefaultArgoOutputsDir                 = ""
+	DefaultArgoWorkflowName               = ""
+	DefaultArgoNodeID                     = ""
+	DefaultJobSetRollup                   = ""
+	DefaultReportToOwner                  = false
+	DefaultReportToOwnerMode              = ReportToOwnerReplace
+	DefaultServerSideApply                = true
+	DefaultReportInProgress               = false
+	DefaultTimeoutStatus                  = reporter.ConditionStatusFalse
+	DefaultFleetManagerEndpoint           = ""
+	DefaultFleetManagerClusterID          = ""
+	DefaultFleetManagerMethod             = ""
+	DefaultFleetManagerTokenFile          = ""
+	DefaultFleetManagerCAFile             = ""
+	DefaultFleetManagerTokenExchangeURL   = ""
+	DefaultFleetManagerTokenAudience      = ""
+	DefaultFleetManagerTokenScopes        = ""
+	DefaultFleetManagerFatal              = false
+	DefaultFleetManagerMaxRetries         = 3
+	DefaultFleetManagerTimeoutSeconds     = 10
+	DefaultProgressingCondition           = false
+	DefaultProgressingConditionType       = ""
+	DefaultCorrelationID                  = ""
+	DefaultObservedGeneration             = 0
+	DefaultLeaseHeartbeat                 = false
+	DefaultEmailSMTPSecretDir             = ""
+	DefaultEmailOnReasons                 = "AdapterOOMKilled,AdapterTimeout,AdapterExitedWithError"
+)
+
+const (
+	CallbackFailurePolicyBestEffort = "best-effort"
+	CallbackFailurePolicyFatal      = "fatal"
+)
+
+const (
+	EnvJobName                        = "JOB_NAME"
+	EnvJobNamespace                   = "JOB_NAMESPACE"
+	EnvPodName                        = "POD_NAME"
+	EnvResultsPath                    = "RESULTS_PATH"
+	EnvPollIntervalSeconds            = "POLL_INTERVAL_SECONDS"
+	EnvMaxWaitTimeSeconds             = "MAX_WAIT_TIME_SECONDS"
+	EnvConditionType                  = "CONDITION_TYPE"
+	EnvLogLevel                       = "LOG_LEVEL"
+	EnvAdapterContainerName           = "ADAPTER_CONTAINER_NAME"
+	EnvSingleAdapter                  = "SINGLE_ADAPTER"
+	EnvMessageSingleLine              = "MESSAGE_SINGLE_LINE"
+	EnvCallbackURL                    = "CALLBACK_URL"
+	EnvCallbackToken                  = "CALLBACK_TOKEN"
+	EnvCallbackTokenFile              = "CALLBACK_TOKEN_FILE"
+	EnvCallbackCAFile                 = "CALLBACK_CA_FILE"
+	EnvCallbackClientCertFile         = "CALLBACK_CLIENT_CERT_FILE"
+	EnvCallbackClientKeyFile          = "CALLBACK_CLIENT_KEY_FILE"
+	EnvCallbackTimeoutSeconds         = "CALLBACK_TIMEOUT_SECONDS"
+	EnvCallbackMaxRetries             = "CALLBACK_MAX_RETRIES"
+	EnvCallbackFailurePolicy          = "CALLBACK_FAILURE_POLICY"
+	EnvSkipSentinelPath               = "SKIP_SENTINEL_PATH"
+	EnvMaxResultAgeSeconds            = "MAX_RESULT_AGE_SECONDS"
+	EnvCheckOnContainerChange         = "CHECK_ON_CONTAINER_CHANGE"
+	EnvAllowSpecUpdateFallback        = "ALLOW_SPEC_UPDATE_FALLBACK"
+	EnvQuietStartup                   = "QUIET_STARTUP"
+	EnvExpectFailure                  = "EXPECT_FAILURE"
+	EnvDebounceTermination            = "DEBOUNCE_TERMINATION"
+	EnvOutcomeSocketPath              = "OUTCOME_SOCKET_PATH"
+	EnvOutcomeSocketStrict            = "OUTCOME_SOCKET_STRICT"
+	EnvInitialStatusRetries           = "INITIAL_STATUS_RETRIES"
+	EnvInitialStatusRetryDelaySeconds = "INITIAL_STATUS_RETRY_DELAY_SECONDS"
+	EnvConfirmSuccessStable           = "CONFIRM_SUCCESS_STABLE"
+	EnvMode                           = "MODE"
+	EnvJobLabelSelector               = "JOB_LABEL_SELECTOR"
+	EnvLogDedupIntervalSeconds        = "LOG_DEDUP_INTERVAL_SECONDS"
+	EnvStatusPointer                  = "STATUS_POINTER"
+	EnvReasonPointer                  = "REASON_POINTER"
+	EnvMessagePointer                 = "MESSAGE_POINTER"
+	EnvDetailsPointer                 = "DETAILS_POINTER"
+	EnvSeverityPointer                = "SEVERITY_POINTER"
+	EnvConditionsPointer              = "CONDITIONS_POINTER"
+	EnvCorrelationIDPointer           = "CORRELATION_ID_POINTER"
+	EnvObservedGenerationPointer      = "OBSERVED_GENERATION_POINTER"
+	EnvNonTerminalReasons             = "NON_TERMINAL_REASONS"
+	EnvTimingOutputPath               = "TIMING_OUTPUT_PATH"
+	EnvActiveDeadlineCheck            = "ACTIVE_DEADLINE_CHECK"
+	EnvDegradedDetailsPointer         = "DEGRADED_DETAILS_POINTER"
+	EnvDegradedStatus                 = "DEGRADED_STATUS"
+	EnvDegradedReason                 = "DEGRADED_REASON"
+	EnvExitOnPodTerminating           = "EXIT_ON_POD_TERMINATING"
+	EnvResultParseSettleSeconds       = "RESULT_PARSE_SETTLE_SECONDS"
+	EnvRunID                          = "RUN_ID"
+	EnvNoteParseFailure               = "NOTE_PARSE_FAILURE"
+	EnvCleanupFailurePolicy           = "CLEANUP_FAILURE_POLICY"
+	EnvCleanupGraceSeconds            = "CLEANUP_GRACE_SECONDS"
+	EnvAuditLogPath                   = "AUDIT_LOG_PATH"
+	EnvRetryableErrorPatterns         = "RETRYABLE_ERROR_PATTERNS"
+	EnvReportMemoryLimit              = "REPORT_MEMORY_LIMIT"
+	EnvDeadlinePolling                = "DEADLINE_POLLING"
+	EnvMessageKVSuffix                = "MESSAGE_KV_SUFFIX"
+	EnvStopPollingOnTermination       = "STOP_POLLING_ON_TERMINATION"
+	EnvPodNameIsPrefix                = "POD_NAME_IS_PREFIX"
+	EnvReportingStatePath             = "REPORTING_STATE_PATH"
+	EnvAllowedResultsBase             = "ALLOWED_RESULTS_BASE"
+	EnvCloudEventsSink                = "CLOUDEVENTS_SINK"
+	EnvCollapseDuplicateConditions    = "COLLAPSE_DUPLICATE_CONDITIONS"
+	EnvValidateOnly                   = "VALIDATE_ONLY"
+	EnvStartupPreflight               = "STARTUP_PREFLIGHT"
+	EnvCommitStatusRepo               = "COMMIT_STATUS_REPO"
+	EnvCommitStatusSHA                = "COMMIT_STATUS_SHA"
+	EnvCommitStatusAPIURL             = "COMMIT_STATUS_API_URL"
+	EnvCommitStatusTokenFile          = "COMMIT_STATUS_TOKEN_FILE"
+	EnvCommitStatusTimeoutSeconds     = "COMMIT_STATUS_TIMEOUT_SECONDS"
+	EnvMinFailureSeverity             = "MIN_FAILURE_SEVERITY"
+	EnvUseFileLock                    = "USE_FILE_LOCK"
+	EnvRecordAdapterImage             = "RECORD_ADAPTER_IMAGE"
+	EnvFinalStatusLine                = "FINAL_STATUS_LINE"
+	EnvVerifyChecksum                 = "VERIFY_CHECKSUM"
+	EnvChecksumSuffix                 = "CHECKSUM_SUFFIX"
+	EnvChecksumStrict                 = "CHECKSUM_STRICT"
+	EnvRequireResultChecksum          = "REQUIRE_RESULT_CHECKSUM"
+	EnvOTLPLogsEndpoint               = "OTEL_EXPORTER_OTLP_LOGS_ENDPOINT"
+	EnvOTLPEndpoint                   = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	EnvOTelServiceName                = "OTEL_SERVICE_NAME"
+	EnvReportOnPanic                  = "REPORT_ON_PANIC"
+	EnvConditionTypeRoutes            = "CONDITION_TYPE_ROUTES"
+	EnvRecordRestarts                 = "RECORD_RESTARTS"
+	EnvRequireReasonMessage           = "REQUIRE_REASON_MESSAGE"
+	EnvTimeoutGrowthGraceSeconds      = "TIMEOUT_GROWTH_GRACE_SECONDS"
+	EnvAdapterHealthURL               = "ADAPTER_HEALTH_URL"
+	EnvAdapterHealthMode              = "ADAPTER_HEALTH_MODE"
+	EnvAggregatorResource             = "AGGREGATOR_RESOURCE"
+	EnvAggregatorName                 = "AGGREGATOR_NAME"
+	EnvAggregatorNamespace            = "AGGREGATOR_NAMESPACE"
+	EnvResultFileWatch                = "RESULT_FILE_WATCH"
+	EnvResultHTTPAddr                 = "RESULT_HTTP_ADDR"
+	EnvResultHTTPTokenFile            = "RESULT_HTTP_TOKEN_FILE"
+	EnvResultSocketPath               = "RESULT_SOCKET_PATH"
+	EnvResultFromTerminationMessage   = "RESULT_FROM_TERMINATION_MESSAGE"
+	EnvResultFormat                   = "RESULT_FORMAT"
+	EnvResultsExpectedCount           = "RESULTS_EXPECTED_COUNT"
+	EnvAggregateConcurrency           = "AGGREGATE_CONCURRENCY"
+	EnvResultStream                   = "RESULT_STREAM"
+	EnvResultStreamProgress           = "RESULT_STREAM_PROGRESS"
+	EnvResultsDir                     = "RESULTS_DIR"
+	EnvResultsDirConditions           = "RESULTS_DIR_CONDITIONS"
+	EnvRequireDoneFile                = "REQUIRE_DONE_FILE"
+	EnvResultDoneFile                 = "RESULT_DONE_FILE"
+	EnvResultProjectedVolume          = "RESULT_PROJECTED_VOLUME"
+	EnvTargetGroup                    = "TARGET_GROUP"
+	EnvTargetVersion                  = "TARGET_VERSION"
+	EnvTargetKind                     = "TARGET_KIND"
+	EnvTargetName                     = "TARGET_NAME"
+	EnvTargetNamespace                = "TARGET_NAMESPACE"
+	EnvPublishPodCondition            = "PUBLISH_POD_CONDITION"
+	EnvEmitEvents                     = "EMIT_EVENTS"
+	EnvResultAnnotation               = "RESULT_ANNOTATION"
+	EnvResultAnnotationKey            = "RESULT_ANNOTATION_KEY"
+	EnvResultAnnotationDigest         = "RESULT_ANNOTATION_DIGEST"
+	EnvResultConfigMap                = "RESULT_CONFIGMAP"
+	EnvCallbackIncludeResult          = "CALLBACK_INCLUDE_RESULT"
+	EnvNotifyWebhookURL               = "NOTIFY_WEBHOOK_URL"
+	EnvNotifyWebhookFormat            = "NOTIFY_WEBHOOK_FORMAT"
+	EnvNotifyOnSuccess                = "NOTIFY_ON_SUCCESS"
+	EnvMessageBus                     = "MESSAGE_BUS"
+	EnvMessageBusURL                  = "MESSAGE_BUS_URL"
+	EnvMessageBusTopic                = "MESSAGE_BUS_TOPIC"
+	EnvMessageBusTokenFile            = "MESSAGE_BUS_TOKEN_FILE"
+	EnvMessageBusCredsFile            = "MESSAGE_BUS_CREDS_FILE"
+	EnvMessageBusNKeySeedFile         = "MESSAGE_BUS_NKEY_SEED_FILE"
+	EnvMessageBusUser                 = "MESSAGE_BUS_USER"
+	EnvMessageBusPasswordFile         = "MESSAGE_BUS_PASSWORD_FILE"
+	EnvMessageBusCAFile               = "MESSAGE_BUS_CA_FILE"
+	EnvMessageBusMaxRetries           = "MESSAGE_BUS_MAX_RETRIES"
+	EnvMessageBusTimeoutSeconds       = "MESSAGE_BUS_TIMEOUT_SECONDS"
+	EnvCloudEventsMode                = "CLOUDEVENTS_MODE"
+	EnvResultArchiveBucket            = "RESULT_ARCHIVE_BUCKET"
+	EnvResultArchiveEndpoint          = "RESULT_ARCHIVE_ENDPOINT"
+	EnvResultArchiveRegion            = "RESULT_ARCHIVE_REGION"
+	EnvResultArchivePrefix            = "RESULT_ARCHIVE_PREFIX"
+	EnvResultArchiveAccessKeyFile     = "RESULT_ARCHIVE_ACCESS_KEY_FILE"
+	EnvResultArchiveSecretKeyFile     = "RESULT_ARCHIVE_SECRET_KEY_FILE"
+	EnvResultArchiveAddressing        = "RESULT_ARCHIVE_ADDRESSING"
+	EnvResultArchiveWebIdentityFile   = "RESULT_ARCHIVE_WEB_IDENTITY_TOKEN_FILE"
+	EnvResultArchiveRoleARN           = "RESULT_ARCHIVE_ROLE_ARN"
+	EnvResultArchiveSTSEndpoint       = "RESULT_ARCHIVE_STS_ENDPOINT"
+	EnvResultArchiveLogTailLines      = "RESULT_ARCHIVE_LOG_TAIL_LINES"
+	EnvSinkFailurePolicy              = "SINK_FAILURE_POLICY"
+	EnvArgoOutputsDir                 = "ARGO_OUTPUTS_DIR"
+	EnvArgoWorkflowName               = "ARGO_WORKFLOW_NAME"
+	EnvArgoNodeID                     = "ARGO_NODE_ID"
+	EnvJobSetRollup                   = "JOBSET_ROLLUP"
+	EnvReportToOwner                  = "REPORT_TO_OWNER"
+	EnvReportToOwnerMode              = "REPORT_TO_OWNER_MODE"
+	EnvServerSideApply                = "SERVER_SIDE_APPLY"
+	EnvReportInProgress               = "REPORT_IN_PROGRESS"
+	EnvTimeoutStatus                  = "TIMEOUT_STATUS"
+	EnvFleetManagerEndpoint           = "FLEET_MANAGER_ENDPOINT"
+	EnvFleetManagerClusterID          = "FLEET_MANAGER_CLUSTER_ID"
+	EnvFleetManagerMethod             = "FLEET_MANAGER_METHOD"
+	EnvFleetManagerTokenFile          = "FLEET_MANAGER_TOKEN_FILE"
+	EnvFleetManagerCAFile             = "FLEET_MANAGER_CA_FILE"
+	EnvFleetManagerTokenExchangeURL   = "FLEET_MANAGER_TOKEN_EXCHANGE_URL"
+	EnvFleetManagerTokenAudience      = "FLEET_MANAGER_TOKEN_AUDIENCE"
+	EnvFleetManagerTokenScopes        = "FLEET_MANAGER_TOKEN_SCOPES"
+	EnvFleetManagerFatal              = "FLEET_MANAGER_FATAL"
+	EnvFleetManagerMaxRetries         = "FLEET_MANAGER_MAX_RETRIES"
+	EnvFleetManagerTimeoutSeconds     = "FLEET_MANAGER_TIMEOUT_SECONDS"
+	EnvProgressingCondition           = "PROGRESSING_CONDITION"
+	EnvProgressingConditionType       = "PROGRESSING_CONDITION_TYPE"
+	EnvCorrelationID                  = "CORRELATION_ID"
+	EnvObservedGeneration             = "OBSERVED_GENERATION"
+	EnvLeaseHeartbeat                 = "LEASE_HEARTBEAT"
+	EnvEmailSMTPSecretDir             = "EMAIL_SMTP_SECRET_DIR"
+	EnvEmailOnReasons                 = "EMAIL_ON_REASONS"
 )
 
-// Config represents the status reporter configuration
-type Config struct {
-	JobName              string
-	JobNamespace         string
-	PodName              string
-	ResultsPath          string
-	PollIntervalSeconds  int
-	MaxWaitTimeSeconds   int
-	ConditionType        string
-	LogLevel             string
-	AdapterContainerName string
-}
+// ValidationError represents a validation error for configuration or data validation
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// Load loads configuration from environment variables
+func Load() (*Config, error) {
+	mode := getEnvOrDefault(EnvMode, DefaultMode)
+
+	// In namespace mode Jobs and pods are discovered, so only the namespace is required
+	var jobName, podName string
+	var err error
+	if mode != ModeNamespace {
+		// An Argo Workflow step without a Job reports to its Workflow node only
+		if os.Getenv(EnvArgoWorkflowName) != "" {
+			jobName = strings.TrimSpace(os.Getenv(EnvJobName))
+		} else if jobName, err = getRequiredEnv(EnvJobName); err != nil {
+			return nil, err
+		}
+	}
+
+	jobNamespace, err := getRequiredEnv(EnvJobNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without a pod to monitor, as when the result is read from stdin, POD_NAME is optional
+	if mode == ModeStdin || mode == ModeWrap {
+		podName = os.Getenv(EnvPodName)
+	} else if mode != ModeNamespace {
+		podName, err = getRequiredEnv(EnvPodName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resultsPath := getEnvOrDefault(EnvResultsPath, DefaultResultsPath)
+	conditionType := getEnvOrDefault(EnvConditionType, DefaultConditionType)
+	logLevel := getEnvOrDefault(EnvLogLevel, DefaultLogLevel)
+	adapterContainerName := getEnvOrDefault(EnvAdapterContainerName, DefaultAdapterContainerName)
+
+	pollIntervalSeconds, err := getEnvIntOrDefault(EnvPollIntervalSeconds, DefaultPollIntervalSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	maxWaitTimeSeconds, err := getEnvIntOrDefault(EnvMaxWaitTimeSeconds, DefaultMaxWaitTimeSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	singleAdapter, err := getEnvBoolOrDefault(EnvSingleAdapter, DefaultSingleAdapter)
+	if err != nil {
+		return nil, err
+	}
+
+	messageSingleLine, err := getEnvBoolOrDefault(EnvMessageSingleLine, DefaultMessageSingleLine)
+	if err != nil {
+		return nil, err
+	}
+
+	callbackURL := getEnvOrDefault(EnvCallbackURL, DefaultCallbackURL)
+
+	callbackToken := getEnvOrDefault(EnvCallbackToken, DefaultCallbackToken)
+
+	callbackTokenFile := getEnvOrDefault(EnvCallbackTokenFile, DefaultCallbackTokenFile)
+
+	callbackCAFile := getEnvOrDefault(EnvCallbackCAFile, DefaultCallbackCAFile)
+
+	callbackClientCertFile := getEnvOrDefault(EnvCallbackClientCertFile, DefaultCallbackClientCertFile)
+
+	callbackClientKeyFile := getEnvOrDefault(EnvCallbackClientKeyFile, DefaultCallbackClientKeyFile)
+
+	callbackTimeoutSeconds, err := getEnvIntOrDefault(EnvCallbackTimeoutSeconds, DefaultCallbackTimeoutSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	callbackMaxRetries, err := getEnvIntOrDefault(EnvCallbackMaxRetries, DefaultCallbackMaxRetries)
+	if err != nil {
+		return nil, err
+	}
+
+	callbackFailurePolicy := getEnvOrDefault(EnvCallbackFailurePolicy, DefaultCallbackFailurePolicy)
+
+	skipSentinelPath := getEnvOrDefault(EnvSkipSentinelPath, DefaultSkipSentinelPath)
+
+	maxResultAgeSeconds, err := getEnvIntOrDefault(EnvMaxResultAgeSeconds, DefaultMaxResultAgeSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	checkOnContainerChange, err := getEnvBoolOrDefault(EnvCheckOnContainerChange, DefaultCheckOnContainerChange)
+	if err != nil {
+		return nil, err
+	}
+
+	allowSpecUpdateFallback, err := getEnvBoolOrDefault(EnvAllowSpecUpdateFallback, DefaultAllowSpecUpdateFallback)
+	if err != nil {
+		return nil, err
+	}
+
+	quietStartup, err := getEnvBoolOrDefault(EnvQuietStartup, DefaultQuietStartup)
+	if err != nil {
+		return nil, err
+	}
+
+	expectFailure, err := getEnvBoolOrDefault(EnvExpectFailure, DefaultExpectFailure)
+	if err != nil {
+		return nil, err
+	}
+
+	debounceTermination, err := getEnvBoolOrDefault(EnvDebounceTermination, DefaultDebounceTermination)
+	if err != nil {
+		return nil, err
+	}
+
+	outcomeSocketPath := getEnvOrDefault(EnvOutcomeSocketPath, DefaultOutcomeSocketPath)
+
+	outcomeSocketStrict, err := getEnvBoolOrDefault(EnvOutcomeSocketStrict, DefaultOutcomeSocketStrict)
+	if err != nil {
+		return nil, err
+	}
+
+	initialStatusRetries, err := getEnvIntOrDefault(EnvInitialStatusRetries, DefaultInitialStatusRetries)
+	if err != nil {
+		return nil, err
+	}
+
+	initialStatusRetryDelaySeconds, err := getEnvIntOrDefault(EnvInitialStatusRetryDelaySeconds, DefaultInitialStatusRetryDelaySeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	confirmSuccessStable, err := getEnvBoolOrDefault(EnvConfirmSuccessStable, DefaultConfirmSuccessStable)
+	if err != nil {
+		return nil, err
+	}
+
+	jobLabelSelector := getEnvOrDefault(EnvJobLabelSelector, DefaultJobLabelSelector)
+
+	logDedupIntervalSeconds, err := getEnvIntOrDefault(EnvLogDedupIntervalSeconds, DefaultLogDedupIntervalSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	statusPointer := getEnvOrDefault(EnvStatusPointer, DefaultStatusPointer)
+
+	reasonPointer := getEnvOrDefault(EnvReasonPointer, DefaultReasonPointer)
+
+	messagePointer := getEnvOrDefault(EnvMessagePointer, DefaultMessagePointer)
+
+	detailsPointer := getEnvOrDefault(EnvDetailsPointer, DefaultDetailsPointer)
+
+	severityPointer := getEnvOrDefault(EnvSeverityPointer, DefaultSeverityPointer)
+
+	conditionsPointer := getEnvOrDefault(EnvConditionsPointer, DefaultConditionsPointer)
+
+	correlationIDPointer := getEnvOrDefault(EnvCorrelationIDPointer, DefaultCorrelationIDPointer)
+
+	observedGenerationPointer := getEnvOrDefault(EnvObservedGenerationPointer, DefaultObservedGenerationPointer)
+
+	nonTerminalReasons := getEnvOrDefault(EnvNonTerminalReasons, DefaultNonTerminalReasons)
+
+	timingOutputPath := getEnvOrDefault(EnvTimingOutputPath, DefaultTimingOutputPath)
+
+	activeDeadlineCheck := getEnvOrDefault(EnvActiveDeadlineCheck, DefaultActiveDeadlineCheck)
+
+	degradedDetailsPointer := getEnvOrDefault(EnvDegradedDetailsPointer, DefaultDegradedDetailsPointer)
+
+	degradedStatus := getEnvOrDefault(EnvDegradedStatus, DefaultDegradedStatus)
+
+	degradedReason := getEnvOrDefault(EnvDegradedReason, DefaultDegradedReason)
+
+	exitOnPodTerminating, err := getEnvBoolOrDefault(EnvExitOnPodTerminating, DefaultExitOnPodTerminating)
+	if err != nil {
+		return nil, err
+	}
+
+	resultParseSettleSeconds, err := getEnvIntOrDefault(EnvResultParseSettleSeconds, DefaultResultParseSettleSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	runID := getEnvOrDefault(EnvRunID, DefaultRunID)
+
+	noteParseFailure, err := getEnvBoolOrDefault(EnvNoteParseFailure, DefaultNoteParseFailure)
+	if err != nil {
+		return nil, err
+	}
+
+	cleanupFailurePolicy := getEnvOrDefault(EnvCleanupFailurePolicy, DefaultCleanupFailurePolicy)
+
+	cleanupGraceSeconds, err := getEnvIntOrDefault(EnvCleanupGraceSeconds, DefaultCleanupGraceSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	auditLogPath := getEnvOrDefault(EnvAuditLogPath, DefaultAuditLogPath)
+
+	retryableErrorPatterns := getEnvOrDefault(EnvRetryableErrorPatterns, DefaultRetryableErrorPatterns)
+
+	reportMemoryLimit, err := getEnvBoolOrDefault(EnvReportMemoryLimit, DefaultReportMemoryLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	deadlinePolling, err := getEnvBoolOrDefault(EnvDeadlinePolling, DefaultDeadlinePolling)
+	if err != nil {
+		return nil, err
+	}
+
+	messageKVSuffix := getEnvOrDefault(EnvMessageKVSuffix, DefaultMessageKVSuffix)
+
+	stopPollingOnTermination, err := getEnvBoolOrDefault(EnvStopPollingOnTermination, DefaultStopPollingOnTermination)
+	if err != nil {
+		return nil, err
+	}
+
+	podNameIsPrefix, err := getEnvBoolOrDefault(EnvPodNameIsPrefix, DefaultPodNameIsPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	reportingStatePath := getEnvOrDefault(EnvReportingStatePath, DefaultReportingStatePath)
+
+	allowedResultsBase := getEnvOrDefault(EnvAllowedResultsBase, DefaultAllowedResultsBase)
+
+	cloudEventsSink := getEnvOrDefault(EnvCloudEventsSink, DefaultCloudEventsSink)
+
+	collapseDuplicateConditions, err := getEnvBoolOrDefault(EnvCollapseDuplicateConditions, DefaultCollapseDuplicateConditions)
+	if err != nil {
+		return nil, err
+	}
+
+	validateOnly, err := getEnvBoolOrDefault(EnvValidateOnly, DefaultValidateOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	startupPreflight, err := getEnvBoolOrDefault(EnvStartupPreflight, DefaultStartupPreflight)
+	if err != nil {
+		return nil, err
+	}
+
+	commitStatusRepo := getEnvOrDefault(EnvCommitStatusRepo, DefaultCommitStatusRepo)
+
+	commitStatusSHA := getEnvOrDefault(EnvCommitStatusSHA, DefaultCommitStatusSHA)
+
+	commitStatusAPIURL := getEnvOrDefault(EnvCommitStatusAPIURL, DefaultCommitStatusAPIURL)
+
+	commitStatusTokenFile := getEnvOrDefault(EnvCommitStatusTokenFile, DefaultCommitStatusTokenFile)
+
+	commitStatusTimeoutSeconds, err := getEnvIntOrDefault(EnvCommitStatusTimeoutSeconds, DefaultCommitStatusTimeoutSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	minFailureSeverity := getEnvOrDefault(EnvMinFailureSeverity, DefaultMinFailureSeverity)
+
+	useFileLock, err := getEnvBoolOrDefault(EnvUseFileLock, DefaultUseFileLock)
+	if err != nil {
+		return nil, err
+	}
+
+	recordAdapterImage, err := getEnvBoolOrDefault(EnvRecordAdapterImage, DefaultRecordAdapterImage)
+	if err != nil {
+		return nil, err
+	}
+
+	finalStatusLine, err := getEnvBoolOrDefault(EnvFinalStatusLine, DefaultFinalStatusLine)
+	if err != nil {
+		return nil, err
+	}
+
+	verifyChecksum, err := getEnvBoolOrDefault(EnvVerifyChecksum, DefaultVerifyChecksum)
+	if err != nil {
+		return nil, err
+	}
+
+	checksumSuffix := getEnvOrDefault(EnvChecksumSuffix, DefaultChecksumSuffix)
+
+	checksumStrict, err := getEnvBoolOrDefault(EnvChecksumStrict, DefaultChecksumStrict)
+	if err != nil {
+		return nil, err
+	}
+
+	requireResultChecksum, err := getEnvBoolOrDefault(EnvRequireResultChecksum, DefaultRequireResultChecksum)
+	if err != nil {
+		return nil, err
+	}
+	if requireResultChecksum {
+		verifyChecksum = true
+		checksumStrict = true
+	}
+
+	otlpLogsEndpoint := getEnvOrDefault(EnvOTLPLogsEndpoint, DefaultOTLPLogsEndpoint)
+	if base := os.Getenv(EnvOTLPEndpoint); otlpLogsEndpoint == "" && base != "" {
+		otlpLogsEndpoint = strings.TrimSuffix(base, "/") + otlpLogsPath
+	}
+
+	otelServiceName := getEnvOrDefault(EnvOTelServiceName, DefaultOTelServiceName)
+
+	reportOnPanic, err := getEnvBoolOrDefault(EnvReportOnPanic, DefaultReportOnPanic)
+	if err != nil {
+		return nil, err
+	}
+
+	conditionTypeRoutes := getEnvOrDefault(EnvConditionTypeRoutes, DefaultConditionTypeRoutes)
+
+	recordRestarts, err := getEnvBoolOrDefault(EnvRecordRestarts, DefaultRecordRestarts)
+	if err != nil {
+		return nil, err
+	}
+
+	requireReasonMessage, err := getEnvBoolOrDefault(EnvRequireReasonMessage, DefaultRequireReasonMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutGrowthGraceSeconds, err := getEnvIntOrDefault(EnvTimeoutGrowthGraceSeconds, DefaultTimeoutGrowthGraceSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	adapterHealthURL := getEnvOrDefault(EnvAdapterHealthURL, DefaultAdapterHealthURL)
+
+	adapterHealthMode := getEnvOrDefault(EnvAdapterHealthMode, DefaultAdapterHealthMode)
+
+	aggregatorResource := getEnvOrDefault(EnvAggregatorResource, DefaultAggregatorResource)
+
+	aggregatorName := getEnvOrDefault(EnvAggregatorName, DefaultAggregatorName)
+
+	aggregatorNamespace := getEnvOrDefault(EnvAggregatorNamespace, DefaultAggregatorNamespace)
+	if aggregatorNamespace == "" {
+		aggregatorNamespace = jobNamespace
+	}
+
+	resultFileWatch, err := getEnvBoolOrDefault(EnvResultFileWatch, DefaultResultFileWatch)
+	if err != nil {
+		return nil, err
+	}
+
+	resultHTTPAddr := getEnvOrDefault(EnvResultHTTPAddr, DefaultResultHTTPAddr)
+	resultHTTPTokenFile := getEnvOrDefault(EnvResultHTTPTokenFile, DefaultResultHTTPTokenFile)
+
+	resultSocketPath := getEnvOrDefault(EnvResultSocketPath, DefaultResultSocketPath)
+
+	resultFromTerminationMessage, err := getEnvBoolOrDefault(EnvResultFromTerminationMessage, DefaultResultFromTerminationMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	resultFormat := getEnvOrDefault(EnvResultFormat, DefaultResultFormat)
+
+	resultsExpectedCount, err := getEnvIntOrDefault(EnvResultsExpectedCount, DefaultResultsExpectedCount)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregateConcurrency, err := getEnvIntOrDefault(EnvAggregateConcurrency, DefaultAggregateConcurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	resultStream, err := getEnvBoolOrDefault(EnvResultStream, DefaultResultStream)
+	if err != nil {
+		return nil, err
+	}
+
+	resultStreamProgress, err := getEnvBoolOrDefault(EnvResultStreamProgress, DefaultResultStreamProgress)
+	if err != nil {
+		return nil, err
+	}
+
+	resultsDir := getEnvOrDefault(EnvResultsDir, DefaultResultsDir)
+
+	resultsDirConditions := getEnvOrDefault(EnvResultsDirConditions, DefaultResultsDirConditions)
+
+	requireDoneFile, err := getEnvBoolOrDefault(EnvRequireDoneFile, DefaultRequireDoneFile)
+	if err != nil {
+		return nil, err
+	}
+
+	resultDoneFile := getEnvOrDefault(EnvResultDoneFile, DefaultResultDoneFile)
+
+	resultProjectedVolume, err := getEnvBoolOrDefault(EnvResultProjectedVolume, DefaultResultProjectedVolume)
+	if err != nil {
+		return nil, err
+	}
+
+	targetGroup := getEnvOrDefault(EnvTargetGroup, DefaultTargetGroup)
+
+	targetVersion := getEnvOrDefault(EnvTargetVersion, DefaultTargetVersion)
+
+	targetKind := getEnvOrDefault(EnvTargetKind, DefaultTargetKind)
+
+	targetName := getEnvOrDefault(EnvTargetName, DefaultTargetName)
+
+	targetNamespace := getEnvOrDefault(EnvTargetNamespace, DefaultTargetNamespace)
+	if targetNamespace == "" {
+		targetNamespace = jobNamespace
+	}
+
+	publishPodCondition, err := getEnvBoolOrDefault(EnvPublishPodCondition, DefaultPublishPodCondition)
+	if err != nil {
+		return nil, err
+	}
+
+	emitEvents, err := getEnvBoolOrDefault(EnvEmitEvents, DefaultEmitEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	resultAnnotation, err := getEnvBoolOrDefault(EnvResultAnnotation, DefaultResultAnnotation)
+	if err != nil {
+		return nil, err
+	}
+
+	resultAnnotationKey := getEnvOrDefault(EnvResultAnnotationKey, DefaultResultAnnotationKey)
+
+	resultAnnotationDigest, err := getEnvBoolOrDefault(EnvResultAnnotationDigest, DefaultResultAnnotationDigest)
+	if err != nil {
+		return nil, err
+	}
+
+	resultConfigMap, err := getEnvBoolOrDefault(EnvResultConfigMap, DefaultResultConfigMap)
+	if err != nil {
+		return nil, err
+	}
+
+	callbackIncludeResult, err := getEnvBoolOrDefault(EnvCallbackIncludeResult, DefaultCallbackIncludeResult)
+	if err != nil {
+		return nil, err
+	}
+
+	notifyWebhookURL := getEnvOrDefault(EnvNotifyWebhookURL, DefaultNotifyWebhookURL)
+
+	notifyWebhookFormat := getEnvOrDefault(EnvNotifyWebhookFormat, DefaultNotifyWebhookFormat)
+
+	notifyOnSuccess, err := getEnvBoolOrDefault(EnvNotifyOnSuccess, DefaultNotifyOnSuccess)
+	if err != nil {
+		return nil, err
+	}
+
+	messageBus := getEnvOrDefault(EnvMessageBus, DefaultMessageBus)
+
+	messageBusURL := getEnvOrDefault(EnvMessageBusURL, DefaultMessageBusURL)
+
+	messageBusTopic := getEnvOrDefault(EnvMessageBusTopic, DefaultMessageBusTopic)
+
+	messageBusTokenFile := getEnvOrDefault(EnvMessageBusTokenFile, DefaultMessageBusTokenFile)
+
+	messageBusCredsFile := getEnvOrDefault(EnvMessageBusCredsFile, DefaultMessageBusCredsFile)
+
+	messageBusNKeySeedFile := getEnvOrDefault(EnvMessageBusNKeySeedFile, DefaultMessageBusNKeySeedFile)
+
+	messageBusUser := getEnvOrDefault(EnvMessageBusUser, DefaultMessageBusUser)
+
+	messageBusPasswordFile := getEnvOrDefault(EnvMessageBusPasswordFile, DefaultMessageBusPasswordFile)
+
+	messageBusCAFile := getEnvOrDefault(EnvMessageBusCAFile, DefaultMessageBusCAFile)
+
+	messageBusMaxRetries, err := getEnvIntOrDefault(EnvMessageBusMaxRetries, DefaultMessageBusMaxRetries)
+	if err != nil {
+		return nil, err
+	}
+
+	messageBusTimeoutSeconds, err := getEnvIntOrDefault(EnvMessageBusTimeoutSeconds, DefaultMessageBusTimeoutSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	cloudEventsMode := getEnvOrDefault(EnvCloudEventsMode, DefaultCloudEventsMode)
+
+	resultArchiveBucket := getEnvOrDefault(EnvResultArchiveBucket, DefaultResultArchiveBucket)
+
+	resultArchiveEndpoint := getEnvOrDefault(EnvResultArchiveEndpoint, DefaultResultArchiveEndpoint)
+
+	resultArchiveRegion := getEnvOrDefault(EnvResultArchiveRegion, DefaultResultArchiveRegion)
+
+	resultArchivePrefix := getEnvOrDefault(EnvResultArchivePrefix, DefaultResultArchivePrefix)
+
+	resultArchiveAccessKeyFile := getEnvOrDefault(EnvResultArchiveAccessKeyFile, DefaultResultArchiveAccessKeyFile)
+
+	resultArchiveSecretKeyFile := getEnvOrDefault(EnvResultArchiveSecretKeyFile, DefaultResultArchiveSecretKeyFile)
+
+	resultArchiveAddressing := getEnvOrDefault(EnvResultArchiveAddressing, DefaultResultArchiveAddressing)
+
+	resultArchiveWebIdentityFile := getEnvOrDefault(EnvResultArchiveWebIdentityFile, DefaultResultArchiveWebIdentityFile)
+
+	resultArchiveRoleARN := getEnvOrDefault(EnvResultArchiveRoleARN, DefaultResultArchiveRoleARN)
+
+	resultArchiveSTSEndpoint := getEnvOrDefault(EnvResultArchiveSTSEndpoint, DefaultResultArchiveSTSEndpoint)
+
+	resultArchiveLogTailLines, err := getEnvIntOrDefault(EnvResultArchiveLogTailLines, DefaultResultArchiveLogTailLines)
+	if err != nil {
+		return nil, err
+	}
+
+	sinkFailurePolicy := getEnvOrDefault(EnvSinkFailurePolicy, DefaultSinkFailurePolicy)
+
+	argoOutputsDir := getEnvOrDefault(EnvArgoOutputsDir, DefaultArgoOutputsDir)
+
+	argoWorkflowName := getEnvOrDefault(EnvArgoWorkflowName, DefaultArgoWorkflowName)
+
+	argoNodeID := getEnvOrDefault(EnvArgoNodeID, DefaultArgoNodeID)
+
+	jobSetRollup := getEnvOrDefault(EnvJobSetRollup, DefaultJobSetRollup)
+
+	reportToOwner, err := getEnvBoolOrDefault(EnvReportToOwner, DefaultReportToOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	reportToOwnerMode := getEnvOrDefault(EnvReportToOwnerMode, DefaultReportToOwnerMode)
+
+	serverSideApply, err := getEnvBoolOrDefault(EnvServerSideApply, DefaultServerSideApply)
+	if err != nil {
+		return nil, err
+	}
+
+	reportInProgress, err := getEnvBoolOrDefault(EnvReportInProgress, DefaultReportInProgress)
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutStatus := getEnvOrDefault(EnvTimeoutStatus, DefaultTimeoutStatus)
+
+	fleetManagerEndpoint := getEnvOrDefault(EnvFleetManagerEndpoint, DefaultFleetManagerEndpoint)
+
+	fleetManagerClusterID := getEnvOrDefault(EnvFleetManagerClusterID, DefaultFleetManagerClusterID)
+
+	fleetManagerMethod := getEnvOrDefault(EnvFleetManagerMethod, DefaultFleetManagerMethod)
+
+	fleetManagerTokenFile := getEnvOrDefault(EnvFleetManagerTokenFile, DefaultFleetManagerTokenFile)
+
+	fleetManagerCAFile := getEnvOrDefault(EnvFleetManagerCAFile, DefaultFleetManagerCAFile)
+
+	fleetManagerTokenExchangeURL := getEnvOrDefault(EnvFleetManagerTokenExchangeURL, DefaultFleetManagerTokenExchangeURL)
+
+	fleetManagerTokenAudience := getEnvOrDefault(EnvFleetManagerTokenAudience, DefaultFleetManagerTokenAudience)
+
+	fleetManagerTokenScopes := getEnvOrDefault(EnvFleetManagerTokenScopes, DefaultFleetManagerTokenScopes)
+
+	fleetManagerFatal, err := getEnvBoolOrDefault(EnvFleetManagerFatal, DefaultFleetManagerFatal)
+	if err != nil {
+		return nil, err
+	}
+
+	fleetManagerMaxRetries, err := getEnvIntOrDefault(EnvFleetManagerMaxRetries, DefaultFleetManagerMaxRetries)
+	if err != nil {
+		return nil, err
+	}
+
+	fleetManagerTimeoutSeconds, err := getEnvIntOrDefault(EnvFleetManagerTimeoutSeconds, DefaultFleetManagerTimeoutSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	progressingCondition, err := getEnvBoolOrDefault(EnvProgressingCondition, DefaultProgressingCondition)
+	if err != nil {
+		return nil, err
+	}
+
+	progressingConditionType := getEnvOrDefault(EnvProgressingConditionType, DefaultProgressingConditionType)
+
+	correlationID := strings.TrimSpace(getEnvOrDefault(EnvCorrelationID, DefaultCorrelationID))
+
+	observedGeneration, err := getEnvIntOrDefault(EnvObservedGeneration, DefaultObservedGeneration)
+	if err != nil {
+		return nil, err
+	}
+
+	leaseHeartbeat, err := getEnvBoolOrDefault(EnvLeaseHeartbeat, DefaultLeaseHeartbeat)
+	if err != nil {
+		return nil, err
+	}
+
+	emailSMTPSecretDir := getEnvOrDefault(EnvEmailSMTPSecretDir, DefaultEmailSMTPSecretDir)
+
+	emailOnReasons := getEnvOrDefault(EnvEmailOnReasons, DefaultEmailOnReasons)
+
+	config := &Config{
+		JobName:                        jobName,
+		JobNamespace:                   jobNamespace,
+		PodName:                        podName,
+		ResultsPath:                    resultsPath,
+		PollIntervalSeconds:            pollIntervalSeconds,
+		MaxWaitTimeSeconds:             maxWaitTimeSeconds,
+		ConditionType:                  conditionType,
+		LogLevel:                       logLevel,
+		AdapterContainerName:           adapterContainerName,
+		SingleAdapter:                  singleAdapter,
+		MessageSingleLine:              messageSingleLine,
+		CallbackURL:                    callbackURL,
+		CallbackToken:                  callbackToken,
+		CallbackTokenFile:              callbackTokenFile,
+		CallbackCAFile:                 callbackCAFile,
+		CallbackClientCertFile:         callbackClientCertFile,
+		CallbackClientKeyFile:          callbackClientKeyFile,
+		CallbackTimeoutSeconds:         callbackTimeoutSeconds,
+		CallbackMaxRetries:             callbackMaxRetries,
+		CallbackFailurePolicy:          callbackFailurePolicy,
+		SkipSentinelPath:               skipSentinelPath,
+		MaxResultAgeSeconds:            maxResultAgeSeconds,
+		CheckOnContainerChange:         checkOnContainerChange,
+		AllowSpecUpdateFallback:        allowSpecUpdateFallback,
+		QuietStartup:                   quietStartup,
+		ExpectFailure:                  expectFailure,
+		DebounceTermination:            debounceTermination,
+		OutcomeSocketPath:              outcomeSocketPath,
+		OutcomeSocketStrict:            outcomeSocketStrict,
+		InitialStatusRetries:           initialStatusRetries,
+		InitialStatusRetryDelaySeconds: initialStatusRetryDelaySeconds,
+		ConfirmSuccessStable:           confirmSuccessStable,
+		Mode:                           mode,
+		JobLabelSelector:               jobLabelSelector,
+		LogDedupIntervalSeconds:        logDedupIntervalSeconds,
+		StatusPointer:                  statusPointer,
+		ReasonPointer:                  reasonPointer,
+		MessagePointer:                 messagePointer,
+		DetailsPointer:                 detailsPointer,
+		SeverityPointer:                severityPointer,
+		ConditionsPointer:              conditionsPointer,
+		CorrelationIDPointer:           correlationIDPointer,
+		ObservedGenerationPointer:      observedGenerationPointer,
+		NonTerminalReasons:             nonTerminalReasons,
+		TimingOutputPath:               timingOutputPath,
+		ActiveDeadlineCheck:            activeDeadlineCheck,
+		DegradedDetailsPointer:         degradedDetailsPointer,
+		DegradedStatus:                 degradedStatus,
+		DegradedReason:                 degradedReason,
+		ExitOnPodTerminating:           exitOnPodTerminating,
+		ResultParseSettleSeconds:       resultParseSettleSeconds,
+		RunID:                          runID,
+		NoteParseFailure:               noteParseFailure,
+		CleanupFailurePolicy:           cleanupFailurePolicy,
+		CleanupGraceSeconds:            cleanupGraceSeconds,
+		AuditLogPath:                   auditLogPath,
+		RetryableErrorPatterns:         retryableErrorPatterns,
+		ReportMemoryLimit:              reportMemoryLimit,
+		DeadlinePolling:                deadlinePolling,
+		MessageKVSuffix:                messageKVSuffix,
+		StopPollingOnTermination:       stopPollingOnTermination,
+		PodNameIsPrefix:                podNameIsPrefix,
+		ReportingStatePath:             reportingStatePath,
+		AllowedResultsBase:             allowedResultsBase,
+		CloudEventsSink:                cloudEventsSink,
+		CollapseDuplicateConditions:    collapseDuplicateConditions,
+		ValidateOnly:                   validateOnly,
+		StartupPreflight:               startupPreflight,
+		CommitStatusRepo:               commitStatusRepo,
+		CommitStatusSHA:                commitStatusSHA,
+		CommitStatusAPIURL:             commitStatusAPIURL,
+		CommitStatusTokenFile:          commitStatusTokenFile,
+		CommitStatusTimeoutSeconds:     commitStatusTimeoutSeconds,
+		MinFailureSeverity:             minFailureSeverity,
+		UseFileLock:                    useFileLock,
+		RecordAdapterImage:             recordAdapterImage,
+		FinalStatusLine:                finalStatusLine,
+		VerifyChecksum:                 verifyChecksum,
+		ChecksumSuffix:                 checksumSuffix,
+		ChecksumStrict:                 checksumStrict,
+		OTLPLogsEndpoint:               otlpLogsEndpoint,
+		OTelServiceName:                otelServiceName,
+		ReportOnPanic:                  reportOnPanic,
+		ConditionTypeRoutes:            conditionTypeRoutes,
+		RecordRestarts:                 recordRestarts,
+		RequireReasonMessage:           requireReasonMessage,
+		TimeoutGrowthGraceSeconds:      timeoutGrowthGraceSeconds,
+		AdapterHealthURL:               adapterHealthURL,
+		AdapterHealthMode:              adapterHealthMode,
+		AggregatorResource:             aggregatorResource,
+		AggregatorName:                 aggregatorName,
+		AggregatorNamespace:            aggregatorNamespace,
+		ResultFileWatch:                resultFileWatch,
+		ResultHTTPAddr:                 resultHTTPAddr,
+		ResultHTTPTokenFile:            resultHTTPTokenFile,
+		ResultSocketPath:               resultSocketPath,
+		ResultFromTerminationMessage:   resultFromTerminationMessage,
+		ResultFormat:                   resultFormat,
+		ResultsExpectedCount:           resultsExpectedCount,
+		AggregateConcurrency:           aggregateConcurrency,
+		ResultStream:                   resultStream,
+		ResultStreamProgress:           resultStreamProgress,
+		ResultsDir:                     resultsDir,
+		ResultsDirConditions:           resultsDirConditions,
+		RequireDoneFile:                requireDoneFile,
+		ResultDoneFile:                 resultDoneFile,
+		ResultProjectedVolume:          resultProjectedVolume,
+		TargetGroup:                    targetGroup,
+		TargetVersion:                  targetVersion,
+		TargetKind:                     targetKind,
+		TargetName:                     targetName,
+		TargetNamespace:                targetNamespace,
+		PublishPodCondition:            publishPodCondition,
+		EmitEvents:                     emitEvents,
+		ResultAnnotation:               resultAnnotation,
+		ResultAnnotationKey:            resultAnnotationKey,
+		ResultAnnotationDigest:         resultAnnotationDigest,
+		ResultConfigMap:                resultConfigMap,
+		CallbackIncludeResult:          callbackIncludeResult,
+		NotifyWebhookURL:               notifyWebhookURL,
+		NotifyWebhookFormat:            notifyWebhookFormat,
+		NotifyOnSuccess:                notifyOnSuccess,
+		MessageBus:                     messageBus,
+		MessageBusURL:                  messageBusURL,
+		MessageBusTopic:                messageBusTopic,
+		MessageBusTokenFile:            messageBusTokenFile,
+		MessageBusCredsFile:            messageBusCredsFile,
+		MessageBusNKeySeedFile:         messageBusNKeySeedFile,
+		MessageBusUser:                 messageBusUser,
+		MessageBusPasswordFile:         messageBusPasswordFile,
+		MessageBusCAFile:               messageBusCAFile,
+		MessageBusMaxRetries:           messageBusMaxRetries,
+		MessageBusTimeoutSeconds:       messageBusTimeoutSeconds,
+		CloudEventsMode:                cloudEventsMode,
+		ResultArchiveBucket:            resultArchiveBucket,
+		ResultArchiveEndpoint:          resultArchiveEndpoint,
+		ResultArchiveRegion:            resultArchiveRegion,
+		ResultArchivePrefix:            resultArchivePrefix,
+		ResultArchiveAccessKeyFile:     resultArchiveAccessKeyFile,
+		ResultArchiveSecretKeyFile:     resultArchiveSecretKeyFile,
+		ResultArchiveAddressing:        resultArchiveAddressing,
+		ResultArchiveWebIdentityFile:   resultArchiveWebIdentityFile,
+		ResultArchiveRoleARN:           resultArchiveRoleARN,
+		ResultArchiveSTSEndpoint:       resultArchiveSTSEndpoint,
+		ResultArchiveLogTailLines:      resultArchiveLogTailLines,
+		SinkFailurePolicy:              sinkFailurePolicy,
+		ArgoOutputsDir:                 argoOutputsDir,
+		ArgoWorkflowName:               argoWorkflowName,
+		ArgoNodeID:                     argoNodeID,
+		JobSetRollup:                   jobSetRollup,
+		ReportToOwner:                  reportToOwner,
+		ReportToOwnerMode:              reportToOwnerMode,
+		ServerSideApply:                serverSideApply,
+		ReportInProgress:               reportInProgress,
+		TimeoutStatus:                  timeoutStatus,
+		FleetManagerEndpoint:           fleetManagerEndpoint,
+		FleetManagerClusterID:          fleetManagerClusterID,
+		FleetManagerMethod:             fleetManagerMethod,
+		FleetManagerTokenFile:          fleetManagerTokenFile,
+		FleetManagerCAFile:             fleetManagerCAFile,
+		FleetManagerTokenExchangeURL:   fleetManagerTokenExchangeURL,
+		FleetManagerTokenAudience:      fleetManagerTokenAudience,
+		FleetManagerTokenScopes:        fleetManagerTokenScopes,
+		FleetManagerFatal:              fleetManagerFatal,
+		FleetManagerMaxRetries:         fleetManagerMaxRetries,
+		FleetManagerTimeoutSeconds:     fleetManagerTimeoutSeconds,
+		ProgressingCondition:           progressingCondition,
+		ProgressingConditionType:       progressingConditionType,
+		CorrelationID:                  correlationID,
+		ObservedGeneration:             observedGeneration,
+		LeaseHeartbeat:                 leaseHeartbeat,
+		EmailSMTPSecretDir:             emailSMTPSecretDir,
+		EmailOnReasons:                 emailOnReasons,
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// Validate validates the configuration
+func (c *Config) Validate() error {
+	if c.PollIntervalSeconds <= 0 {
+		return &ValidationError{Field: "PollIntervalSeconds", Message: "must be positive"}
+	}
+	if c.MaxWaitTimeSeconds <= 0 {
+		return &ValidationError{Field: "MaxWaitTimeSeconds", Message: "must be positive"}
+	}
+	if c.PollIntervalSeconds >= c.MaxWaitTimeSeconds {
+		return &ValidationError{Field: "PollIntervalSeconds", Message: "must be less than MaxWaitTimeSeconds"}
+	}
+	switch c.TimeoutStatus {
+	case "", reporter.ConditionStatusFalse, reporter.ConditionStatusUnknown:
+	default:
+		return &ValidationError{
+			Field:   "TimeoutStatus",
+			Message: fmt.Sprintf("must be either '%s' or '%s'", reporter.ConditionStatusFalse, reporter.ConditionStatusUnknown),
+		}
+	}
+	if err := result.ValidateCorrelationID(c.CorrelationID); err != nil {
+		return &ValidationError{Field: "CorrelationID", Message: err.Error()}
+	}
+	if c.ObservedGeneration < 0 {
+		return &ValidationError{Field: "ObservedGeneration", Message: "must not be negative"}
+	}
+	if c.ProgressingCondition && c.ProgressingConditionType == c.ConditionType {
+		return &ValidationError{Field: "ProgressingConditionType", Message: "must differ from ConditionType"}
+	}
+	if c.AdapterContainerName == k8s.StatusReporterContainerName {
+		return &ValidationError{
+			Field:   "AdapterContainerName",
+			Message: fmt.Sprintf("must not be %q, the status reporter's own container", k8s.StatusReporterContainerName),
+		}
+	}
+
+	switch c.Mode {
+	case "", ModeSidecar, ModeStdin, ModeWrap:
+	case ModeNamespace:
+		if strings.TrimSpace(c.JobLabelSelector) == "" {
+			return &ValidationError{Field: "JobLabelSelector", Message: "required in namespace mode"}
+		}
+	default:
+		return &ValidationError{
+			Field:   "Mode",
+			Message: fmt.Sprintf("must be one of '%s', '%s', '%s' or '%s'", ModeSidecar, ModeNamespace, ModeStdin, ModeWrap),
+		}
+	}
+
+	if c.InitialStatusRetries < 0 {
+		return &ValidationError{Field: "InitialStatusRetries", Message: "must not be negative"}
+	}
+	if c.InitialStatusRetryDelaySeconds < 0 {
+		return &ValidationError{Field: "InitialStatusRetryDelaySeconds", Message: "must not be negative"}
+	}
+	switch c.ActiveDeadlineCheck {
+	case "", reporter.ActiveDeadlineCheckOff, reporter.ActiveDeadlineCheckWarn, reporter.ActiveDeadlineCheckStrict:
+	default:
+		return &ValidationError{
+			Field:   "ActiveDeadlineCheck",
+			Message: fmt.Sprintf("must be one of '%s', '%s' or '%s'", reporter.ActiveDeadlineCheckOff, reporter.ActiveDeadlineCheckWarn, reporter.ActiveDeadlineCheckStrict),
+		}
+	}
+	if c.LogDedupIntervalSeconds < 0 {
+		return &ValidationError{Field: "LogDedupIntervalSeconds", Message: "must not be negative"}
+	}
+	if c.ResultParseSettleSeconds < 0 {
+		return &ValidationError{Field: "ResultParseSettleSeconds", Message: "must not be negative"}
+	}
+	switch c.CleanupFailurePolicy {
+	case "", reporter.CleanupFailurePolicyIgnore, reporter.CleanupFailurePolicyEscalate:
+	default:
+		return &ValidationError{
+			Field:   "CleanupFailurePolicy",
+			Message: fmt.Sprintf("must be either '%s' or '%s'", reporter.CleanupFailurePolicyIgnore, reporter.CleanupFailurePolicyEscalate),
+		}
+	}
+	if c.CleanupGraceSeconds < 0 {
+		return &ValidationError{Field: "CleanupGraceSeconds", Message: "must not be negative"}
+	}
+	if c.TimeoutGrowthGraceSeconds < 0 {
+		return &ValidationError{Field: "TimeoutGrowthGraceSeconds", Message: "must not be negative"}
+	}
+	if c.OTLPLogsEndpoint != "" {
+		u, err := url.Parse(c.OTLPLogsEndpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return &ValidationError{Field: "OTLPLogsEndpoint", Message: "must be an absolute http or https URL"}
+		}
+	}
+	if c.NotifyWebhookURL != "" {
+		u, err := url.Parse(c.NotifyWebhookURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return &ValidationError{Field: "NotifyWebhookURL", Message: "must be an absolute http or https URL"}
+		}
+	}
+	switch c.SinkFailurePolicy {
+	case "", reporter.SinkFailurePolicyFatal, reporter.SinkFailurePolicyAny, reporter.SinkFailurePolicyAll:
+	default:
+		return &ValidationError{
+			Field:   "SinkFailurePolicy",
+			Message: fmt.Sprintf("must be one of '%s', '%s' or '%s'", reporter.SinkFailurePolicyFatal, reporter.SinkFailurePolicyAny, reporter.SinkFailurePolicyAll),
+		}
+	}
+	switch c.NotifyWebhookFormat {
+	case "", reporter.NotifyFormatSlack, reporter.NotifyFormatTeams:
+	default:
+		return &ValidationError{
+			Field:   "NotifyWebhookFormat",
+			Message: fmt.Sprintf("must be either '%s' or '%s'", reporter.NotifyFormatSlack, reporter.NotifyFormatTeams),
+		}
+	}
+	if c.ResultFormat != "" && c.ResultFormat != result.FormatAuto && !slices.Contains(result.Formats(), c.ResultFormat) {
+		return &ValidationError{
+			Field:   "ResultFormat",
+			Message: fmt.Sprintf("must be %s or one of %s", result.FormatAuto, strings.Join(result.Formats(), ", ")),
+		}
+	}
+	if c.ResultHTTPAddr != "" {
+		host, _, err := net.SplitHostPort(c.ResultHTTPAddr)
+		if err != nil {
+			return &ValidationError{Field: "ResultHTTPAddr", Message: fmt.Sprintf("must be a host:port listen address: %v", err)}
+		}
+		// Anyone who can reach the endpoint can set the Job's condition, so it stays on the pod's
+		// loopback interface unless requests must carry a shared token
+		if c.ResultHTTPTokenFile == "" && !isLoopbackHost(host) {
+			return &ValidationError{
+				Field:   "ResultHTTPAddr",
+				Message: fmt.Sprintf("must be a loopback address (e.g. 127.0.0.1:8081) unless %s is set", EnvResultHTTPTokenFile),
+			}
+		}
+	}
+	if c.AdapterHealthURL != "" {
+		u, err := url.Parse(c.AdapterHealthURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return &ValidationError{Field: "AdapterHealthURL", Message: "must be an absolute http or https URL"}
+		}
+	}
+	switch c.AdapterHealthMode {
+	case "", AdapterHealthModeCoexist, AdapterHealthModeReplace:
+	default:
+		return &ValidationError{
+			Field:   "AdapterHealthMode",
+			Message: fmt.Sprintf("must be either '%s' or '%s'", AdapterHealthModeCoexist, AdapterHealthModeReplace),
+		}
+	}
+	if c.AggregatorName != "" {
+		if _, err := c.GetAggregatorGVR(); err != nil {
+			return &ValidationError{Field: "AggregatorResource", Message: err.Error()}
+		}
+	}
+	if _, err := c.GetConditionTypeRoutes(); err != nil {
+		return &ValidationError{Field: "ConditionTypeRoutes", Message: err.Error()}
+	}
+	if err := c.validateResultsDir(); err != nil {
+		return err
+	}
+	if err := c.validateStatusTarget(); err != nil {
+		return err
+	}
+	if err := c.validateJobSetRollup(); err != nil {
+		return err
+	}
+	if c.LeaseHeartbeat && (c.JobName == "" || c.Mode == ModeNamespace) {
+		return &ValidationError{Field: "LeaseHeartbeat", Message: "requires JobName and is not supported in namespace mode"}
+	}
+	if c.EmailSMTPSecretDir != "" {
+		// The once-per-Job claim is an annotation on the Job
+		if c.JobName == "" && c.Mode != ModeNamespace {
+			return &ValidationError{Field: "EmailSMTPSecretDir", Message: "requires JobName"}
+		}
+		if len(c.GetEmailOnReasons()) == 0 {
+			return &ValidationError{Field: "EmailOnReasons", Message: "must list at least one reason"}
+		}
+	}
+	if err := c.validateReportToOwner(); err != nil {
+		return err
+	}
+	if err := c.validateMessageBus(); err != nil {
+		return err
+	}
+	if err := c.validateFleetManager(); err != nil {
+		return err
+	}
+	if err := c.validateResultArchive(); err != nil {
+		return err
+	}
+	if err := c.validateArgo(); err != nil {
+		return err
+	}
+	if c.ResultAnnotation {
+		if errs := validation.IsQualifiedName(c.ResultAnnotationKey); len(errs) > 0 {
+			return &ValidationError{Field: "ResultAnnotationKey", Message: strings.Join(errs, "; ")}
+		}
+	}
+	if c.ResultProjectedVolume && (c.IsResultsGlob() || c.ResultsDir != "") {
+		return &ValidationError{Field: "ResultProjectedVolume", Message: "cannot be combined with a ResultsPath glob or ResultsDir"}
+	}
+	if c.RequireDoneFile {
+		if c.ResultDoneFile == "" && (c.IsResultsGlob() || c.ResultsDir != "") {
+			return &ValidationError{Field: "ResultDoneFile", Message: "is required when ResultsPath is a glob or ResultsDir is set"}
+		}
+		if !filepath.IsAbs(c.GetResultDoneFile()) {
+			return &ValidationError{Field: "ResultDoneFile", Message: "path must be absolute"}
+		}
+	}
+	if c.VerifyChecksum && c.ChecksumSuffix == "" {
+		return &ValidationError{Field: "ChecksumSuffix", Message: "is required when VerifyChecksum is enabled"}
+	}
+	if _, ok := result.SeverityRank(c.MinFailureSeverity); c.MinFailureSeverity != "" && !ok {
+		return &ValidationError{
+			Field:   "MinFailureSeverity",
+			Message: fmt.Sprintf("must be one of %s", strings.Join(result.Severities, ", ")),
+		}
+	}
+	for _, key := range c.GetMessageKVSuffixKeys() {
+		switch key {
+		case reporter.MessageKVStatus, reporter.MessageKVReason, reporter.MessageKVElapsed:
+		default:
+			return &ValidationError{
+				Field:   "MessageKVSuffix",
+				Message: fmt.Sprintf("unknown key %q (expected '%s', '%s' or '%s')", key, reporter.MessageKVStatus, reporter.MessageKVReason, reporter.MessageKVElapsed),
+			}
+		}
+	}
+	if c.MaxResultAgeSeconds < 0 {
+		return &ValidationError{Field: "MaxResultAgeSeconds", Message: "must not be negative"}
+	}
+
+	for _, p := range []struct{ field, pointer string }{
+		{"StatusPointer", c.StatusPointer},
+		{"ReasonPointer", c.ReasonPointer},
+		{"MessagePointer", c.MessagePointer},
+		{"DetailsPointer", c.DetailsPointer},
+		{"SeverityPointer", c.SeverityPointer},
+		{"ConditionsPointer", c.ConditionsPointer},
+		{"CorrelationIDPointer", c.CorrelationIDPointer},
+		{"ObservedGenerationPointer", c.ObservedGenerationPointer},
+	} {
+		if err := result.ValidatePointer(p.pointer); err != nil {
+			return &ValidationError{Field: p.field, Message: err.Error()}
+		}
+	}
 
-const (
-	DefaultResultsPath          = "/results/adapter-result.json"
-	DefaultPollIntervalSeconds  = 2
-	DefaultMaxWaitTimeSeconds   = 300
-	DefaultConditionType        = "Available"
-	DefaultLogLevel             = "info"
-	DefaultAdapterContainerName = ""
-)
+	if err := c.validateDegradedRule(); err != nil {
+		return err
+	}
 
-const (
-	EnvJobName              = "JOB_NAME"
-	EnvJobNamespace         = "JOB_NAMESPACE"
-	EnvPodName              = "POD_NAME"
-	EnvResultsPath          = "RESULTS_PATH"
-	EnvPollIntervalSeconds  = "POLL_INTERVAL_SECONDS"
-	EnvMaxWaitTimeSeconds   = "MAX_WAIT_TIME_SECONDS"
-	EnvConditionType        = "CONDITION_TYPE"
-	EnvLogLevel             = "LOG_LEVEL"
-	EnvAdapterContainerName = "ADAPTER_CONTAINER_NAME"
-)
+	if err := c.validateResultsPath(); err != nil {
+		return err
+	}
 
-// ValidationError represents a validation error for configuration or data validation
-type ValidationError struct {
-	Field   string
-	Message string
-}
+	if c.OutcomeSocketPath != "" && !filepath.IsAbs(c.OutcomeSocketPath) {
+		return &ValidationError{Field: "OutcomeSocketPath", Message: "path must be absolute"}
+	}
 
-func (e *ValidationError) Error() string {
-	return e.Field + ": " + e.Message
+	if c.TimingOutputPath != "" && !filepath.IsAbs(c.TimingOutputPath) {
+		return &ValidationError{Field: "TimingOutputPath", Message: "path must be absolute"}
+	}
+	if c.AuditLogPath != "" && !filepath.IsAbs(c.AuditLogPath) {
+		return &ValidationError{Field: "AuditLogPath", Message: "path must be absolute"}
+	}
+	if c.ReportingStatePath != "" && !filepath.IsAbs(c.ReportingStatePath) {
+		return &ValidationError{Field: "ReportingStatePath", Message: "path must be absolute"}
+	}
+
+	if err := c.validateCallback(); err != nil {
+		return err
+	}
+
+	if err := c.validateCommitStatus(); err != nil {
+		return err
+	}
+
+	return nil
 }
 
-// Load loads configuration from environment variables
-func Load() (*Config, error) {
-	jobName, err := getRequiredEnv(EnvJobName)
-	if err != nil {
-		return nil, err
+// validateDegradedRule checks the degraded result rule when it is enabled
+func (c *Config) validateDegradedRule() error {
+	if c.DegradedDetailsPointer == "" {
+		return nil
+	}
+	if err := result.ValidatePointer(c.DegradedDetailsPointer); err != nil {
+		return &ValidationError{Field: "DegradedDetailsPointer", Message: err.Error()}
+	}
+	if c.DegradedStatus != "False" && c.DegradedStatus != "Unknown" {
+		return &ValidationError{Field: "DegradedStatus", Message: "must be either 'False' or 'Unknown'"}
+	}
+	if strings.TrimSpace(c.DegradedReason) == "" {
+		return &ValidationError{Field: "DegradedReason", Message: "must not be empty"}
 	}
+	return nil
+}
 
-	jobNamespace, err := getRequiredEnv(EnvJobNamespace)
+// validateResultsDir ensures the results directory and its check file mapping are usable
+func (c *Config) validateResultsDir() error {
+	checks, err := c.GetResultsDirConditions()
 	if err != nil {
-		return nil, err
+		return &ValidationError{Field: "ResultsDirConditions", Message: err.Error()}
+	}
+	if c.ResultsDir == "" {
+		if len(checks) > 0 {
+			return &ValidationError{Field: "ResultsDirConditions", Message: "requires ResultsDir"}
+		}
+		return nil
 	}
 
-	podName, err := getRequiredEnv(EnvPodName)
-	if err != nil {
-		return nil, err
+	if !filepath.IsAbs(c.ResultsDir) {
+		return &ValidationError{Field: "ResultsDir", Message: "path must be absolute"}
 	}
+	if len(checks) == 0 {
+		return &ValidationError{Field: "ResultsDirConditions", Message: "is required when ResultsDir is set"}
+	}
+	if c.Mode == ModeNamespace {
+		return &ValidationError{Field: "ResultsDir", Message: "is not supported in namespace mode"}
+	}
+	if c.IsResultsGlob() {
+		return &ValidationError{Field: "ResultsDir", Message: "cannot be combined with a ResultsPath glob"}
+	}
+	if c.VerifyChecksum && c.ChecksumStrict {
+		return &ValidationError{Field: "ResultsDir", Message: "cannot be combined with ChecksumStrict"}
+	}
+	return nil
+}
 
-	resultsPath := getEnvOrDefault(EnvResultsPath, DefaultResultsPath)
-	conditionType := getEnvOrDefault(EnvConditionType, DefaultConditionType)
-	logLevel := getEnvOrDefault(EnvLogLevel, DefaultLogLevel)
-	adapterContainerName := getEnvOrDefault(EnvAdapterContainerName, DefaultAdapterContainerName)
+// validateStatusTarget ensures the status target names a single resource
+func (c *Config) validateStatusTarget() error {
+	if c.TargetKind == "" {
+		if c.TargetGroup != "" || c.TargetVersion != "" || c.TargetName != "" {
+			return &ValidationError{Field: "TargetKind", Message: "is required when a status target is configured"}
+		}
+		return nil
+	}
+	if c.TargetName == "" {
+		return &ValidationError{Field: "TargetName", Message: "is required when TargetKind is set"}
+	}
+	if c.JobSetRollup != "" {
+		return &ValidationError{Field: "JobSetRollup", Message: "cannot be combined with TargetKind"}
+	}
+	if c.Mode == ModeNamespace {
+		return &ValidationError{Field: "TargetKind", Message: "is not supported in namespace mode"}
+	}
+	return nil
+}
 
-	pollIntervalSeconds, err := getEnvIntOrDefault(EnvPollIntervalSeconds, DefaultPollIntervalSeconds)
-	if err != nil {
-		return nil, err
+// validateJobSetRollup ensures the JobSet roll-up mode is known and there is a Job to roll up
+func (c *Config) validateJobSetRollup() error {
+	switch c.JobSetRollup {
+	case "":
+		return nil
+	case JobSetRollupStatus, JobSetRollupAnnotation:
+	default:
+		return &ValidationError{
+			Field:   "JobSetRollup",
+			Message: fmt.Sprintf("must be either '%s' or '%s'", JobSetRollupStatus, JobSetRollupAnnotation),
+		}
+	}
+	if c.Mode == ModeNamespace {
+		return &ValidationError{Field: "JobSetRollup", Message: "is not supported in namespace mode"}
+	}
+	if c.JobName == "" {
+		return &ValidationError{Field: "JobSetRollup", Message: "requires JobName"}
 	}
+	return nil
+}
 
-	maxWaitTimeSeconds, err := getEnvIntOrDefault(EnvMaxWaitTimeSeconds, DefaultMaxWaitTimeSeconds)
-	if err != nil {
-		return nil, err
+// validateReportToOwner ensures the owner reporting mode is known and the owner is the only
+// alternative destination of the condition
+func (c *Config) validateReportToOwner() error {
+	if !c.ReportToOwner {
+		return nil
+	}
+	switch c.ReportToOwnerMode {
+	case ReportToOwnerReplace, ReportToOwnerAdditional:
+	default:
+		return &ValidationError{
+			Field:   "ReportToOwnerMode",
+			Message: fmt.Sprintf("must be either '%s' or '%s'", ReportToOwnerReplace, ReportToOwnerAdditional),
+		}
 	}
+	if c.Mode == ModeNamespace {
+		return &ValidationError{Field: "ReportToOwner", Message: "is not supported in namespace mode"}
+	}
+	if c.JobName == "" {
+		return &ValidationError{Field: "ReportToOwner", Message: "requires JobName"}
+	}
+	if c.TargetKind != "" || c.JobSetRollup != "" {
+		return &ValidationError{Field: "ReportToOwner", Message: "cannot be combined with TargetKind or JobSetRollup"}
+	}
+	return nil
+}
 
-	config := &Config{
-		JobName:              jobName,
-		JobNamespace:         jobNamespace,
-		PodName:              podName,
-		ResultsPath:          resultsPath,
-		PollIntervalSeconds:  pollIntervalSeconds,
-		MaxWaitTimeSeconds:   maxWaitTimeSeconds,
-		ConditionType:        conditionType,
-		LogLevel:             logLevel,
-		AdapterContainerName: adapterContainerName,
+// validateMessageBus ensures the message bus URL matches the bus and a topic is set
+func (c *Config) validateMessageBus() error {
+	var schemes []string
+	switch c.MessageBus {
+	case "":
+		return nil
+	case reporter.MessageBusNATS:
+		schemes = []string{"nats", "tls"}
+	case reporter.MessageBusKafkaREST:
+		schemes = []string{"http", "https"}
+	default:
+		return &ValidationError{
+			Field:   "MessageBus",
+			Message: fmt.Sprintf("must be either '%s' or '%s'", reporter.MessageBusNATS, reporter.MessageBusKafkaREST),
+		}
+	}
+	u, err := url.Parse(c.MessageBusURL)
+	if err != nil || !slices.Contains(schemes, u.Scheme) || u.Host == "" {
+		return &ValidationError{
+			Field:   "MessageBusURL",
+			Message: fmt.Sprintf("must be an absolute %s URL when MessageBus is '%s'", strings.Join(schemes, " or "), c.MessageBus),
+		}
+	}
+	if strings.TrimSpace(c.MessageBusTopic) == "" {
+		return &ValidationError{Field: "MessageBusTopic", Message: "is required when MessageBus is set"}
+	}
+	natsAuth := 0
+	for _, set := range []bool{c.MessageBusCredsFile != "", c.MessageBusNKeySeedFile != "", c.MessageBusUser != ""} {
+		if set {
+			natsAuth++
+		}
+	}
+	if natsAuth > 0 && c.MessageBus != reporter.MessageBusNATS {
+		return &ValidationError{Field: "MessageBus", Message: "credentials, nkey and user authentication are only supported with NATS"}
+	}
+	if c.MessageBusTokenFile != "" {
+		natsAuth++
 	}
+	if natsAuth > 1 {
+		return &ValidationError{Field: "MessageBus", Message: "only one of the token, credentials, nkey seed or user authentication may be set"}
+	}
+	if (c.MessageBusUser == "") != (c.MessageBusPasswordFile == "") {
+		return &ValidationError{Field: "MessageBusPasswordFile", Message: "must be set together with MessageBusUser"}
+	}
+	if c.MessageBusMaxRetries < 0 {
+		return &ValidationError{Field: "MessageBusMaxRetries", Message: "must not be negative"}
+	}
+	if c.MessageBusTimeoutSeconds <= 0 {
+		return &ValidationError{Field: "MessageBusTimeoutSeconds", Message: "must be positive"}
+	}
+	return nil
+}
 
-	if err := config.Validate(); err != nil {
-		return nil, err
+// validateFleetManager ensures the fleet manager endpoint is an http(s) URL and a cluster ID is set
+func (c *Config) validateFleetManager() error {
+	if c.FleetManagerEndpoint == "" {
+		return nil
+	}
+	u, err := url.Parse(c.FleetManagerEndpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return &ValidationError{Field: "FleetManagerEndpoint", Message: "must be an absolute http or https URL"}
+	}
+	if strings.TrimSpace(c.FleetManagerClusterID) == "" {
+		return &ValidationError{Field: "FleetManagerClusterID", Message: "is required when FleetManagerEndpoint is set"}
+	}
+	if c.FleetManagerMethod != "" && (!strings.HasPrefix(c.FleetManagerMethod, "/") || strings.Count(c.FleetManagerMethod, "/") != 2) {
+		return &ValidationError{Field: "FleetManagerMethod", Message: "must be a full gRPC method name like /package.Service/Method"}
+	}
+	if c.FleetManagerTokenExchangeURL != "" {
+		u, err := url.Parse(c.FleetManagerTokenExchangeURL)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return &ValidationError{Field: "FleetManagerTokenExchangeURL", Message: "must be an absolute https URL"}
+		}
+		if c.FleetManagerTokenFile == "" || c.FleetManagerTokenAudience == "" {
+			return &ValidationError{Field: "FleetManagerTokenExchangeURL", Message: "requires FleetManagerTokenFile and FleetManagerTokenAudience"}
+		}
+	}
+	if c.FleetManagerMaxRetries < 0 {
+		return &ValidationError{Field: "FleetManagerMaxRetries", Message: "must not be negative"}
+	}
+	if c.FleetManagerTimeoutSeconds <= 0 {
+		return &ValidationError{Field: "FleetManagerTimeoutSeconds", Message: "must be positive"}
 	}
+	return nil
+}
 
-	return config, nil
+// validateResultArchive ensures the archive names a bucket on an http(s) endpoint, with at most one
+// source of credentials; without key files or a web identity token file the default AWS chain is used
+func (c *Config) validateResultArchive() error {
+	if c.ResultArchiveBucket == "" {
+		return nil
+	}
+	if strings.Contains(c.ResultArchiveBucket, "/") {
+		return &ValidationError{Field: "ResultArchiveBucket", Message: "must be a bucket name, not a path"}
+	}
+	u, err := url.Parse(c.ResultArchiveEndpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return &ValidationError{Field: "ResultArchiveEndpoint", Message: "must be an absolute http or https URL"}
+	}
+	if strings.Trim(u.Path, "/") != "" {
+		return &ValidationError{Field: "ResultArchiveEndpoint", Message: "must not have a path"}
+	}
+	switch c.ResultArchiveAddressing {
+	case objectstore.AddressingAuto, objectstore.AddressingPath, objectstore.AddressingVirtual:
+	default:
+		return &ValidationError{
+			Field:   "ResultArchiveAddressing",
+			Message: fmt.Sprintf("must be %s, %s or %s", objectstore.AddressingAuto, objectstore.AddressingPath, objectstore.AddressingVirtual),
+		}
+	}
+	if (c.ResultArchiveAccessKeyFile == "") != (c.ResultArchiveSecretKeyFile == "") {
+		return &ValidationError{
+			Field:   "ResultArchiveAccessKeyFile",
+			Message: "ResultArchiveAccessKeyFile and ResultArchiveSecretKeyFile must be set together",
+		}
+	}
+	if c.ResultArchiveWebIdentityFile != "" {
+		if c.ResultArchiveAccessKeyFile != "" {
+			return &ValidationError{Field: "ResultArchiveWebIdentityFile", Message: "must not be set with ResultArchiveAccessKeyFile"}
+		}
+		if c.ResultArchiveRoleARN == "" {
+			return &ValidationError{Field: "ResultArchiveRoleARN", Message: "is required with ResultArchiveWebIdentityFile"}
+		}
+	}
+	if c.ResultArchiveSTSEndpoint != "" {
+		sts, err := url.Parse(c.ResultArchiveSTSEndpoint)
+		if err != nil || (sts.Scheme != "http" && sts.Scheme != "https") || sts.Host == "" {
+			return &ValidationError{Field: "ResultArchiveSTSEndpoint", Message: "must be an absolute http or https URL"}
+		}
+	}
+	if c.ResultArchiveLogTailLines < 0 {
+		return &ValidationError{Field: "ResultArchiveLogTailLines", Message: "must not be negative"}
+	}
+	return nil
 }
 
-// Validate validates the configuration
-func (c *Config) Validate() error {
-	if c.PollIntervalSeconds <= 0 {
-		return &ValidationError{Field: "PollIntervalSeconds", Message: "must be positive"}
+// validateArgo ensures the Argo Workflow node is fully identified and the outputs directory is absolute
+func (c *Config) validateArgo() error {
+	if c.ArgoOutputsDir != "" && !filepath.IsAbs(c.ArgoOutputsDir) {
+		return &ValidationError{Field: "ArgoOutputsDir", Message: "must be an absolute path"}
 	}
-	if c.MaxWaitTimeSeconds <= 0 {
-		return &ValidationError{Field: "MaxWaitTimeSeconds", Message: "must be positive"}
+	if (c.ArgoWorkflowName == "") != (c.ArgoNodeID == "") {
+		return &ValidationError{Field: "ArgoNodeID", Message: "ArgoWorkflowName and ArgoNodeID must be set together"}
 	}
-	if c.PollIntervalSeconds >= c.MaxWaitTimeSeconds {
-		return &ValidationError{Field: "PollIntervalSeconds", Message: "must be less than MaxWaitTimeSeconds"}
+	if c.ArgoWorkflowName != "" && c.Mode == ModeNamespace {
+		return &ValidationError{Field: "ArgoWorkflowName", Message: "is not supported in namespace mode"}
 	}
+	return nil
+}
 
-	if err := c.validateResultsPath(); err != nil {
-		return err
+// GetResultDoneFile returns the done marker file, by default the result file path with a .done suffix
+func (c *Config) GetResultDoneFile() string {
+	if c.ResultDoneFile != "" {
+		return c.ResultDoneFile
 	}
+	return c.ResultsPath + ".done"
+}
 
-	return nil
+// IsResultsGlob reports whether ResultsPath is a glob matching several result files
+func (c *Config) IsResultsGlob() bool {
+	return strings.ContainsAny(c.ResultsPath, "*?[")
 }
 
 // validateResultsPath ensures the results path is safe
@@ -141,9 +1939,118 @@ func (c *Config) validateResultsPath() error {
 		}
 	}
 
+	if c.IsResultsGlob() {
+		if _, err := filepath.Match(cleanPath, ""); err != nil {
+			return &ValidationError{Field: "ResultsPath", Message: fmt.Sprintf("invalid glob pattern: %v", err)}
+		}
+	}
+	if c.ResultsExpectedCount < 0 {
+		return &ValidationError{Field: "ResultsExpectedCount", Message: "must not be negative"}
+	}
+	if (c.IsResultsGlob() || c.ResultsDir != "") && c.AggregateConcurrency < 1 {
+		return &ValidationError{Field: "AggregateConcurrency", Message: "must be at least 1"}
+	}
+
+	if c.AllowedResultsBase != "" {
+		base := filepath.Clean(c.AllowedResultsBase)
+		if !filepath.IsAbs(base) {
+			return &ValidationError{Field: "AllowedResultsBase", Message: "path must be absolute"}
+		}
+		if !result.IsWithinBase(base, cleanPath) {
+			return &ValidationError{
+				Field:   "ResultsPath",
+				Message: fmt.Sprintf("path must be under the allowed results base %s", base),
+			}
+		}
+		if c.ResultsDir != "" && !result.IsWithinBase(base, c.ResultsDir) {
+			return &ValidationError{
+				Field:   "ResultsDir",
+				Message: fmt.Sprintf("path must be under the allowed results base %s", base),
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateCommitStatus ensures the commit status integration is fully configured when enabled
+func (c *Config) validateCommitStatus() error {
+	if c.CommitStatusRepo == "" {
+		return nil
+	}
+
+	if owner, name, ok := strings.Cut(c.CommitStatusRepo, "/"); !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return &ValidationError{Field: "CommitStatusRepo", Message: "must be in the form owner/name"}
+	}
+	if c.CommitStatusSHA == "" {
+		return &ValidationError{Field: "CommitStatusSHA", Message: "is required when CommitStatusRepo is set"}
+	}
+	u, err := url.Parse(c.CommitStatusAPIURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return &ValidationError{Field: "CommitStatusAPIURL", Message: "must be an absolute http or https URL"}
+	}
+	if c.CommitStatusTimeoutSeconds <= 0 {
+		return &ValidationError{Field: "CommitStatusTimeoutSeconds", Message: "must be positive"}
+	}
+
+	return nil
+}
+
+// validateCallback ensures the outcome callback settings are consistent
+func (c *Config) validateCallback() error {
+	if c.CloudEventsSink != "" {
+		u, err := url.Parse(c.CloudEventsSink)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return &ValidationError{Field: "CloudEventsSink", Message: "must be an absolute http or https URL"}
+		}
+	}
+	switch c.CloudEventsMode {
+	case "", CloudEventsModeStructured, CloudEventsModeBinary:
+	default:
+		return &ValidationError{
+			Field:   "CloudEventsMode",
+			Message: fmt.Sprintf("must be either '%s' or '%s'", CloudEventsModeStructured, CloudEventsModeBinary),
+		}
+	}
+	if c.UsesCallbackSettings() {
+		if c.CallbackTimeoutSeconds <= 0 {
+			return &ValidationError{Field: "CallbackTimeoutSeconds", Message: "must be positive"}
+		}
+		if c.CallbackMaxRetries < 0 {
+			return &ValidationError{Field: "CallbackMaxRetries", Message: "must not be negative"}
+		}
+		if c.CallbackCAFile != "" && !filepath.IsAbs(c.CallbackCAFile) {
+			return &ValidationError{Field: "CallbackCAFile", Message: "must be an absolute path"}
+		}
+	}
+	if c.CallbackURL == "" {
+		return nil
+	}
+
+	u, err := url.Parse(c.CallbackURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return &ValidationError{Field: "CallbackURL", Message: "must be an absolute http or https URL"}
+	}
+	if c.CallbackFailurePolicy != CallbackFailurePolicyBestEffort && c.CallbackFailurePolicy != CallbackFailurePolicyFatal {
+		return &ValidationError{
+			Field:   "CallbackFailurePolicy",
+			Message: fmt.Sprintf("must be either '%s' or '%s'", CallbackFailurePolicyBestEffort, CallbackFailurePolicyFatal),
+		}
+	}
+	if (c.CallbackClientCertFile == "") != (c.CallbackClientKeyFile == "") {
+		return &ValidationError{Field: "CallbackClientCertFile", Message: "client certificate and key must be set together"}
+	}
+
 	return nil
 }
 
+// UsesCallbackSettings reports whether any outcome consumer that shares the CALLBACK_CA_FILE,
+// CALLBACK_TIMEOUT_SECONDS and CALLBACK_MAX_RETRIES settings is enabled
+func (c *Config) UsesCallbackSettings() bool {
+	return c.CallbackURL != "" || c.CloudEventsSink != "" || c.OTLPLogsEndpoint != "" ||
+		c.NotifyWebhookURL != "" || c.EmailSMTPSecretDir != ""
+}
+
 // GetPollInterval returns poll interval as duration
 func (c *Config) GetPollInterval() time.Duration {
 	return time.Duration(c.PollIntervalSeconds) * time.Second
@@ -154,6 +2061,155 @@ func (c *Config) GetMaxWaitTime() time.Duration {
 	return time.Duration(c.MaxWaitTimeSeconds) * time.Second
 }
 
+// GetCallbackTimeout returns the callback request timeout as duration
+func (c *Config) GetCallbackTimeout() time.Duration {
+	return time.Duration(c.CallbackTimeoutSeconds) * time.Second
+}
+
+// GetMessageBusTimeout returns the timeout of a single message bus publish attempt
+func (c *Config) GetMessageBusTimeout() time.Duration {
+	return time.Duration(c.MessageBusTimeoutSeconds) * time.Second
+}
+
+// GetFleetManagerTimeout returns the timeout of a single fleet manager call
+func (c *Config) GetFleetManagerTimeout() time.Duration {
+	return time.Duration(c.FleetManagerTimeoutSeconds) * time.Second
+}
+
+// GetCommitStatusURL returns the statuses API endpoint for the configured repository and commit
+func (c *Config) GetCommitStatusURL() string {
+	return fmt.Sprintf("%s/repos/%s/statuses/%s", strings.TrimSuffix(c.CommitStatusAPIURL, "/"), c.CommitStatusRepo, c.CommitStatusSHA)
+}
+
+// GetAggregatorGVR parses AggregatorResource ("group/version/resource", or "version/resource" for
+// the core group) into a GroupVersionResource
+func (c *Config) GetAggregatorGVR() (schema.GroupVersionResource, error) {
+	parts := strings.Split(c.AggregatorResource, "/")
+	if len(parts) == 2 {
+		parts = append([]string{""}, parts...)
+	}
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return schema.GroupVersionResource{}, fmt.Errorf("must be in the form group/version/resource, got %q", c.AggregatorResource)
+	}
+	return schema.GroupVersionResource{Group: parts[0], Version: parts[1], Resource: parts[2]}, nil
+}
+
+// GetCommitStatusTimeout returns the commit status request timeout as duration
+func (c *Config) GetCommitStatusTimeout() time.Duration {
+	return time.Duration(c.CommitStatusTimeoutSeconds) * time.Second
+}
+
+// GetMaxResultAge returns the maximum result file age as duration (zero disables the check)
+func (c *Config) GetMaxResultAge() time.Duration {
+	return time.Duration(c.MaxResultAgeSeconds) * time.Second
+}
+
+// GetNonTerminalReasons returns the configured non-terminal result reasons
+func (c *Config) GetNonTerminalReasons() []string {
+	return splitList(c.NonTerminalReasons)
+}
+
+// GetRetryableErrorPatterns returns the retryable error patterns as a list
+func (c *Config) GetRetryableErrorPatterns() []string {
+	return splitList(c.RetryableErrorPatterns)
+}
+
+// GetFleetManagerTokenScopes returns the scopes requested in the fleet manager token exchange
+func (c *Config) GetFleetManagerTokenScopes() []string {
+	return splitList(c.FleetManagerTokenScopes)
+}
+
+// GetEmailOnReasons returns the failure reasons that trigger the email notification
+func (c *Config) GetEmailOnReasons() []string {
+	return splitList(c.EmailOnReasons)
+}
+
+// GetMessageKVSuffixKeys returns the keys of the key=value message suffix as a list
+func (c *Config) GetMessageKVSuffixKeys() []string {
+	return splitList(c.MessageKVSuffix)
+}
+
+// GetConditionTypeRoutes parses the reason-to-condition-type routes, in order
+func (c *Config) GetConditionTypeRoutes() ([]reporter.ConditionRoute, error) {
+	var routes []reporter.ConditionRoute
+	for _, item := range splitList(c.ConditionTypeRoutes) {
+		pattern, conditionType, ok := strings.Cut(item, "=")
+		pattern, conditionType = strings.TrimSpace(pattern), strings.TrimSpace(conditionType)
+		if !ok || pattern == "" || conditionType == "" {
+			return nil, fmt.Errorf("route %q must be in the form pattern=ConditionType", item)
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("route %q has an invalid pattern: %w", item, err)
+		}
+		routes = append(routes, reporter.ConditionRoute{Pattern: pattern, ConditionType: conditionType})
+	}
+	return routes, nil
+}
+
+// ResultsDirCondition maps a check's result file in the results directory to its condition type
+type ResultsDirCondition struct {
+	File          string
+	ConditionType string
+}
+
+// GetResultsDirConditions parses the file-to-condition-type mapping of the results directory, in order
+func (c *Config) GetResultsDirConditions() ([]ResultsDirCondition, error) {
+	var checks []ResultsDirCondition
+	seen := map[string]bool{}
+	for _, item := range splitList(c.ResultsDirConditions) {
+		file, conditionType, ok := strings.Cut(item, "=")
+		file, conditionType = strings.TrimSpace(file), strings.TrimSpace(conditionType)
+		if !ok || file == "" || conditionType == "" {
+			return nil, fmt.Errorf("mapping %q must be in the form file=ConditionType", item)
+		}
+		if file != filepath.Base(file) || file == "." || file == ".." {
+			return nil, fmt.Errorf("mapping %q must name a file directly in the results directory", item)
+		}
+		if seen[file] {
+			return nil, fmt.Errorf("file %q is mapped more than once", file)
+		}
+		seen[file] = true
+		checks = append(checks, ResultsDirCondition{File: file, ConditionType: conditionType})
+	}
+	return checks, nil
+}
+
+// splitList splits a comma-separated value, trimming whitespace and dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// GetTimeoutGrowthGrace returns the deadline extension for a still-growing result file as duration
+func (c *Config) GetTimeoutGrowthGrace() time.Duration {
+	return time.Duration(c.TimeoutGrowthGraceSeconds) * time.Second
+}
+
+// GetLogDedupInterval returns the log deduplication summary interval as duration
+func (c *Config) GetLogDedupInterval() time.Duration {
+	return time.Duration(c.LogDedupIntervalSeconds) * time.Second
+}
+
+// GetCleanupGracePeriod returns how long a success result is held under the escalate cleanup failure policy
+func (c *Config) GetCleanupGracePeriod() time.Duration {
+	return time.Duration(c.CleanupGraceSeconds) * time.Second
+}
+
+// GetResultParseSettleDelay returns the delay before retrying the first failed result file parse
+func (c *Config) GetResultParseSettleDelay() time.Duration {
+	return time.Duration(c.ResultParseSettleSeconds) * time.Second
+}
+
+// GetInitialStatusRetryDelay returns the delay between initial container status retries as duration
+func (c *Config) GetInitialStatusRetryDelay() time.Duration {
+	return time.Duration(c.InitialStatusRetryDelaySeconds) * time.Second
+}
+
 func getEnvOrDefault(key, defaultValue string) string {
 	value := strings.TrimSpace(os.Getenv(key))
 	if value == "" {
@@ -186,3 +2242,29 @@ func getEnvIntOrDefault(key string, defaultValue int) (int, error) {
 
 	return intValue, nil
 }
+
+func getEnvBoolOrDefault(key string, defaultValue bool) (bool, error) {
+	value := strings.TrimSpace(os.Getenv(key))
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	boolValue, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, &ValidationError{
+			Field:   key,
+			Message: fmt.Sprintf("must be a valid boolean, got: %s", value),
+		}
+	}
+
+	return boolValue, nil
+}
+
+// isLoopbackHost reports whether host names the loopback interface
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -2,12 +2,14 @@ package config_test
 
 import (
 	"os"
+	"path/filepath"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"github.com/openshift-hyperfleet/status-reporter/pkg/config"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/reporter"
 )
 
 var _ = Describe("Config", func() {
@@ -19,6 +21,72 @@ var _ = Describe("Config", func() {
 			"JOB_NAME", "JOB_NAMESPACE", "POD_NAME",
 			"RESULTS_PATH", "POLL_INTERVAL_SECONDS", "MAX_WAIT_TIME_SECONDS",
 			"CONDITION_TYPE", "LOG_LEVEL", "ADAPTER_CONTAINER_NAME",
+			"SINGLE_ADAPTER", "MESSAGE_SINGLE_LINE", "CALLBACK_URL",
+			"CALLBACK_TOKEN", "CALLBACK_TOKEN_FILE", "CALLBACK_CA_FILE",
+			"CALLBACK_CLIENT_CERT_FILE", "CALLBACK_CLIENT_KEY_FILE",
+			"CALLBACK_TIMEOUT_SECONDS", "CALLBACK_MAX_RETRIES",
+			"CALLBACK_FAILURE_POLICY", "SKIP_SENTINEL_PATH",
+			"MAX_RESULT_AGE_SECONDS", "CHECK_ON_CONTAINER_CHANGE",
+			"ALLOW_SPEC_UPDATE_FALLBACK", "QUIET_STARTUP", "EXPECT_FAILURE",
+			"DEBOUNCE_TERMINATION", "OUTCOME_SOCKET_PATH",
+			"OUTCOME_SOCKET_STRICT", "INITIAL_STATUS_RETRIES",
+			"INITIAL_STATUS_RETRY_DELAY_SECONDS", "CONFIRM_SUCCESS_STABLE",
+			"MODE", "JOB_LABEL_SELECTOR", "LOG_DEDUP_INTERVAL_SECONDS",
+			"STATUS_POINTER", "REASON_POINTER", "MESSAGE_POINTER",
+			"DETAILS_POINTER", "NON_TERMINAL_REASONS", "TIMING_OUTPUT_PATH",
+			"ACTIVE_DEADLINE_CHECK", "DEGRADED_DETAILS_POINTER",
+			"DEGRADED_STATUS", "DEGRADED_REASON", "EXIT_ON_POD_TERMINATING",
+			"RESULT_PARSE_SETTLE_SECONDS", "RUN_ID", "NOTE_PARSE_FAILURE",
+			"CLEANUP_FAILURE_POLICY", "CLEANUP_GRACE_SECONDS",
+			"AUDIT_LOG_PATH", "RETRYABLE_ERROR_PATTERNS",
+			"REPORT_MEMORY_LIMIT", "DEADLINE_POLLING", "MESSAGE_KV_SUFFIX",
+			"STOP_POLLING_ON_TERMINATION", "POD_NAME_IS_PREFIX",
+			"REPORTING_STATE_PATH", "ALLOWED_RESULTS_BASE", "OTEL_EXPORTER_OTLP_ENDPOINT", "CLOUDEVENTS_SINK",
+			"COLLAPSE_DUPLICATE_CONDITIONS", "VALIDATE_ONLY",
+			"STARTUP_PREFLIGHT", "COMMIT_STATUS_REPO", "COMMIT_STATUS_SHA",
+			"COMMIT_STATUS_API_URL", "COMMIT_STATUS_TOKEN_FILE",
+			"COMMIT_STATUS_TIMEOUT_SECONDS", "MIN_FAILURE_SEVERITY",
+			"USE_FILE_LOCK", "RECORD_ADAPTER_IMAGE", "FINAL_STATUS_LINE",
+			"VERIFY_CHECKSUM", "CHECKSUM_SUFFIX", "CHECKSUM_STRICT", "REQUIRE_RESULT_CHECKSUM",
+			"OTEL_EXPORTER_OTLP_LOGS_ENDPOINT", "OTEL_SERVICE_NAME",
+			"REPORT_ON_PANIC", "CONDITION_TYPE_ROUTES", "RECORD_RESTARTS",
+			"REQUIRE_REASON_MESSAGE", "TIMEOUT_GROWTH_GRACE_SECONDS",
+			"ADAPTER_HEALTH_URL", "ADAPTER_HEALTH_MODE", "AGGREGATOR_RESOURCE",
+			"AGGREGATOR_NAME", "AGGREGATOR_NAMESPACE", "RESULT_FILE_WATCH",
+			"RESULT_HTTP_ADDR", "RESULT_HTTP_TOKEN_FILE", "RESULT_SOCKET_PATH",
+			"RESULT_FROM_TERMINATION_MESSAGE", "RESULT_FORMAT",
+			"RESULTS_EXPECTED_COUNT", "AGGREGATE_CONCURRENCY", "RESULT_STREAM",
+			"RESULT_STREAM_PROGRESS", "RESULTS_DIR",
+			"RESULTS_DIR_CONDITIONS", "REQUIRE_DONE_FILE", "RESULT_DONE_FILE",
+			"RESULT_PROJECTED_VOLUME", "TARGET_GROUP", "TARGET_VERSION",
+			"TARGET_KIND", "TARGET_NAME", "TARGET_NAMESPACE",
+			"PUBLISH_POD_CONDITION", "EMIT_EVENTS", "RESULT_ANNOTATION",
+			"RESULT_ANNOTATION_KEY", "RESULT_ANNOTATION_DIGEST",
+			"RESULT_CONFIGMAP", "CALLBACK_INCLUDE_RESULT",
+			"NOTIFY_WEBHOOK_URL", "NOTIFY_WEBHOOK_FORMAT", "NOTIFY_ON_SUCCESS",
+			"MESSAGE_BUS", "MESSAGE_BUS_URL", "MESSAGE_BUS_TOPIC",
+			"MESSAGE_BUS_TOKEN_FILE", "MESSAGE_BUS_MAX_RETRIES",
+			"MESSAGE_BUS_CREDS_FILE", "MESSAGE_BUS_NKEY_SEED_FILE", "MESSAGE_BUS_USER",
+			"MESSAGE_BUS_PASSWORD_FILE", "MESSAGE_BUS_CA_FILE",
+			"MESSAGE_BUS_TIMEOUT_SECONDS", "CLOUDEVENTS_MODE",
+			"RESULT_ARCHIVE_BUCKET", "RESULT_ARCHIVE_ENDPOINT",
+			"RESULT_ARCHIVE_REGION", "RESULT_ARCHIVE_PREFIX",
+			"RESULT_ARCHIVE_ACCESS_KEY_FILE", "RESULT_ARCHIVE_SECRET_KEY_FILE",
+			"RESULT_ARCHIVE_LOG_TAIL_LINES", "SINK_FAILURE_POLICY",
+			"RESULT_ARCHIVE_ADDRESSING", "RESULT_ARCHIVE_WEB_IDENTITY_TOKEN_FILE",
+			"RESULT_ARCHIVE_ROLE_ARN", "RESULT_ARCHIVE_STS_ENDPOINT",
+			"ARGO_OUTPUTS_DIR", "ARGO_WORKFLOW_NAME", "ARGO_NODE_ID",
+			"JOBSET_ROLLUP", "REPORT_TO_OWNER", "REPORT_TO_OWNER_MODE",
+			"SERVER_SIDE_APPLY", "REPORT_IN_PROGRESS", "TIMEOUT_STATUS",
+			"FLEET_MANAGER_ENDPOINT", "FLEET_MANAGER_CLUSTER_ID",
+			"FLEET_MANAGER_METHOD", "FLEET_MANAGER_TOKEN_FILE",
+			"FLEET_MANAGER_CA_FILE", "FLEET_MANAGER_FATAL",
+			"FLEET_MANAGER_TOKEN_EXCHANGE_URL", "FLEET_MANAGER_TOKEN_AUDIENCE", "FLEET_MANAGER_TOKEN_SCOPES",
+			"FLEET_MANAGER_MAX_RETRIES", "FLEET_MANAGER_TIMEOUT_SECONDS",
+			"PROGRESSING_CONDITION", "PROGRESSING_CONDITION_TYPE",
+			"CORRELATION_ID", "OBSERVED_GENERATION", "LEASE_HEARTBEAT",
+			"EMAIL_SMTP_SECRET_DIR", "EMAIL_ON_REASONS",
+			"SEVERITY_POINTER", "CONDITIONS_POINTER", "CORRELATION_ID_POINTER", "OBSERVED_GENERATION_POINTER",
 		}
 		for _, key := range envVars {
 			originalEnv[key] = os.Getenv(key)
@@ -62,6 +130,11 @@ var _ = Describe("Config", func() {
 				Expect(cfg.ConditionType).To(Equal("Available"))
 				Expect(cfg.LogLevel).To(Equal("info"))
 				Expect(cfg.AdapterContainerName).To(Equal(""))
+				Expect(cfg.SingleAdapter).To(BeFalse())
+				Expect(cfg.ReportOnPanic).To(BeTrue())
+				Expect(cfg.ServerSideApply).To(BeTrue())
+				Expect(cfg.TimeoutStatus).To(Equal(reporter.ConditionStatusFalse))
+				Expect(cfg.ReportInProgress).To(BeFalse())
 			})
 
 			It("uses custom values when provided", func() {
@@ -71,6 +144,7 @@ var _ = Describe("Config", func() {
 				Expect(os.Setenv("CONDITION_TYPE", "Ready")).To(Succeed())
 				Expect(os.Setenv("LOG_LEVEL", "debug")).To(Succeed())
 				Expect(os.Setenv("ADAPTER_CONTAINER_NAME", "my-adapter")).To(Succeed())
+				Expect(os.Setenv("SINGLE_ADAPTER", "true")).To(Succeed())
 
 				cfg, err := config.Load()
 				Expect(err).NotTo(HaveOccurred())
@@ -80,6 +154,49 @@ var _ = Describe("Config", func() {
 				Expect(cfg.ConditionType).To(Equal("Ready"))
 				Expect(cfg.LogLevel).To(Equal("debug"))
 				Expect(cfg.AdapterContainerName).To(Equal("my-adapter"))
+				Expect(cfg.SingleAdapter).To(BeTrue())
+			})
+
+			It("disables server-side apply with SERVER_SIDE_APPLY=false", func() {
+				Expect(os.Setenv("SERVER_SIDE_APPLY", "false")).To(Succeed())
+
+				cfg, err := config.Load()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.ServerSideApply).To(BeFalse())
+			})
+
+			It("loads MESSAGE_SINGLE_LINE", func() {
+				Expect(os.Setenv("MESSAGE_SINGLE_LINE", "true")).To(Succeed())
+
+				cfg, err := config.Load()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.MessageSingleLine).To(BeTrue())
+			})
+
+			It("derives the OTLP logs endpoint from OTEL_EXPORTER_OTLP_ENDPOINT", func() {
+				Expect(os.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://otel-collector:4318/")).To(Succeed())
+
+				cfg, err := config.Load()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.OTLPLogsEndpoint).To(Equal("http://otel-collector:4318/v1/logs"))
+			})
+
+			It("prefers OTEL_EXPORTER_OTLP_LOGS_ENDPOINT", func() {
+				Expect(os.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://otel-collector:4318")).To(Succeed())
+				Expect(os.Setenv("OTEL_EXPORTER_OTLP_LOGS_ENDPOINT", "http://logs-collector:4318/v1/logs")).To(Succeed())
+
+				cfg, err := config.Load()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.OTLPLogsEndpoint).To(Equal("http://logs-collector:4318/v1/logs"))
+			})
+
+			It("enables strict checksum verification with REQUIRE_RESULT_CHECKSUM", func() {
+				Expect(os.Setenv("REQUIRE_RESULT_CHECKSUM", "true")).To(Succeed())
+
+				cfg, err := config.Load()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.VerifyChecksum).To(BeTrue())
+				Expect(cfg.ChecksumStrict).To(BeTrue())
 			})
 
 			It("trims whitespace from values", func() {
@@ -103,6 +220,18 @@ var _ = Describe("Config", func() {
 				Expect(err.Error()).To(ContainSubstring("JOB_NAME"))
 			})
 
+			It("does not require JOB_NAME for an Argo Workflow step", func() {
+				Expect(os.Setenv("JOB_NAMESPACE", "test-namespace")).To(Succeed())
+				Expect(os.Setenv("POD_NAME", "test-pod")).To(Succeed())
+				Expect(os.Setenv("ARGO_WORKFLOW_NAME", "provision")).To(Succeed())
+				Expect(os.Setenv("ARGO_NODE_ID", "provision-123")).To(Succeed())
+
+				cfg, err := config.Load()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.JobName).To(BeEmpty())
+				Expect(cfg.ArgoWorkflowName).To(Equal("provision"))
+			})
+
 			It("returns error when JOB_NAMESPACE is missing", func() {
 				Expect(os.Setenv("JOB_NAME", "test-job")).To(Succeed())
 				Expect(os.Setenv("POD_NAME", "test-pod")).To(Succeed())
@@ -145,6 +274,89 @@ var _ = Describe("Config", func() {
 				Expect(err.Error()).To(ContainSubstring("MAX_WAIT_TIME_SECONDS"))
 			})
 		})
+
+		Context("with invalid boolean values", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("JOB_NAME", "test-job")).To(Succeed())
+				Expect(os.Setenv("JOB_NAMESPACE", "test-namespace")).To(Succeed())
+				Expect(os.Setenv("POD_NAME", "test-pod")).To(Succeed())
+			})
+
+			It("returns error for invalid SINGLE_ADAPTER", func() {
+				Expect(os.Setenv("SINGLE_ADAPTER", "maybe")).To(Succeed())
+
+				_, err := config.Load()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("SINGLE_ADAPTER"))
+				Expect(err.Error()).To(ContainSubstring("must be a valid boolean"))
+			})
+		})
+
+		Context("in namespace mode", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("MODE", "namespace")).To(Succeed())
+				Expect(os.Setenv("JOB_NAMESPACE", "test-namespace")).To(Succeed())
+			})
+
+			It("does not require JOB_NAME or POD_NAME", func() {
+				cfg, err := config.Load()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.Mode).To(Equal(config.ModeNamespace))
+				Expect(cfg.JobName).To(BeEmpty())
+				Expect(cfg.JobLabelSelector).To(Equal(config.DefaultJobLabelSelector))
+			})
+
+			It("still requires JOB_NAMESPACE", func() {
+				Expect(os.Unsetenv("JOB_NAMESPACE")).To(Succeed())
+
+				_, err := config.Load()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("JOB_NAMESPACE"))
+			})
+		})
+
+		Context("in stdin mode", func() {
+			It("requires JOB_NAME but not POD_NAME", func() {
+				Expect(os.Setenv("MODE", "stdin")).To(Succeed())
+				Expect(os.Setenv("JOB_NAMESPACE", "test-namespace")).To(Succeed())
+
+				_, err := config.Load()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("JOB_NAME"))
+
+				Expect(os.Setenv("JOB_NAME", "test-job")).To(Succeed())
+				cfg, err := config.Load()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.Mode).To(Equal(config.ModeStdin))
+				Expect(cfg.PodName).To(BeEmpty())
+			})
+		})
+
+		Context("in wrap mode", func() {
+			It("does not require POD_NAME", func() {
+				Expect(os.Setenv("MODE", "wrap")).To(Succeed())
+				Expect(os.Setenv("JOB_NAME", "test-job")).To(Succeed())
+				Expect(os.Setenv("JOB_NAMESPACE", "test-namespace")).To(Succeed())
+
+				cfg, err := config.Load()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.Mode).To(Equal(config.ModeWrap))
+				Expect(cfg.PodName).To(BeEmpty())
+			})
+		})
+
+		Context("with an unknown mode", func() {
+			It("returns error", func() {
+				Expect(os.Setenv("MODE", "cluster")).To(Succeed())
+				Expect(os.Setenv("JOB_NAME", "test-job")).To(Succeed())
+				Expect(os.Setenv("JOB_NAMESPACE", "test-namespace")).To(Succeed())
+				Expect(os.Setenv("POD_NAME", "test-pod")).To(Succeed())
+
+				_, err := config.Load()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Mode"))
+			})
+		})
 	})
 
 	Describe("Validate", func() {
@@ -208,6 +420,20 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Context("with invalid result age", func() {
+			It("returns error for negative max result age", func() {
+				cfg := &config.Config{
+					ResultsPath:         "/results/result.json",
+					PollIntervalSeconds: 2,
+					MaxWaitTimeSeconds:  300,
+					MaxResultAgeSeconds: -1,
+				}
+				err := cfg.Validate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("MaxResultAgeSeconds"))
+			})
+		})
+
 		Context("with invalid results path", func() {
 			It("returns error for relative path", func() {
 				cfg := &config.Config{
@@ -220,6 +446,52 @@ var _ = Describe("Config", func() {
 				Expect(err.Error()).To(ContainSubstring("must be absolute"))
 			})
 
+			It("returns error for a path outside the allowed base", func() {
+				cfg := &config.Config{
+					ResultsPath:         "/etc/../results-other/result.json",
+					PollIntervalSeconds: 2,
+					MaxWaitTimeSeconds:  300,
+					AllowedResultsBase:  "/results",
+				}
+				err := cfg.Validate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("allowed results base"))
+			})
+
+			It("accepts a path under the allowed base", func() {
+				cfg := &config.Config{
+					ResultsPath:         "/results/adapter/result.json",
+					PollIntervalSeconds: 2,
+					MaxWaitTimeSeconds:  300,
+					AllowedResultsBase:  "/results/",
+				}
+				Expect(cfg.Validate()).To(Succeed())
+			})
+
+			It("returns error for a path under the base that is a symlink to another directory", func() {
+				base := GinkgoT().TempDir()
+				Expect(os.Symlink(GinkgoT().TempDir(), filepath.Join(base, "escape"))).To(Succeed())
+				cfg := &config.Config{
+					ResultsPath:         filepath.Join(base, "escape", "result.json"),
+					PollIntervalSeconds: 2,
+					MaxWaitTimeSeconds:  300,
+					AllowedResultsBase:  base,
+				}
+				Expect(cfg.Validate()).To(MatchError(ContainSubstring("allowed results base")))
+			})
+
+			It("returns error for a results directory outside the allowed base", func() {
+				cfg := &config.Config{
+					ResultsPath:          "/results/result.json",
+					ResultsDir:           "/var/checks",
+					ResultsDirConditions: "dns.json=DNSReady",
+					PollIntervalSeconds:  2,
+					MaxWaitTimeSeconds:   300,
+					AllowedResultsBase:   "/results",
+					AggregateConcurrency: 8,
+				}
+				Expect(cfg.Validate()).To(MatchError(ContainSubstring("ResultsDir: path must be under the allowed results base")))
+			})
 
 			It("returns error for directory path", func() {
 				cfg := &config.Config{
@@ -234,6 +506,1091 @@ var _ = Describe("Config", func() {
 		})
 	})
 
+	Describe("Validate callback", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				JobName:                "validate-cluster-1",
+				ResultsPath:            "/results/result.json",
+				PollIntervalSeconds:    2,
+				MaxWaitTimeSeconds:     300,
+				CallbackURL:            "https://orchestrator.example.com/runs",
+				CallbackFailurePolicy:  config.CallbackFailurePolicyBestEffort,
+				CallbackTimeoutSeconds: 10,
+				CallbackMaxRetries:     3,
+			}
+		})
+
+		It("accepts a valid callback configuration", func() {
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("returns error for a non-http URL", func() {
+			cfg.CallbackURL = "ftp://orchestrator.example.com"
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("CallbackURL"))
+		})
+
+		It("returns error for an unknown failure policy", func() {
+			cfg.CallbackFailurePolicy = "sometimes"
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("CallbackFailurePolicy"))
+		})
+
+		It("returns error when only the client certificate is set", func() {
+			cfg.CallbackClientCertFile = "/certs/tls.crt"
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be set together"))
+		})
+
+		It("returns error for negative retries", func() {
+			cfg.CallbackMaxRetries = -1
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("CallbackMaxRetries"))
+		})
+
+		It("validates the shared settings for every consumer without a callback URL", func() {
+			cfg.CallbackURL = ""
+			cfg.CallbackTimeoutSeconds = 0
+			Expect(cfg.Validate()).To(Succeed())
+
+			for _, enable := range []func(){
+				func() { cfg.CloudEventsSink = "http://broker-ingress.knative-eventing.svc/default/default" },
+				func() { cfg.OTLPLogsEndpoint = "http://otel-collector:4318/v1/logs" },
+				func() { cfg.NotifyWebhookURL = "https://hooks.slack.com/services/T000/B000/XXXX" },
+				func() { cfg.EmailSMTPSecretDir, cfg.EmailOnReasons = "/etc/smtp", "AdapterTimeout" },
+			} {
+				cfg.CloudEventsSink, cfg.OTLPLogsEndpoint, cfg.NotifyWebhookURL, cfg.EmailSMTPSecretDir = "", "", "", ""
+				enable()
+				cfg.CallbackTimeoutSeconds = 0
+				Expect(cfg.Validate()).To(MatchError(ContainSubstring("CallbackTimeoutSeconds")))
+
+				cfg.CallbackTimeoutSeconds = 10
+				cfg.CallbackMaxRetries = -1
+				Expect(cfg.Validate()).To(MatchError(ContainSubstring("CallbackMaxRetries")))
+				cfg.CallbackMaxRetries = 3
+			}
+		})
+
+		It("returns error for a relative CA file", func() {
+			cfg.CallbackCAFile = "certs/ca.crt"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("CallbackCAFile")))
+		})
+
+		It("returns error for a non-http CloudEvents sink", func() {
+			cfg.CloudEventsSink = "broker.example.com"
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("CloudEventsSink"))
+		})
+
+		It("returns error for an unknown CloudEvents mode", func() {
+			cfg.CloudEventsSink = "http://broker-ingress.knative-eventing.svc/default/default"
+			cfg.CloudEventsMode = config.CloudEventsModeBinary
+			Expect(cfg.Validate()).NotTo(HaveOccurred())
+
+			cfg.CloudEventsMode = "batched"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("CloudEventsMode")))
+		})
+
+		It("returns error for an unknown sink failure policy", func() {
+			cfg.SinkFailurePolicy = reporter.SinkFailurePolicyAll
+			Expect(cfg.Validate()).To(Succeed())
+
+			cfg.SinkFailurePolicy = "majority"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("SinkFailurePolicy")))
+		})
+
+		It("ignores callback settings when the URL is empty", func() {
+			cfg.CallbackURL = ""
+			cfg.CallbackFailurePolicy = ""
+			Expect(cfg.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("GetConditionTypeRoutes", func() {
+		It("parses routes in order", func() {
+			cfg := &config.Config{ConditionTypeRoutes: "DNS*=DNSReady, Cert*=CertificatesReady"}
+			routes, err := cfg.GetConditionTypeRoutes()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(routes).To(Equal([]reporter.ConditionRoute{
+				{Pattern: "DNS*", ConditionType: "DNSReady"},
+				{Pattern: "Cert*", ConditionType: "CertificatesReady"},
+			}))
+		})
+
+		It("rejects a route without a condition type", func() {
+			cfg := &config.Config{
+				ResultsPath:         "/results/result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+				ConditionTypeRoutes: "DNS*",
+			}
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ConditionTypeRoutes"))
+		})
+
+		It("rejects an invalid pattern", func() {
+			cfg := &config.Config{ConditionTypeRoutes: "DNS[=DNSReady"}
+			_, err := cfg.GetConditionTypeRoutes()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Validate adapter container name", func() {
+		It("returns error when the adapter is the status reporter's own container", func() {
+			cfg := &config.Config{
+				ResultsPath:          "/results/result.json",
+				PollIntervalSeconds:  2,
+				MaxWaitTimeSeconds:   300,
+				AdapterContainerName: "status-reporter",
+			}
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("AdapterContainerName"))
+		})
+	})
+
+	Describe("Validate minimum failure severity", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				ResultsPath:         "/results/result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+			}
+		})
+
+		It("accepts a known severity in any case", func() {
+			cfg.MinFailureSeverity = "High"
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("returns error for an unknown severity", func() {
+			cfg.MinFailureSeverity = "urgent"
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("MinFailureSeverity"))
+		})
+	})
+
+	Describe("Validate checksum verification", func() {
+		It("returns error when the checksum suffix is empty", func() {
+			cfg := &config.Config{
+				ResultsPath:         "/results/result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+				VerifyChecksum:      true,
+			}
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ChecksumSuffix"))
+		})
+
+		It("returns error when a strict checksum is combined with a results directory", func() {
+			cfg := &config.Config{
+				ResultsPath:          "/results/result.json",
+				PollIntervalSeconds:  2,
+				MaxWaitTimeSeconds:   300,
+				VerifyChecksum:       true,
+				ChecksumSuffix:       ".sha256",
+				ChecksumStrict:       true,
+				ResultsDir:           "/results",
+				ResultsDirConditions: "dns.json=DNSReady",
+			}
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ChecksumStrict"))
+		})
+	})
+
+	Describe("Validate notification webhook", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				ResultsPath:            "/results/adapter-result.json",
+				PollIntervalSeconds:    2,
+				MaxWaitTimeSeconds:     300,
+				NotifyWebhookURL:       "https://hooks.slack.com/services/T000/B000/XXXX",
+				NotifyWebhookFormat:    reporter.NotifyFormatTeams,
+				CallbackTimeoutSeconds: 10,
+			}
+		})
+
+		It("accepts a webhook URL and format", func() {
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("returns error for a relative URL", func() {
+			cfg.NotifyWebhookURL = "hooks.slack.com/services"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("NotifyWebhookURL")))
+		})
+
+		It("returns error for an unknown format", func() {
+			cfg.NotifyWebhookFormat = "discord"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("NotifyWebhookFormat")))
+		})
+	})
+
+	Describe("Validate message bus", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				ResultsPath:              "/results/adapter-result.json",
+				PollIntervalSeconds:      2,
+				MaxWaitTimeSeconds:       300,
+				MessageBus:               reporter.MessageBusNATS,
+				MessageBusURL:            "nats://nats.messaging:4222",
+				MessageBusTopic:          "hyperfleet.results",
+				MessageBusMaxRetries:     3,
+				MessageBusTimeoutSeconds: 10,
+			}
+		})
+
+		It("accepts a NATS and a Kafka REST Proxy configuration", func() {
+			Expect(cfg.Validate()).To(Succeed())
+
+			cfg.MessageBus = reporter.MessageBusKafkaREST
+			cfg.MessageBusURL = "https://kafka-rest.messaging:8082"
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("returns error for an unknown bus", func() {
+			cfg.MessageBus = "amqp"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("MessageBus")))
+		})
+
+		It("returns error when the URL scheme does not match the bus", func() {
+			cfg.MessageBusURL = "http://nats.messaging:4222"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("MessageBusURL")))
+
+			cfg.MessageBus = reporter.MessageBusKafkaREST
+			cfg.MessageBusURL = "nats://kafka-rest.messaging:8082"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("MessageBusURL")))
+		})
+
+		It("requires a topic", func() {
+			cfg.MessageBusTopic = ""
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("MessageBusTopic")))
+		})
+
+		It("requires a positive timeout", func() {
+			cfg.MessageBusTimeoutSeconds = 0
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("MessageBusTimeoutSeconds")))
+		})
+
+		It("accepts one NATS authentication method", func() {
+			cfg.MessageBusCredsFile = "/var/run/secrets/nats/user.creds"
+			Expect(cfg.Validate()).To(Succeed())
+
+			cfg.MessageBusTokenFile = "/var/run/secrets/nats/token"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("only one of the token, credentials, nkey seed or user")))
+		})
+
+		It("requires the password file with the user", func() {
+			cfg.MessageBusUser = "reporter"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("MessageBusPasswordFile")))
+
+			cfg.MessageBusPasswordFile = "/var/run/secrets/nats/password"
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("rejects NATS authentication with the Kafka REST Proxy", func() {
+			cfg.MessageBus = reporter.MessageBusKafkaREST
+			cfg.MessageBusURL = "https://kafka-rest.messaging:8082"
+			cfg.MessageBusNKeySeedFile = "/var/run/secrets/nats/user.nk"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("only supported with NATS")))
+		})
+	})
+
+	Describe("Validate fleet manager", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				ResultsPath:                "/results/adapter-result.json",
+				PollIntervalSeconds:        2,
+				MaxWaitTimeSeconds:         300,
+				FleetManagerEndpoint:       "https://fleet-manager.hyperfleet:8443",
+				FleetManagerClusterID:      "cluster-1",
+				FleetManagerMaxRetries:     3,
+				FleetManagerTimeoutSeconds: 10,
+			}
+		})
+
+		It("accepts https and plaintext http endpoints", func() {
+			Expect(cfg.Validate()).To(Succeed())
+
+			cfg.FleetManagerEndpoint = "http://localhost:15001"
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("returns error for a non-http endpoint", func() {
+			cfg.FleetManagerEndpoint = "fleet-manager.hyperfleet:8443"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("FleetManagerEndpoint")))
+		})
+
+		It("requires a cluster ID", func() {
+			cfg.FleetManagerClusterID = " "
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("FleetManagerClusterID")))
+		})
+
+		It("returns error for a malformed method", func() {
+			cfg.FleetManagerMethod = "ReportClusterValidation"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("FleetManagerMethod")))
+
+			cfg.FleetManagerMethod = "/hyperfleet.v2.Fleet/ReportClusterValidation"
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("requires a positive timeout", func() {
+			cfg.FleetManagerTimeoutSeconds = 0
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("FleetManagerTimeoutSeconds")))
+		})
+
+		It("requires a token file and an audience for the token exchange", func() {
+			cfg.FleetManagerTokenExchangeURL = "https://sts.googleapis.com/v1/token"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("requires FleetManagerTokenFile and FleetManagerTokenAudience")))
+
+			cfg.FleetManagerTokenFile = "/var/run/secrets/fleet/token"
+			cfg.FleetManagerTokenAudience = "//iam.googleapis.com/projects/1/locations/global/workloadIdentityPools/fleet/providers/cluster"
+			Expect(cfg.Validate()).To(Succeed())
+
+			cfg.FleetManagerTokenExchangeURL = "http://sts.local/token"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("FleetManagerTokenExchangeURL")))
+		})
+	})
+
+	Describe("Validate result archive", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				ResultsPath:                "/results/adapter-result.json",
+				PollIntervalSeconds:        2,
+				MaxWaitTimeSeconds:         300,
+				ResultArchiveBucket:        "hyperfleet-audit",
+				ResultArchiveEndpoint:      "https://storage.googleapis.com",
+				ResultArchiveAccessKeyFile: "/var/run/secrets/archive/access-key",
+				ResultArchiveSecretKeyFile: "/var/run/secrets/archive/secret-key",
+				ResultArchiveAddressing:    "auto",
+				ResultArchiveLogTailLines:  200,
+			}
+		})
+
+		It("accepts a bucket with an endpoint and credentials", func() {
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("returns error for a bucket path", func() {
+			cfg.ResultArchiveBucket = "hyperfleet-audit/runs"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ResultArchiveBucket")))
+		})
+
+		It("returns error for a non-http endpoint", func() {
+			cfg.ResultArchiveEndpoint = "gs://hyperfleet-audit"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ResultArchiveEndpoint")))
+		})
+
+		It("returns error for an endpoint with a path", func() {
+			cfg.ResultArchiveEndpoint = "https://minio.storage/s3"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ResultArchiveEndpoint: must not have a path")))
+		})
+
+		It("returns error for an unknown addressing style", func() {
+			cfg.ResultArchiveAddressing = "dns"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ResultArchiveAddressing")))
+		})
+
+		It("requires both credential files", func() {
+			cfg.ResultArchiveSecretKeyFile = ""
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ResultArchiveSecretKeyFile")))
+		})
+
+		It("accepts no credential files, using the default credential chain", func() {
+			cfg.ResultArchiveAccessKeyFile = ""
+			cfg.ResultArchiveSecretKeyFile = ""
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("accepts a web identity token file with a role", func() {
+			cfg.ResultArchiveAccessKeyFile = ""
+			cfg.ResultArchiveSecretKeyFile = ""
+			cfg.ResultArchiveWebIdentityFile = "/var/run/secrets/sts/token"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ResultArchiveRoleARN")))
+
+			cfg.ResultArchiveRoleARN = "arn:aws:iam::123456789012:role/status-reporter"
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("returns error for a web identity token file with access keys", func() {
+			cfg.ResultArchiveWebIdentityFile = "/var/run/secrets/sts/token"
+			cfg.ResultArchiveRoleARN = "arn:aws:iam::123456789012:role/status-reporter"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ResultArchiveWebIdentityFile")))
+		})
+
+		It("returns error for negative log tail lines", func() {
+			cfg.ResultArchiveLogTailLines = -1
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ResultArchiveLogTailLines")))
+		})
+	})
+
+	Describe("Validate Argo Workflows", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				ResultsPath:         "/results/adapter-result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+				ArgoOutputsDir:      "/results/outputs",
+				ArgoWorkflowName:    "provision",
+				ArgoNodeID:          "provision-123",
+			}
+		})
+
+		It("accepts an outputs directory and a workflow node", func() {
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("returns error for a relative outputs directory", func() {
+			cfg.ArgoOutputsDir = "outputs"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ArgoOutputsDir")))
+		})
+
+		It("requires the node ID with the workflow name", func() {
+			cfg.ArgoNodeID = ""
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("must be set together")))
+		})
+
+		It("returns error in namespace mode", func() {
+			cfg.Mode = config.ModeNamespace
+			cfg.JobLabelSelector = "app=validator"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ArgoWorkflowName")))
+		})
+	})
+
+	Describe("Validate JobSet roll-up", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				JobName:             "provision-workers-0",
+				ResultsPath:         "/results/adapter-result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+				JobSetRollup:        config.JobSetRollupStatus,
+			}
+		})
+
+		It("accepts the status and annotation modes", func() {
+			Expect(cfg.Validate()).To(Succeed())
+			cfg.JobSetRollup = config.JobSetRollupAnnotation
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("returns error for an unknown mode", func() {
+			cfg.JobSetRollup = "labels"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("JobSetRollup")))
+		})
+
+		It("returns error with a status target", func() {
+			cfg.TargetKind = "ClusterValidation"
+			cfg.TargetName = "my-cluster"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("cannot be combined with TargetKind")))
+		})
+
+		It("returns error in namespace mode", func() {
+			cfg.Mode = config.ModeNamespace
+			cfg.JobName = ""
+			cfg.JobLabelSelector = "app=validator"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("JobSetRollup: is not supported in namespace mode")))
+		})
+	})
+
+	Describe("Validate owner reporting", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				JobName:             "nightly-validation-28310400",
+				ResultsPath:         "/results/adapter-result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+				ReportToOwner:       true,
+				ReportToOwnerMode:   config.ReportToOwnerReplace,
+			}
+		})
+
+		It("accepts the replace and additional modes", func() {
+			Expect(cfg.Validate()).To(Succeed())
+			cfg.ReportToOwnerMode = config.ReportToOwnerAdditional
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("returns error for an unknown mode", func() {
+			cfg.ReportToOwnerMode = "both"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ReportToOwnerMode")))
+		})
+
+		It("returns error with a JobSet roll-up", func() {
+			cfg.JobSetRollup = config.JobSetRollupStatus
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("cannot be combined with TargetKind or JobSetRollup")))
+		})
+
+		It("returns error in namespace mode", func() {
+			cfg.Mode = config.ModeNamespace
+			cfg.JobName = ""
+			cfg.JobLabelSelector = "app=validator"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ReportToOwner: is not supported in namespace mode")))
+		})
+
+		It("ignores the mode when disabled", func() {
+			cfg.ReportToOwner = false
+			cfg.ReportToOwnerMode = "both"
+			Expect(cfg.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("Validate timeout status", func() {
+		It("accepts Unknown", func() {
+			cfg := &config.Config{
+				ResultsPath:         "/results/adapter-result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+				TimeoutStatus:       reporter.ConditionStatusUnknown,
+			}
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("returns error for True", func() {
+			cfg := &config.Config{
+				ResultsPath:         "/results/adapter-result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+				TimeoutStatus:       "True",
+			}
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("TimeoutStatus")))
+		})
+	})
+
+	Describe("Validate progressing condition", func() {
+		It("returns error when the type is the reported condition type", func() {
+			cfg := &config.Config{
+				ResultsPath:              "/results/adapter-result.json",
+				PollIntervalSeconds:      2,
+				MaxWaitTimeSeconds:       300,
+				ConditionType:            "Available",
+				ProgressingCondition:     true,
+				ProgressingConditionType: "Available",
+			}
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ProgressingConditionType")))
+
+			cfg.ProgressingConditionType = ""
+			Expect(cfg.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("Validate correlation", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				ResultsPath:         "/results/adapter-result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+				CorrelationID:       "reconcile-7f3a",
+				ObservedGeneration:  4,
+			}
+		})
+
+		It("accepts a correlation ID and observed generation", func() {
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("returns error for a correlation ID with whitespace", func() {
+			cfg.CorrelationID = "reconcile 7f3a"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("CorrelationID")))
+		})
+
+		It("returns error for a negative observed generation", func() {
+			cfg.ObservedGeneration = -1
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ObservedGeneration")))
+		})
+	})
+
+	Describe("Validate lease heartbeat", func() {
+		It("requires a Job name", func() {
+			cfg := &config.Config{
+				ResultsPath:         "/results/adapter-result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+				LeaseHeartbeat:      true,
+			}
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("LeaseHeartbeat")))
+
+			cfg.JobName = "validate-cluster-1"
+			Expect(cfg.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("Validate email notification", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				JobName:                "validate-cluster-1",
+				ResultsPath:            "/results/adapter-result.json",
+				PollIntervalSeconds:    2,
+				MaxWaitTimeSeconds:     300,
+				EmailSMTPSecretDir:     "/etc/smtp",
+				EmailOnReasons:         " AdapterOOMKilled, AdapterTimeout ",
+				CallbackTimeoutSeconds: 10,
+			}
+		})
+
+		It("accepts the SMTP secret directory with a reason list", func() {
+			Expect(cfg.Validate()).To(Succeed())
+			Expect(cfg.GetEmailOnReasons()).To(Equal([]string{"AdapterOOMKilled", "AdapterTimeout"}))
+		})
+
+		It("requires a Job name", func() {
+			cfg.JobName = ""
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("EmailSMTPSecretDir")))
+		})
+
+		It("returns error for an empty reason list", func() {
+			cfg.EmailOnReasons = " , "
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("EmailOnReasons")))
+		})
+	})
+
+	Describe("Validate result annotation", func() {
+		It("returns error for an invalid annotation key", func() {
+			cfg := &config.Config{
+				ResultsPath:         "/results/adapter-result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+				ResultAnnotation:    true,
+				ResultAnnotationKey: config.DefaultResultAnnotationKey,
+			}
+			Expect(cfg.Validate()).To(Succeed())
+
+			cfg.ResultAnnotationKey = "not a key"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ResultAnnotationKey")))
+		})
+	})
+
+	Describe("Validate status target", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				ResultsPath:         "/results/adapter-result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+				TargetGroup:         "hyperfleet.io",
+				TargetKind:          "ClusterValidation",
+				TargetName:          "my-cluster",
+			}
+		})
+
+		It("accepts a kind and name", func() {
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("returns error when the name is missing", func() {
+			cfg.TargetName = ""
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("TargetName")))
+		})
+
+		It("returns error when target fields are set without a kind", func() {
+			cfg.TargetKind = ""
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("TargetKind")))
+		})
+
+		It("returns error in namespace mode", func() {
+			cfg.Mode = config.ModeNamespace
+			cfg.JobLabelSelector = "app=validator"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("namespace mode")))
+		})
+
+		It("defaults the namespace to the Job namespace", func() {
+			Expect(os.Setenv("JOB_NAME", "test-job")).To(Succeed())
+			Expect(os.Setenv("JOB_NAMESPACE", "test-ns")).To(Succeed())
+			Expect(os.Setenv("POD_NAME", "test-pod")).To(Succeed())
+			Expect(os.Setenv("TARGET_KIND", "ClusterValidation")).To(Succeed())
+			Expect(os.Setenv("TARGET_NAME", "my-cluster")).To(Succeed())
+
+			loaded, err := config.Load()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(loaded.TargetNamespace).To(Equal("test-ns"))
+		})
+	})
+
+	Describe("Validate projected volume", func() {
+		It("returns error when combined with a results glob", func() {
+			cfg := &config.Config{
+				ResultsPath:           "/results/adapter-result.json",
+				PollIntervalSeconds:   2,
+				MaxWaitTimeSeconds:    300,
+				ResultProjectedVolume: true,
+			}
+			Expect(cfg.Validate()).To(Succeed())
+
+			cfg.ResultsPath = "/results/*.json"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ResultProjectedVolume")))
+		})
+	})
+
+	Describe("Validate done file", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				ResultsPath:          "/results/adapter-result.json",
+				PollIntervalSeconds:  2,
+				MaxWaitTimeSeconds:   300,
+				RequireDoneFile:      true,
+				AggregateConcurrency: 8,
+			}
+		})
+
+		It("defaults the done file next to the result file", func() {
+			Expect(cfg.Validate()).To(Succeed())
+			Expect(cfg.GetResultDoneFile()).To(Equal("/results/adapter-result.json.done"))
+
+			cfg.ResultDoneFile = "/results/DONE"
+			Expect(cfg.GetResultDoneFile()).To(Equal("/results/DONE"))
+		})
+
+		It("requires an explicit done file for a results glob", func() {
+			cfg.ResultsPath = "/results/*.json"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ResultDoneFile")))
+
+			cfg.ResultDoneFile = "/results/DONE"
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("returns error for a relative done file", func() {
+			cfg.ResultDoneFile = "DONE"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("path must be absolute")))
+		})
+	})
+
+	Describe("Validate results directory", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				ResultsPath:          "/results/adapter-result.json",
+				PollIntervalSeconds:  2,
+				MaxWaitTimeSeconds:   300,
+				ResultsDir:           "/results/checks",
+				ResultsDirConditions: "dns.json=DNSReady, quota.json=QuotaReady",
+				AggregateConcurrency: 8,
+			}
+		})
+
+		It("parses the file mapping in order", func() {
+			Expect(cfg.Validate()).To(Succeed())
+			checks, err := cfg.GetResultsDirConditions()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checks).To(Equal([]config.ResultsDirCondition{
+				{File: "dns.json", ConditionType: "DNSReady"},
+				{File: "quota.json", ConditionType: "QuotaReady"},
+			}))
+		})
+
+		It("requires a mapping and an absolute directory", func() {
+			cfg.ResultsDirConditions = ""
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ResultsDirConditions")))
+
+			cfg.ResultsDirConditions = "dns.json=DNSReady"
+			cfg.ResultsDir = "results/checks"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ResultsDir: path must be absolute")))
+		})
+
+		It("rejects invalid mappings", func() {
+			for _, mapping := range []string{"dns.json", "=DNSReady", "sub/dns.json=DNSReady", "..=DNSReady", "dns.json=A,dns.json=B"} {
+				cfg.ResultsDirConditions = mapping
+				Expect(cfg.Validate()).To(MatchError(ContainSubstring("ResultsDirConditions")), mapping)
+			}
+		})
+
+		It("rejects a mapping without a directory", func() {
+			cfg.ResultsDir = ""
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("requires ResultsDir")))
+		})
+
+		It("rejects a results glob", func() {
+			cfg.ResultsPath = "/results/*.json"
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("ResultsPath glob")))
+		})
+	})
+
+	Describe("Validate results glob", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				ResultsPath:          "/results/*.json",
+				PollIntervalSeconds:  2,
+				MaxWaitTimeSeconds:   300,
+				ResultsExpectedCount: 3,
+				AggregateConcurrency: 8,
+			}
+		})
+
+		It("accepts a glob results path", func() {
+			Expect(cfg.Validate()).To(Succeed())
+			Expect(cfg.IsResultsGlob()).To(BeTrue())
+		})
+
+		It("returns error for an aggregate concurrency below 1", func() {
+			cfg.AggregateConcurrency = 0
+			Expect(cfg.Validate()).To(MatchError(ContainSubstring("AggregateConcurrency")))
+		})
+
+		It("returns error for a malformed pattern", func() {
+			cfg.ResultsPath = "/results/[a-.json"
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid glob pattern"))
+		})
+
+		It("returns error for a negative expected count", func() {
+			cfg.ResultsExpectedCount = -1
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ResultsExpectedCount"))
+		})
+	})
+
+	Describe("Validate result format", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				ResultsPath:         "/results/result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+			}
+		})
+
+		It("accepts auto and the registered formats", func() {
+			for _, format := range []string{"auto", "json", "yaml"} {
+				cfg.ResultFormat = format
+				Expect(cfg.Validate()).To(Succeed())
+			}
+		})
+
+		It("returns error for an unknown format", func() {
+			cfg.ResultFormat = "xml"
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ResultFormat"))
+		})
+	})
+
+	Describe("Validate result HTTP address", func() {
+		It("returns error for an address without a port", func() {
+			cfg := &config.Config{
+				ResultsPath:         "/results/result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+				ResultHTTPAddr:      "localhost",
+			}
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("ResultHTTPAddr"))
+		})
+
+		It("requires a token file for an address reachable from outside the pod", func() {
+			cfg := &config.Config{
+				ResultsPath:         "/results/result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+				ResultHTTPAddr:      ":8081",
+			}
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("RESULT_HTTP_TOKEN_FILE"))
+
+			cfg.ResultHTTPTokenFile = "/var/run/secrets/result/token"
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("accepts a loopback address without a token file", func() {
+			for _, addr := range []string{"127.0.0.1:8081", "localhost:8081", "[::1]:8081"} {
+				cfg := &config.Config{
+					ResultsPath:         "/results/result.json",
+					PollIntervalSeconds: 2,
+					MaxWaitTimeSeconds:  300,
+					ResultHTTPAddr:      addr,
+				}
+				Expect(cfg.Validate()).To(Succeed())
+			}
+		})
+	})
+
+	Describe("Validate adapter health probe", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				ResultsPath:         "/results/result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+				AdapterHealthURL:    "http://localhost:8080/healthz",
+				AdapterHealthMode:   config.AdapterHealthModeReplace,
+			}
+		})
+
+		It("accepts a valid health probe configuration", func() {
+			Expect(cfg.Validate()).To(Succeed())
+		})
+
+		It("returns error for a URL without a scheme", func() {
+			cfg.AdapterHealthURL = "localhost:8080/healthz"
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("AdapterHealthURL"))
+		})
+
+		It("returns error for an unknown mode", func() {
+			cfg.AdapterHealthMode = "exclusive"
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("AdapterHealthMode"))
+		})
+	})
+
+	Describe("Validate aggregator", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				ResultsPath:         "/results/result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+				AggregatorResource:  "hyperfleet.io/v1/adapterruns",
+				AggregatorName:      "fleet-run",
+			}
+		})
+
+		It("parses the aggregator resource", func() {
+			Expect(cfg.Validate()).To(Succeed())
+			gvr, err := cfg.GetAggregatorGVR()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gvr.Group).To(Equal("hyperfleet.io"))
+			Expect(gvr.Version).To(Equal("v1"))
+			Expect(gvr.Resource).To(Equal("adapterruns"))
+		})
+
+		It("returns error when the resource is missing", func() {
+			cfg.AggregatorResource = ""
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("AggregatorResource"))
+		})
+
+		It("returns error for a resource without a version", func() {
+			cfg.AggregatorResource = "adapterruns.hyperfleet.io"
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("AggregatorResource"))
+		})
+	})
+
+	Describe("Validate commit status", func() {
+		var cfg *config.Config
+
+		BeforeEach(func() {
+			cfg = &config.Config{
+				ResultsPath:                "/results/result.json",
+				PollIntervalSeconds:        2,
+				MaxWaitTimeSeconds:         300,
+				CommitStatusRepo:           "openshift-hyperfleet/status-reporter",
+				CommitStatusSHA:            "0123abcd",
+				CommitStatusAPIURL:         config.DefaultCommitStatusAPIURL,
+				CommitStatusTimeoutSeconds: 10,
+			}
+		})
+
+		It("accepts a valid commit status configuration", func() {
+			Expect(cfg.Validate()).To(Succeed())
+			Expect(cfg.GetCommitStatusURL()).To(Equal("https://api.github.com/repos/openshift-hyperfleet/status-reporter/statuses/0123abcd"))
+		})
+
+		It("returns error for a repository without an owner", func() {
+			cfg.CommitStatusRepo = "status-reporter"
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("CommitStatusRepo"))
+		})
+
+		It("returns error when the SHA is missing", func() {
+			cfg.CommitStatusSHA = ""
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("CommitStatusSHA"))
+		})
+
+		It("returns error for a non-positive timeout", func() {
+			cfg.CommitStatusTimeoutSeconds = 0
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("CommitStatusTimeoutSeconds"))
+		})
+	})
+
+	Describe("Validate message key=value suffix", func() {
+		It("accepts known keys", func() {
+			cfg := &config.Config{
+				ResultsPath:         "/results/result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+				MessageKVSuffix:     "status, reason,elapsed",
+			}
+			Expect(cfg.Validate()).To(Succeed())
+			Expect(cfg.GetMessageKVSuffixKeys()).To(Equal([]string{"status", "reason", "elapsed"}))
+		})
+
+		It("returns error for an unknown key", func() {
+			cfg := &config.Config{
+				ResultsPath:         "/results/result.json",
+				PollIntervalSeconds: 2,
+				MaxWaitTimeSeconds:  300,
+				MessageKVSuffix:     "status,duration",
+			}
+			err := cfg.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("MessageKVSuffix"))
+		})
+	})
+
+	Describe("GetNonTerminalReasons", func() {
+		It("splits and trims the comma-separated list", func() {
+			cfg := &config.Config{NonTerminalReasons: " InProgress, Pending ,,"}
+			Expect(cfg.GetNonTerminalReasons()).To(Equal([]string{"InProgress", "Pending"}))
+		})
+
+		It("returns nothing when unset", func() {
+			cfg := &config.Config{}
+			Expect(cfg.GetNonTerminalReasons()).To(BeEmpty())
+		})
+	})
+
 	Describe("GetPollInterval", func() {
 		It("returns poll interval as duration", func() {
 			cfg := &config.Config{PollIntervalSeconds: 5}
@@ -0,0 +1,251 @@
+// Package email sends plain-text notification emails over SMTP. The SMTP settings are read from a
+// mounted Secret, one key per file, before every attempt so rotated credentials are picked up.
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/retry"
+)
+
+const (
+	// DefaultPort is the SMTP submission port, used with STARTTLS when the server offers it
+	DefaultPort = "587"
+
+	// ImplicitTLSPort is the SMTPS port, on which the connection is TLS from the start
+	ImplicitTLSPort = "465"
+
+	// DefaultTimeout bounds a single delivery attempt
+	DefaultTimeout = 30 * time.Second
+
+	// heloName identifies the reporter in the SMTP greeting
+	heloName = "status-reporter"
+)
+
+// Keys of the SMTP Secret; host, from and to are required
+const (
+	SecretKeyHost     = "host"
+	SecretKeyPort     = "port"
+	SecretKeyUsername = "username"
+	SecretKeyPassword = "password"
+	SecretKeyFrom     = "from"
+	SecretKeyTo       = "to"
+)
+
+// Config configures the email client
+type Config struct {
+	// SecretDir is the directory the SMTP Secret is mounted at
+	SecretDir string
+
+	// Timeout bounds a single delivery attempt (DefaultTimeout when zero)
+	Timeout time.Duration
+
+	// Retry bounds the retries of failed deliveries; permanent SMTP errors are not retried
+	Retry retry.Policy
+}
+
+// Settings are the SMTP settings read from the Secret
+type Settings struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+
+	// To lists the recipients, comma-separated in the Secret
+	To []string
+}
+
+// LoadSettings reads the SMTP settings from the mounted Secret directory
+func LoadSettings(dir string) (*Settings, error) {
+	read := func(key string, required bool) (string, error) {
+		data, err := os.ReadFile(filepath.Join(dir, key))
+		if errors.Is(err, os.ErrNotExist) && !required {
+			return "", nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("failed to read SMTP setting %q from %s: %w", key, dir, err)
+		}
+		value := strings.TrimSpace(string(data))
+		if value == "" && required {
+			return "", fmt.Errorf("SMTP setting %q in %s is empty", key, dir)
+		}
+		return value, nil
+	}
+
+	var s Settings
+	var to string
+	var err error
+	if s.Host, err = read(SecretKeyHost, true); err != nil {
+		return nil, err
+	}
+	if s.Port, err = read(SecretKeyPort, false); err != nil {
+		return nil, err
+	}
+	if s.Username, err = read(SecretKeyUsername, false); err != nil {
+		return nil, err
+	}
+	if s.Password, err = read(SecretKeyPassword, false); err != nil {
+		return nil, err
+	}
+	if s.From, err = read(SecretKeyFrom, true); err != nil {
+		return nil, err
+	}
+	if to, err = read(SecretKeyTo, true); err != nil {
+		return nil, err
+	}
+
+	if s.Port == "" {
+		s.Port = DefaultPort
+	}
+	for _, addr := range strings.Split(to, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			s.To = append(s.To, addr)
+		}
+	}
+	if len(s.To) == 0 {
+		return nil, fmt.Errorf("SMTP setting %q in %s has no recipients", SecretKeyTo, dir)
+	}
+	return &s, nil
+}
+
+// Client sends emails through the SMTP server named in the Secret
+type Client struct {
+	secretDir string
+	timeout   time.Duration
+	retry     retry.Policy
+}
+
+// NewClient creates an email client, checking up front that the Secret holds usable settings
+func NewClient(cfg Config) (*Client, error) {
+	if cfg.SecretDir == "" {
+		return nil, errors.New("SMTP secret directory is required")
+	}
+	if _, err := LoadSettings(cfg.SecretDir); err != nil {
+		return nil, err
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Client{
+		secretDir: cfg.SecretDir,
+		timeout:   timeout,
+		retry:     cfg.Retry,
+	}, nil
+}
+
+// Send emails subject and body to the recipients, retrying transient failures; permanent SMTP
+// errors (5xx replies) are not retried
+func (c *Client) Send(ctx context.Context, subject, body string) error {
+	return retry.Do(ctx, "email delivery", c.retry, func(ctx context.Context) error {
+		err := c.send(ctx, subject, body)
+		var smtpErr *textproto.Error
+		if errors.As(err, &smtpErr) && smtpErr.Code >= 500 {
+			return retry.Permanent(err)
+		}
+		return err
+	})
+}
+
+// send delivers the message in a single SMTP session
+func (c *Client) send(ctx context.Context, subject, body string) error {
+	settings, err := LoadSettings(c.secretDir)
+	if err != nil {
+		return err
+	}
+	tlsConfig := &tls.Config{ServerName: settings.Host, MinVersion: tls.VersionTLS12}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	addr := net.JoinHostPort(settings.Host, settings.Port)
+	dialer := &net.Dialer{}
+	var conn net.Conn
+	if settings.Port == ImplicitTLSPort {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server %s: %w", addr, err)
+	}
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("failed to set SMTP deadline: %w", err)
+	}
+
+	client, err := smtp.NewClient(conn, settings.Host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("SMTP greeting from %s failed: %w", addr, err)
+	}
+	defer func() { _ = client.Close() }()
+
+	if err := client.Hello(heloName); err != nil {
+		return err
+	}
+	if ok, _ := client.Extension("STARTTLS"); ok && settings.Port != ImplicitTLSPort {
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return fmt.Errorf("STARTTLS with %s failed: %w", addr, err)
+		}
+	}
+	if settings.Username != "" {
+		// PlainAuth refuses to send credentials over an unencrypted connection to a remote host
+		if err := client.Auth(smtp.PlainAuth("", settings.Username, settings.Password, settings.Host)); err != nil {
+			return fmt.Errorf("SMTP authentication failed: %w", err)
+		}
+	}
+
+	if err := client.Mail(settings.From); err != nil {
+		return err
+	}
+	for _, to := range settings.To {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(formatMessage(settings, subject, body)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// formatMessage builds the RFC 5322 message with a UTF-8 plain-text body
+func formatMessage(settings *Settings, subject, body string) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", settings.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(settings.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	for _, line := range strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n") {
+		b.WriteString(line)
+		b.WriteString("\r\n")
+	}
+	return b.Bytes()
+}
@@ -0,0 +1,231 @@
+package email_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/email"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/retry"
+)
+
+// delivered is one message accepted by fakeServer
+type delivered struct {
+	auth string
+	from string
+	to   []string
+	data string
+}
+
+// fakeServer speaks enough SMTP to accept a message, with AUTH PLAIN and no STARTTLS
+type fakeServer struct {
+	listener net.Listener
+
+	mu        sync.Mutex
+	messages  []delivered
+	conns     int
+	dropFirst int
+	rcptReply string
+}
+
+func newFakeServer() *fakeServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+	s := &fakeServer{listener: listener, rcptReply: "250 OK"}
+	go s.serve()
+	return s
+}
+
+func (s *fakeServer) port() string {
+	_, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	return port
+}
+
+func (s *fakeServer) close() {
+	_ = s.listener.Close()
+}
+
+func (s *fakeServer) received() []delivered {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]delivered(nil), s.messages...)
+}
+
+func (s *fakeServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeServer) handle(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	s.mu.Lock()
+	s.conns++
+	drop := s.conns <= s.dropFirst
+	rcptReply := s.rcptReply
+	s.mu.Unlock()
+	if drop {
+		return
+	}
+
+	reply := func(line string) { _, _ = fmt.Fprintf(conn, "%s\r\n", line) }
+	reply("220 fake ESMTP")
+	reader := bufio.NewReader(conn)
+	msg := delivered{}
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		command := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(command, "EHLO"):
+			reply("250-fake")
+			reply("250 AUTH PLAIN")
+		case strings.HasPrefix(command, "AUTH PLAIN"):
+			decoded, _ := base64.StdEncoding.DecodeString(strings.TrimSpace(line[len("AUTH PLAIN"):]))
+			msg.auth = string(decoded)
+			reply("235 Authenticated")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			msg.from = strings.Trim(line[len("MAIL FROM:"):], "<>")
+			reply("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			msg.to = append(msg.to, strings.Trim(line[len("RCPT TO:"):], "<>"))
+			reply(rcptReply)
+		case command == "DATA":
+			reply("354 Go ahead")
+			var data strings.Builder
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(dataLine)
+			}
+			msg.data = data.String()
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			reply("250 Queued")
+		case command == "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+// writeSecret writes the SMTP settings as a mounted Secret directory
+func writeSecret(settings map[string]string) string {
+	dir := GinkgoT().TempDir()
+	for key, value := range settings {
+		Expect(os.WriteFile(filepath.Join(dir, key), []byte(value), 0o600)).To(Succeed())
+	}
+	return dir
+}
+
+var _ = Describe("Client", func() {
+	var (
+		ctx    context.Context
+		server *fakeServer
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server = newFakeServer()
+	})
+
+	AfterEach(func() {
+		server.close()
+	})
+
+	Describe("NewClient", func() {
+		It("requires host, from and to in the Secret", func() {
+			dir := writeSecret(map[string]string{"host": "127.0.0.1", "to": "team@example.com"})
+			_, err := email.NewClient(email.Config{SecretDir: dir})
+			Expect(err).To(MatchError(ContainSubstring(`SMTP setting "from"`)))
+		})
+
+		It("rejects a Secret without recipients", func() {
+			dir := writeSecret(map[string]string{"host": "127.0.0.1", "from": "reporter@example.com", "to": " , "})
+			_, err := email.NewClient(email.Config{SecretDir: dir})
+			Expect(err).To(MatchError(ContainSubstring("no recipients")))
+		})
+	})
+
+	Describe("Send", func() {
+		It("authenticates and delivers the message to every recipient", func() {
+			dir := writeSecret(map[string]string{
+				"host":     "127.0.0.1",
+				"port":     server.port(),
+				"username": "reporter",
+				"password": "s3cret\n",
+				"from":     "reporter@example.com",
+				"to":       "team@example.com, oncall@example.com",
+			})
+			client, err := email.NewClient(email.Config{SecretDir: dir})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.Send(ctx, "Validation failed: Job hyperfleet/validate", "Reason: AdapterOOMKilled\nMessage: killed")).To(Succeed())
+
+			messages := server.received()
+			Expect(messages).To(HaveLen(1))
+			Expect(messages[0].auth).To(Equal("\x00reporter\x00s3cret"))
+			Expect(messages[0].from).To(Equal("reporter@example.com"))
+			Expect(messages[0].to).To(Equal([]string{"team@example.com", "oncall@example.com"}))
+			Expect(messages[0].data).To(ContainSubstring("Subject: Validation failed: Job hyperfleet/validate\r\n"))
+			Expect(messages[0].data).To(ContainSubstring("To: team@example.com, oncall@example.com\r\n"))
+			Expect(messages[0].data).To(ContainSubstring("Content-Type: text/plain; charset=utf-8\r\n"))
+			Expect(messages[0].data).To(HaveSuffix("\r\nReason: AdapterOOMKilled\r\nMessage: killed\r\n"))
+		})
+
+		It("retries when the connection is dropped", func() {
+			server.mu.Lock()
+			server.dropFirst = 1
+			server.mu.Unlock()
+			dir := writeSecret(map[string]string{
+				"host": "127.0.0.1", "port": server.port(), "from": "reporter@example.com", "to": "team@example.com",
+			})
+			client, err := email.NewClient(email.Config{SecretDir: dir, Retry: retry.Policy{MaxRetries: 2, Interval: 10 * time.Millisecond}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(client.Send(ctx, "subject", "body")).To(Succeed())
+			Expect(server.received()).To(HaveLen(1))
+		})
+
+		It("does not retry a permanent rejection", func() {
+			server.mu.Lock()
+			server.rcptReply = "550 No such user"
+			server.mu.Unlock()
+			dir := writeSecret(map[string]string{
+				"host": "127.0.0.1", "port": server.port(), "from": "reporter@example.com", "to": "nobody@example.com",
+			})
+			client, err := email.NewClient(email.Config{SecretDir: dir, Retry: retry.Policy{MaxRetries: 2, Interval: 10 * time.Millisecond}})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = client.Send(ctx, "subject", "body")
+			Expect(err).To(MatchError(ContainSubstring("after 1 attempt(s)")))
+			Expect(err).To(MatchError(ContainSubstring("No such user")))
+		})
+	})
+})
@@ -0,0 +1,269 @@
+// Package fleet reports validation results to the hyperfleet fleet manager with a unary gRPC call.
+// The service definition is vendored in fleetmanagerpb/fleetmanager.proto, with the stubs generated
+// from it in the same package.
+package fleet
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google/externalaccount"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/fleet/fleetmanagerpb"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/retry"
+)
+
+const (
+	// DefaultMethod is the full gRPC method name of the fleet manager's validation report
+	DefaultMethod = fleetmanagerpb.FleetManager_ReportClusterValidation_FullMethodName
+
+	// DefaultTimeout bounds a single call
+	DefaultTimeout = 10 * time.Second
+
+	// userAgent identifies the reporter's calls to the fleet manager
+	userAgent = "status-reporter"
+
+	// jwtTokenType is the RFC 8693 type of a projected service account token
+	jwtTokenType = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+// Config configures the fleet manager client
+type Config struct {
+	// Endpoint is the fleet manager address: https://host:port for TLS, http://host:port for
+	// plaintext HTTP/2 (e.g. through a service mesh sidecar)
+	Endpoint string
+
+	// Method is the full gRPC method name (DefaultMethod when empty)
+	Method string
+
+	// TokenFile holds the bearer token sent as authorization metadata, e.g. a projected service
+	// account token; it is read before every attempt so rotated tokens are picked up. With
+	// TokenExchangeURL set, it is the workload identity token exchanged for the access token instead
+	TokenFile string
+
+	// TokenExchangeURL is an OAuth 2.0 token exchange (RFC 8693) endpoint, such as a cloud security
+	// token service, where the token from TokenFile is exchanged for the access token sent to the
+	// fleet manager. The access token is cached until it expires.
+	TokenExchangeURL string
+
+	// TokenExchangeAudience identifies the workload identity provider to the token exchange
+	TokenExchangeAudience string
+
+	// TokenExchangeScopes are the scopes requested for the access token
+	TokenExchangeScopes []string
+
+	// CAFile is a PEM bundle used to verify the server certificate instead of the system roots
+	CAFile string
+
+	// Timeout bounds a single attempt (DefaultTimeout when zero)
+	Timeout time.Duration
+
+	// Retry bounds the retries of retryable gRPC statuses, which include transport failures
+	Retry retry.Policy
+}
+
+// ValidationReport is the ReportClusterValidationRequest of fleetmanager.proto
+type ValidationReport struct {
+	ClusterID     string
+	ConditionType string
+	Status        string
+	Reason        string
+	Message       string
+	JobNamespace  string
+	JobName       string
+	ObservedTime  time.Time
+
+	// DetailsJSON is the adapter result details, as JSON
+	DetailsJSON string
+}
+
+// request converts the report to the generated request message
+func (r ValidationReport) request() *fleetmanagerpb.ReportClusterValidationRequest {
+	req := &fleetmanagerpb.ReportClusterValidationRequest{
+		ClusterId:     r.ClusterID,
+		ConditionType: r.ConditionType,
+		Status:        r.Status,
+		Reason:        r.Reason,
+		Message:       r.Message,
+		JobNamespace:  r.JobNamespace,
+		JobName:       r.JobName,
+		DetailsJson:   r.DetailsJSON,
+	}
+	if !r.ObservedTime.IsZero() {
+		req.ObservedTime = timestamppb.New(r.ObservedTime)
+	}
+	return req
+}
+
+// Client calls the fleet manager's ReportClusterValidation method
+type Client struct {
+	target   string
+	method   string
+	timeout  time.Duration
+	retry    retry.Policy
+	dialOpts []grpc.DialOption
+}
+
+// NewClient creates a fleet manager client, validating the endpoint up front
+func NewClient(cfg Config) (*Client, error) {
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return nil, fmt.Errorf("invalid fleet manager endpoint %q: expected https://host:port or http://host:port", cfg.Endpoint)
+	}
+	method := cfg.Method
+	if method == "" {
+		method = DefaultMethod
+	}
+	if !strings.HasPrefix(method, "/") || strings.Count(method, "/") != 2 {
+		return nil, fmt.Errorf("invalid fleet manager method %q: expected /package.Service/Method", method)
+	}
+
+	transportCreds := insecure.NewCredentials()
+	if u.Scheme == "https" {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+		if cfg.CAFile != "" {
+			caData, err := os.ReadFile(cfg.CAFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read fleet manager CA file path=%s: %w", cfg.CAFile, err)
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(caData) {
+				return nil, fmt.Errorf("no valid certificates found in fleet manager CA file path=%s", cfg.CAFile)
+			}
+			tlsConfig.RootCAs = pool
+		}
+		transportCreds = credentials.NewTLS(tlsConfig)
+	}
+
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(transportCreds), grpc.WithUserAgent(userAgent)}
+	tokens, err := newTokenSource(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if tokens != nil {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(tokenCredentials{source: tokens}))
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &Client{
+		target:   u.Host,
+		method:   method,
+		timeout:  timeout,
+		retry:    cfg.Retry,
+		dialOpts: dialOpts,
+	}, nil
+}
+
+// newTokenSource returns the source of the token sent to the fleet manager: the token file as is,
+// the access token it is exchanged for, or nil without a token file
+func newTokenSource(cfg Config) (oauth2.TokenSource, error) {
+	if cfg.TokenExchangeURL == "" {
+		if cfg.TokenFile == "" {
+			return nil, nil
+		}
+		return fileTokenSource{path: cfg.TokenFile}, nil
+	}
+	if cfg.TokenFile == "" || cfg.TokenExchangeAudience == "" {
+		return nil, fmt.Errorf("fleet manager token exchange requires a token file and an audience")
+	}
+
+	tokens, err := externalaccount.NewTokenSource(context.Background(), externalaccount.Config{
+		Audience:         cfg.TokenExchangeAudience,
+		SubjectTokenType: jwtTokenType,
+		TokenURL:         cfg.TokenExchangeURL,
+		Scopes:           cfg.TokenExchangeScopes,
+		CredentialSource: &externalaccount.CredentialSource{File: cfg.TokenFile},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure fleet manager token exchange: %w", err)
+	}
+	return tokens, nil
+}
+
+// fileTokenSource reads the bearer token from a file on every call, so rotated tokens are picked up
+type fileTokenSource struct {
+	path string
+}
+
+func (s fileTokenSource) Token() (*oauth2.Token, error) {
+	token, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fleet manager token file path=%s: %w", s.path, err)
+	}
+	return &oauth2.Token{AccessToken: strings.TrimSpace(string(token)), TokenType: "Bearer"}, nil
+}
+
+// tokenCredentials sends a token as gRPC authorization metadata
+type tokenCredentials struct {
+	source oauth2.TokenSource
+}
+
+func (c tokenCredentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	token, err := c.source.Token()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"authorization": token.Type() + " " + token.AccessToken}, nil
+}
+
+// RequireTransportSecurity allows plaintext endpoints, whose traffic a service mesh sidecar encrypts
+func (tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// ReportClusterValidation sends the report, retrying retryable gRPC statuses (Unavailable,
+// DeadlineExceeded, ResourceExhausted, Aborted)
+func (c *Client) ReportClusterValidation(ctx context.Context, report ValidationReport) error {
+	conn, err := grpc.NewClient(c.target, c.dialOpts...)
+	if err != nil {
+		return fmt.Errorf("failed to create fleet manager connection: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	call := fleetmanagerpb.NewFleetManagerClient(conn).ReportClusterValidation
+	if c.method != DefaultMethod {
+		call = func(ctx context.Context, req *fleetmanagerpb.ReportClusterValidationRequest, opts ...grpc.CallOption) (*fleetmanagerpb.ReportClusterValidationResponse, error) {
+			resp := new(fleetmanagerpb.ReportClusterValidationResponse)
+			return resp, conn.Invoke(ctx, c.method, req, resp, opts...)
+		}
+	}
+
+	req := report.request()
+	return retry.Do(ctx, "fleet manager call", c.retry, func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+
+		_, err := call(ctx, req)
+		if err != nil && !isRetryable(err) {
+			return retry.Permanent(err)
+		}
+		return err
+	})
+}
+
+// isRetryable reports whether a failed call may succeed when repeated; transport failures and
+// overloaded proxies surface as Unavailable
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted, codes.Unavailable:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,299 @@
+package fleet_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/fleet"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/fleet/fleetmanagerpb"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/retry"
+)
+
+// call is one request received by fakeFleetManager
+type call struct {
+	method    string
+	auth      string
+	userAgent string
+	request   *fleetmanagerpb.ReportClusterValidationRequest
+}
+
+// fakeFleetManager is a gRPC server answering calls with the configured errors, in order. It is
+// served through an httptest server, which provides the TLS certificate and plaintext HTTP/2.
+type fakeFleetManager struct {
+	fleetmanagerpb.UnimplementedFleetManagerServer
+	server *httptest.Server
+
+	mu     sync.Mutex
+	calls  []call
+	errors []error
+}
+
+func newFakeFleetManager(tls bool) *fakeFleetManager {
+	f := &fakeFleetManager{}
+	// Calls to other method names, such as a custom FLEET_MANAGER_METHOD, get the same answers
+	grpcServer := grpc.NewServer(grpc.UnknownServiceHandler(func(_ any, stream grpc.ServerStream) error {
+		req := new(fleetmanagerpb.ReportClusterValidationRequest)
+		if err := stream.RecvMsg(req); err != nil {
+			return err
+		}
+		if _, err := f.ReportClusterValidation(stream.Context(), req); err != nil {
+			return err
+		}
+		return stream.SendMsg(new(fleetmanagerpb.ReportClusterValidationResponse))
+	}))
+	fleetmanagerpb.RegisterFleetManagerServer(grpcServer, f)
+
+	f.server = httptest.NewUnstartedServer(grpcServer)
+	if tls {
+		f.server.EnableHTTP2 = true
+		f.server.StartTLS()
+	} else {
+		f.server.Config.Protocols = new(http.Protocols)
+		f.server.Config.Protocols.SetUnencryptedHTTP2(true)
+		f.server.Start()
+	}
+	return f
+}
+
+func (f *fakeFleetManager) ReportClusterValidation(ctx context.Context, req *fleetmanagerpb.ReportClusterValidationRequest) (*fleetmanagerpb.ReportClusterValidationResponse, error) {
+	c := call{request: req}
+	c.method, _ = grpc.Method(ctx)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if auth := md.Get("authorization"); len(auth) > 0 {
+			c.auth = auth[0]
+		}
+		if userAgent := md.Get("user-agent"); len(userAgent) > 0 {
+			c.userAgent = userAgent[0]
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, c)
+	var err error
+	if len(f.errors) > 0 {
+		err, f.errors = f.errors[0], f.errors[1:]
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &fleetmanagerpb.ReportClusterValidationResponse{}, nil
+}
+
+// caFile writes the server certificate to a PEM file
+func (f *fakeFleetManager) caFile() string {
+	path := filepath.Join(GinkgoT().TempDir(), "ca.crt")
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: f.server.Certificate().Raw})
+	Expect(os.WriteFile(path, data, 0o600)).To(Succeed())
+	return path
+}
+
+func (f *fakeFleetManager) received() []call {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]call(nil), f.calls...)
+}
+
+var _ = Describe("Client", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	Describe("NewClient", func() {
+		It("rejects endpoints that are not http or https", func() {
+			_, err := fleet.NewClient(fleet.Config{Endpoint: "grpc://fleet:443"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid fleet manager endpoint"))
+		})
+
+		It("rejects malformed method names", func() {
+			_, err := fleet.NewClient(fleet.Config{Endpoint: "https://fleet:443", Method: "ReportClusterValidation"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid fleet manager method"))
+		})
+
+		It("requires a token file and an audience for the token exchange", func() {
+			_, err := fleet.NewClient(fleet.Config{Endpoint: "https://fleet:443", TokenExchangeURL: "https://sts.example.com/token"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("requires a token file and an audience"))
+		})
+
+		It("fails when the CA file cannot be read", func() {
+			_, err := fleet.NewClient(fleet.Config{Endpoint: "https://fleet:443", CAFile: "/nonexistent/ca.crt"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to read fleet manager CA file"))
+		})
+	})
+
+	Describe("ReportClusterValidation", func() {
+		var report fleet.ValidationReport
+
+		BeforeEach(func() {
+			report = fleet.ValidationReport{
+				ClusterID:     "cluster-1",
+				ConditionType: "Available",
+				Status:        "True",
+				Reason:        "AllChecksPassed",
+				JobNamespace:  "hyperfleet",
+				JobName:       "validate-cluster-1",
+				ObservedTime:  time.Unix(1700000000, 0),
+				DetailsJSON:   `{"checks":3}`,
+			}
+		})
+
+		It("sends the report over TLS with the bearer token from the file", func() {
+			server := newFakeFleetManager(true)
+			defer server.server.Close()
+			tokenFile := filepath.Join(GinkgoT().TempDir(), "token")
+			Expect(os.WriteFile(tokenFile, []byte("s3cret\n"), 0o600)).To(Succeed())
+
+			client, err := fleet.NewClient(fleet.Config{
+				Endpoint: server.server.URL, TokenFile: tokenFile, CAFile: server.caFile(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.ReportClusterValidation(ctx, report)).To(Succeed())
+
+			calls := server.received()
+			Expect(calls).To(HaveLen(1))
+			Expect(calls[0].method).To(Equal(fleet.DefaultMethod))
+			Expect(calls[0].auth).To(Equal("Bearer s3cret"))
+			Expect(calls[0].userAgent).To(HavePrefix("status-reporter"))
+
+			req := calls[0].request
+			Expect(req.GetClusterId()).To(Equal("cluster-1"))
+			Expect(req.GetConditionType()).To(Equal("Available"))
+			Expect(req.GetStatus()).To(Equal("True"))
+			Expect(req.GetReason()).To(Equal("AllChecksPassed"))
+			Expect(req.GetMessage()).To(BeEmpty())
+			Expect(req.GetJobNamespace()).To(Equal("hyperfleet"))
+			Expect(req.GetJobName()).To(Equal("validate-cluster-1"))
+			Expect(req.GetObservedTime().AsTime()).To(Equal(time.Unix(1700000000, 0).UTC()))
+			Expect(req.GetDetailsJson()).To(Equal(`{"checks":3}`))
+		})
+
+		It("uses plaintext HTTP/2 for http endpoints", func() {
+			server := newFakeFleetManager(false)
+			defer server.server.Close()
+
+			client, err := fleet.NewClient(fleet.Config{Endpoint: server.server.URL, Method: "/test.Fleet/Report"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.ReportClusterValidation(ctx, report)).To(Succeed())
+
+			calls := server.received()
+			Expect(calls).To(HaveLen(1))
+			Expect(calls[0].method).To(Equal("/test.Fleet/Report"))
+			Expect(calls[0].auth).To(BeEmpty())
+			Expect(calls[0].request.GetClusterId()).To(Equal("cluster-1"))
+		})
+
+		It("retries Unavailable statuses", func() {
+			server := newFakeFleetManager(false)
+			defer server.server.Close()
+			server.errors = []error{status.Error(codes.Unavailable, "restarting")}
+
+			client, err := fleet.NewClient(fleet.Config{
+				Endpoint: server.server.URL, Retry: retry.Policy{MaxRetries: 2, Interval: 10 * time.Millisecond},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.ReportClusterValidation(ctx, report)).To(Succeed())
+			Expect(server.received()).To(HaveLen(2))
+		})
+
+		It("does not retry other statuses", func() {
+			server := newFakeFleetManager(false)
+			defer server.server.Close()
+			server.errors = []error{status.Error(codes.NotFound, "cluster not found")}
+
+			client, err := fleet.NewClient(fleet.Config{
+				Endpoint: server.server.URL, Retry: retry.Policy{MaxRetries: 2, Interval: 10 * time.Millisecond},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = client.ReportClusterValidation(ctx, report)
+			Expect(err).To(HaveOccurred())
+			Expect(status.Code(errors.Unwrap(err))).To(Equal(codes.NotFound))
+			Expect(err.Error()).To(ContainSubstring("cluster not found"))
+			Expect(server.received()).To(HaveLen(1))
+		})
+
+		It("fails when the token file cannot be read", func() {
+			server := newFakeFleetManager(false)
+			defer server.server.Close()
+
+			client, err := fleet.NewClient(fleet.Config{Endpoint: server.server.URL, TokenFile: "/nonexistent/token", Retry: retry.Policy{MaxRetries: 2}})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = client.ReportClusterValidation(ctx, report)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to read fleet manager token file"))
+			Expect(err.Error()).To(ContainSubstring("after 1 attempt(s)"))
+			Expect(server.received()).To(BeEmpty())
+		})
+
+		It("exchanges the token file for an access token with the token exchange", func() {
+			server := newFakeFleetManager(false)
+			defer server.server.Close()
+			tokenFile := filepath.Join(GinkgoT().TempDir(), "token")
+			Expect(os.WriteFile(tokenFile, []byte("projected-token\n"), 0o600)).To(Succeed())
+
+			var mu sync.Mutex
+			var exchanges []map[string][]string
+			sts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.ParseForm()).To(Succeed())
+				mu.Lock()
+				exchanges = append(exchanges, r.PostForm)
+				mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				Expect(json.NewEncoder(w).Encode(map[string]any{
+					"access_token":      "exchanged-token",
+					"issued_token_type": "urn:ietf:params:oauth:token-type:access_token",
+					"token_type":        "Bearer",
+					"expires_in":        3600,
+				})).To(Succeed())
+			}))
+			defer sts.Close()
+
+			client, err := fleet.NewClient(fleet.Config{
+				Endpoint:              server.server.URL,
+				TokenFile:             tokenFile,
+				TokenExchangeURL:      sts.URL,
+				TokenExchangeAudience: "//iam.example.com/pools/fleet/providers/cluster",
+				TokenExchangeScopes:   []string{"fleet.report"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.ReportClusterValidation(ctx, report)).To(Succeed())
+			Expect(client.ReportClusterValidation(ctx, report)).To(Succeed())
+
+			calls := server.received()
+			Expect(calls).To(HaveLen(2))
+			Expect(calls[0].auth).To(Equal("Bearer exchanged-token"))
+			Expect(calls[1].auth).To(Equal("Bearer exchanged-token"))
+
+			mu.Lock()
+			defer mu.Unlock()
+			Expect(exchanges).To(HaveLen(1), "the access token is cached until it expires")
+			Expect(exchanges[0]["grant_type"]).To(ConsistOf("urn:ietf:params:oauth:grant-type:token-exchange"))
+			Expect(exchanges[0]["subject_token"]).To(ConsistOf("projected-token"))
+			Expect(exchanges[0]["subject_token_type"]).To(ConsistOf("urn:ietf:params:oauth:token-type:jwt"))
+			Expect(exchanges[0]["audience"]).To(ConsistOf("//iam.example.com/pools/fleet/providers/cluster"))
+			Expect(exchanges[0]["scope"]).To(ConsistOf("fleet.report"))
+		})
+	})
+})
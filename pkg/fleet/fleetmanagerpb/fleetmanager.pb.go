@@ -0,0 +1,245 @@
+// Fleet manager API used by the status reporter. fleetmanager.pb.go and fleetmanager_grpc.pb.go
+// are generated from this file with protoc-gen-go and protoc-gen-go-grpc (paths=source_relative);
+// regenerate them when it changes.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.7
+// 	protoc        (unknown)
+// source: fleetmanager.proto
+
+package fleetmanagerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReportClusterValidationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ConditionType string                 `protobuf:"bytes,2,opt,name=condition_type,json=conditionType,proto3" json:"condition_type,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	JobNamespace  string                 `protobuf:"bytes,6,opt,name=job_namespace,json=jobNamespace,proto3" json:"job_namespace,omitempty"`
+	JobName       string                 `protobuf:"bytes,7,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	ObservedTime  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=observed_time,json=observedTime,proto3" json:"observed_time,omitempty"`
+	// details_json is the adapter result details, as JSON
+	DetailsJson   string `protobuf:"bytes,9,opt,name=details_json,json=detailsJson,proto3" json:"details_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportClusterValidationRequest) Reset() {
+	*x = ReportClusterValidationRequest{}
+	mi := &file_fleetmanager_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportClusterValidationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportClusterValidationRequest) ProtoMessage() {}
+
+func (x *ReportClusterValidationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fleetmanager_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportClusterValidationRequest.ProtoReflect.Descriptor instead.
+func (*ReportClusterValidationRequest) Descriptor() ([]byte, []int) {
+	return file_fleetmanager_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReportClusterValidationRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *ReportClusterValidationRequest) GetConditionType() string {
+	if x != nil {
+		return x.ConditionType
+	}
+	return ""
+}
+
+func (x *ReportClusterValidationRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReportClusterValidationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReportClusterValidationRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReportClusterValidationRequest) GetJobNamespace() string {
+	if x != nil {
+		return x.JobNamespace
+	}
+	return ""
+}
+
+func (x *ReportClusterValidationRequest) GetJobName() string {
+	if x != nil {
+		return x.JobName
+	}
+	return ""
+}
+
+func (x *ReportClusterValidationRequest) GetObservedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ObservedTime
+	}
+	return nil
+}
+
+func (x *ReportClusterValidationRequest) GetDetailsJson() string {
+	if x != nil {
+		return x.DetailsJson
+	}
+	return ""
+}
+
+type ReportClusterValidationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportClusterValidationResponse) Reset() {
+	*x = ReportClusterValidationResponse{}
+	mi := &file_fleetmanager_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportClusterValidationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportClusterValidationResponse) ProtoMessage() {}
+
+func (x *ReportClusterValidationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fleetmanager_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportClusterValidationResponse.ProtoReflect.Descriptor instead.
+func (*ReportClusterValidationResponse) Descriptor() ([]byte, []int) {
+	return file_fleetmanager_proto_rawDescGZIP(), []int{1}
+}
+
+var File_fleetmanager_proto protoreflect.FileDescriptor
+
+const file_fleetmanager_proto_rawDesc = "" +
+	"\n" +
+	"\x12fleetmanager.proto\x12\x1ahyperfleet.fleetmanager.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd4\x02\n" +
+	"\x1eReportClusterValidationRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12%\n" +
+	"\x0econdition_type\x18\x02 \x01(\tR\rconditionType\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12#\n" +
+	"\rjob_namespace\x18\x06 \x01(\tR\fjobNamespace\x12\x19\n" +
+	"\bjob_name\x18\a \x01(\tR\ajobName\x12?\n" +
+	"\robserved_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\fobservedTime\x12!\n" +
+	"\fdetails_json\x18\t \x01(\tR\vdetailsJson\"!\n" +
+	"\x1fReportClusterValidationResponse2\xa3\x01\n" +
+	"\fFleetManager\x12\x92\x01\n" +
+	"\x17ReportClusterValidation\x12:.hyperfleet.fleetmanager.v1.ReportClusterValidationRequest\x1a;.hyperfleet.fleetmanager.v1.ReportClusterValidationResponseBJZHgithub.com/openshift-hyperfleet/status-reporter/pkg/fleet/fleetmanagerpbb\x06proto3"
+
+var (
+	file_fleetmanager_proto_rawDescOnce sync.Once
+	file_fleetmanager_proto_rawDescData []byte
+)
+
+func file_fleetmanager_proto_rawDescGZIP() []byte {
+	file_fleetmanager_proto_rawDescOnce.Do(func() {
+		file_fleetmanager_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_fleetmanager_proto_rawDesc), len(file_fleetmanager_proto_rawDesc)))
+	})
+	return file_fleetmanager_proto_rawDescData
+}
+
+var file_fleetmanager_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_fleetmanager_proto_goTypes = []any{
+	(*ReportClusterValidationRequest)(nil),  // 0: hyperfleet.fleetmanager.v1.ReportClusterValidationRequest
+	(*ReportClusterValidationResponse)(nil), // 1: hyperfleet.fleetmanager.v1.ReportClusterValidationResponse
+	(*timestamppb.Timestamp)(nil),           // 2: google.protobuf.Timestamp
+}
+var file_fleetmanager_proto_depIdxs = []int32{
+	2, // 0: hyperfleet.fleetmanager.v1.ReportClusterValidationRequest.observed_time:type_name -> google.protobuf.Timestamp
+	0, // 1: hyperfleet.fleetmanager.v1.FleetManager.ReportClusterValidation:input_type -> hyperfleet.fleetmanager.v1.ReportClusterValidationRequest
+	1, // 2: hyperfleet.fleetmanager.v1.FleetManager.ReportClusterValidation:output_type -> hyperfleet.fleetmanager.v1.ReportClusterValidationResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_fleetmanager_proto_init() }
+func file_fleetmanager_proto_init() {
+	if File_fleetmanager_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fleetmanager_proto_rawDesc), len(file_fleetmanager_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_fleetmanager_proto_goTypes,
+		DependencyIndexes: file_fleetmanager_proto_depIdxs,
+		MessageInfos:      file_fleetmanager_proto_msgTypes,
+	}.Build()
+	File_fleetmanager_proto = out.File
+	file_fleetmanager_proto_goTypes = nil
+	file_fleetmanager_proto_depIdxs = nil
+}
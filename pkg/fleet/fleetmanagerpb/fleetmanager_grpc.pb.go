@@ -0,0 +1,127 @@
+// Fleet manager API used by the status reporter. fleetmanager.pb.go and fleetmanager_grpc.pb.go
+// are generated from this file with protoc-gen-go and protoc-gen-go-grpc (paths=source_relative);
+// regenerate them when it changes.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: fleetmanager.proto
+
+package fleetmanagerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FleetManager_ReportClusterValidation_FullMethodName = "/hyperfleet.fleetmanager.v1.FleetManager/ReportClusterValidation"
+)
+
+// FleetManagerClient is the client API for FleetManager service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FleetManagerClient interface {
+	// ReportClusterValidation records the result of a cluster validation Job
+	ReportClusterValidation(ctx context.Context, in *ReportClusterValidationRequest, opts ...grpc.CallOption) (*ReportClusterValidationResponse, error)
+}
+
+type fleetManagerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFleetManagerClient(cc grpc.ClientConnInterface) FleetManagerClient {
+	return &fleetManagerClient{cc}
+}
+
+func (c *fleetManagerClient) ReportClusterValidation(ctx context.Context, in *ReportClusterValidationRequest, opts ...grpc.CallOption) (*ReportClusterValidationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportClusterValidationResponse)
+	err := c.cc.Invoke(ctx, FleetManager_ReportClusterValidation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FleetManagerServer is the server API for FleetManager service.
+// All implementations must embed UnimplementedFleetManagerServer
+// for forward compatibility.
+type FleetManagerServer interface {
+	// ReportClusterValidation records the result of a cluster validation Job
+	ReportClusterValidation(context.Context, *ReportClusterValidationRequest) (*ReportClusterValidationResponse, error)
+	mustEmbedUnimplementedFleetManagerServer()
+}
+
+// UnimplementedFleetManagerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFleetManagerServer struct{}
+
+func (UnimplementedFleetManagerServer) ReportClusterValidation(context.Context, *ReportClusterValidationRequest) (*ReportClusterValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportClusterValidation not implemented")
+}
+func (UnimplementedFleetManagerServer) mustEmbedUnimplementedFleetManagerServer() {}
+func (UnimplementedFleetManagerServer) testEmbeddedByValue()                      {}
+
+// UnsafeFleetManagerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FleetManagerServer will
+// result in compilation errors.
+type UnsafeFleetManagerServer interface {
+	mustEmbedUnimplementedFleetManagerServer()
+}
+
+func RegisterFleetManagerServer(s grpc.ServiceRegistrar, srv FleetManagerServer) {
+	// If the following call pancis, it indicates UnimplementedFleetManagerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FleetManager_ServiceDesc, srv)
+}
+
+func _FleetManager_ReportClusterValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportClusterValidationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FleetManagerServer).ReportClusterValidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FleetManager_ReportClusterValidation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FleetManagerServer).ReportClusterValidation(ctx, req.(*ReportClusterValidationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FleetManager_ServiceDesc is the grpc.ServiceDesc for FleetManager service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FleetManager_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "hyperfleet.fleetmanager.v1.FleetManager",
+	HandlerType: (*FleetManagerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReportClusterValidation",
+			Handler:    _FleetManager_ReportClusterValidation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "fleetmanager.proto",
+}
@@ -0,0 +1,66 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+)
+
+// AggregatorClient records run outcomes in the status of a named custom resource that aggregates
+// outcomes across runs (e.g. an AdapterRun), independently of the Job status
+type AggregatorClient struct {
+	client    dynamic.Interface
+	gvr       schema.GroupVersionResource
+	namespace string
+	name      string
+}
+
+// NewAggregatorClient creates an aggregator client using in-cluster config
+func NewAggregatorClient(gvr schema.GroupVersionResource, namespace, name string) (*AggregatorClient, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get in-cluster config: %w", err)
+	}
+
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
+	return NewAggregatorClientWithDynamic(client, gvr, namespace, name), nil
+}
+
+// NewAggregatorClientWithDynamic creates an aggregator client from an existing dynamic client (for testing)
+func NewAggregatorClientWithDynamic(client dynamic.Interface, gvr schema.GroupVersionResource, namespace, name string) *AggregatorClient {
+	return &AggregatorClient{
+		client:    client,
+		gvr:       gvr,
+		namespace: namespace,
+		name:      name,
+	}
+}
+
+// PatchRun merges run into the resource's status.runs map under key. A merge patch only touches
+// that key, so concurrent runs keyed by different pods do not overwrite each other.
+func (a *AggregatorClient) PatchRun(ctx context.Context, key string, run any) error {
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{
+			"runs": map[string]any{key: run},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build aggregator patch: %w", err)
+	}
+
+	_, err = a.client.Resource(a.gvr).Namespace(a.namespace).Patch(ctx, a.name, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+	if err != nil {
+		return fmt.Errorf("failed to patch %s status: namespace=%s name=%s: %w", a.gvr.Resource, a.namespace, a.name, err)
+	}
+	return nil
+}
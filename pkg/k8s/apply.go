@@ -0,0 +1,167 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+)
+
+// FieldManager is the field manager of condition updates made with server-side apply
+const FieldManager = "status-reporter"
+
+// WithServerSideApply writes conditions without a read-modify-write of the whole status, so
+// conditions owned by other controllers are left alone and no resourceVersion conflicts occur.
+// Status targets are updated with server-side apply under FieldManager. Job conditions are an
+// atomic list, which an apply would take over as a whole, so they are written with a strategic
+// merge patch keyed by condition type under the same field manager. A status target whose
+// conditions are not a list map keyed by type is updated instead, since a forced apply would take
+// over the whole list. When the API server does not support either, the client falls back to
+// updating the status for the rest of the run.
+func WithServerSideApply(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.serverSideApply = enabled
+	}
+}
+
+// patchJobConditions writes the Job's conditions of the given types with a strategic merge patch
+// of the status subresource
+func (c *Client) patchJobConditions(ctx context.Context, job *batchv1.Job, conditionTypes []string) error {
+	var conditions []batchv1.JobCondition
+	for _, condition := range job.Status.Conditions {
+		if slices.Contains(conditionTypes, string(condition.Type)) {
+			conditions = append(conditions, condition)
+		}
+	}
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{"conditions": conditions},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build condition patch: %w", err)
+	}
+
+	_, err = c.clientset.BatchV1().Jobs(c.namespace).Patch(ctx, c.jobName, types.StrategicMergePatchType, patch,
+		metav1.PatchOptions{FieldManager: FieldManager}, "status")
+	return err
+}
+
+// applyTargetConditions applies the target's conditions of the given types, together with the
+// conditions previously applied under FieldManager, which an apply omitting them would remove
+func (c *Client) applyTargetConditions(ctx context.Context, resource dynamic.ResourceInterface, obj *unstructured.Unstructured, conditions []any, conditionTypes []string) error {
+	owned := appliedConditionTypes(obj)
+	var applied []any
+	for _, item := range conditions {
+		condition, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		conditionType, _ := condition["type"].(string)
+		if owned[conditionType] || slices.Contains(conditionTypes, conditionType) {
+			applied = append(applied, condition)
+		}
+	}
+
+	metadata := map[string]any{"name": obj.GetName()}
+	if obj.GetNamespace() != "" {
+		metadata["namespace"] = obj.GetNamespace()
+	}
+	apply := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": obj.GetAPIVersion(),
+		"kind":       obj.GetKind(),
+		"metadata":   metadata,
+		"status":     map[string]any{"conditions": applied},
+	}}
+	_, err := resource.ApplyStatus(ctx, obj.GetName(), apply, metav1.ApplyOptions{FieldManager: FieldManager, Force: true})
+	return err
+}
+
+// appliedConditionTypes returns the condition types applied to the status under FieldManager, from
+// the object's managed fields
+func appliedConditionTypes(obj *unstructured.Unstructured) map[string]bool {
+	owned := map[string]bool{}
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager != FieldManager || entry.Operation != metav1.ManagedFieldsOperationApply {
+			continue
+		}
+		fields, ok := managedConditionFields(entry)
+		if !ok {
+			continue
+		}
+		for key := range fields {
+			var item struct {
+				Type string `json:"type"`
+			}
+			if strings.HasPrefix(key, "k:") && json.Unmarshal([]byte(strings.TrimPrefix(key, "k:")), &item) == nil && item.Type != "" {
+				owned[item.Type] = true
+			}
+		}
+	}
+	return owned
+}
+
+// conditionsKeyedByType reports whether the status conditions of obj are a list map keyed by type,
+// which an apply can update item by item, from the managed fields of its status: items of a list
+// map are tracked by key ("k:..."), while an atomic list is tracked as a whole. When no manager
+// tracks the conditions, only an object without conditions is safe to apply to.
+func conditionsKeyedByType(obj *unstructured.Unstructured) bool {
+	tracked := false
+	for _, entry := range obj.GetManagedFields() {
+		fields, ok := managedConditionFields(entry)
+		if !ok {
+			continue
+		}
+		if len(fields) == 0 {
+			return false
+		}
+		for key := range fields {
+			if key != "." && !strings.HasPrefix(key, "k:") {
+				return false
+			}
+		}
+		tracked = true
+	}
+	if tracked {
+		return true
+	}
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	return len(conditions) == 0
+}
+
+// managedConditionFields returns the fields of status.conditions in a managed fields entry of the
+// status subresource, and false when the entry does not track them
+func managedConditionFields(entry metav1.ManagedFieldsEntry) (map[string]any, bool) {
+	if entry.Subresource != "status" || entry.FieldsV1 == nil {
+		return nil, false
+	}
+	var fields struct {
+		Status struct {
+			Conditions map[string]any `json:"f:conditions"`
+		} `json:"f:status"`
+	}
+	if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil || fields.Status.Conditions == nil {
+		return nil, false
+	}
+	return fields.Status.Conditions, true
+}
+
+// fallBackFromApply reports whether err shows that the API server does not support the apply or
+// patch of the status subresource, in which case the status is updated instead from now on. Other
+// errors, such as a Forbidden one, are returned to the caller.
+func (c *Client) fallBackFromApply(err error, resource string) bool {
+	if err == nil || !(errors.IsMethodNotSupported(err) || errors.IsUnsupportedMediaType(err) ||
+		errors.IsNotAcceptable(err)) {
+		return false
+	}
+	log.Printf("Warning: server-side apply of %s status failed (%v); falling back to status updates", resource, err)
+	c.applyUnsupported = true
+	return true
+}
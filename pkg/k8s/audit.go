@@ -0,0 +1,96 @@
+package k8s
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// Audit record results
+const (
+	AuditResultApplied = "applied"
+	AuditResultNoOp    = "noop"
+	AuditResultSkipped = "skipped"
+	AuditResultFailed  = "failed"
+)
+
+// AuditRecord is the JSON line appended to the audit log for each UpdateJobStatus call
+type AuditRecord struct {
+	Timestamp     time.Time `json:"timestamp"`
+	Actor         string    `json:"actor"`
+	JobNamespace  string    `json:"jobNamespace"`
+	JobName       string    `json:"jobName"`
+	ConditionType string    `json:"conditionType"`
+	Status        string    `json:"status"`
+	Reason        string    `json:"reason"`
+	Message       string    `json:"message"`
+	Result        string    `json:"result"`
+	Error         string    `json:"error,omitempty"`
+}
+
+// WithAuditLog appends an AuditRecord to the file at path for every UpdateJobStatus call,
+// including no-ops. actor identifies who made the update (for example, the pod name).
+func WithAuditLog(path, actor string) ClientOption {
+	return func(c *Client) {
+		if path != "" {
+			c.audit = &auditLog{path: path, actor: actor}
+		}
+	}
+}
+
+// auditLog appends audit records to a file. Writes are best-effort: failures are logged
+// but never fail the status update.
+type auditLog struct {
+	path  string
+	actor string
+	mu    sync.Mutex
+}
+
+func (a *auditLog) record(namespace, jobName string, condition JobCondition, result string, updateErr error) {
+	if a == nil {
+		return
+	}
+
+	rec := AuditRecord{
+		Timestamp:     time.Now().UTC(),
+		Actor:         a.actor,
+		JobNamespace:  namespace,
+		JobName:       jobName,
+		ConditionType: condition.Type,
+		Status:        condition.Status,
+		Reason:        condition.Reason,
+		Message:       condition.Message,
+		Result:        result,
+	}
+	if updateErr != nil {
+		rec.Error = updateErr.Error()
+	}
+
+	if err := a.append(rec); err != nil {
+		log.Printf("ERROR: failed to write audit record for job %s/%s to %s: %v", namespace, jobName, a.path, err)
+	}
+}
+
+func (a *auditLog) append(rec AuditRecord) error {
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit record: %w", err)
+	}
+	line = append(line, '\n')
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	f, err := os.OpenFile(a.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(line); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -2,13 +2,20 @@ package k8s
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/util/retry"
@@ -17,17 +24,122 @@ import (
 const (
 	// StatusReporterContainerName is the name of the status reporter sidecar container
 	StatusReporterContainerName = "status-reporter"
+
+	// RunIDAnnotation records the run ID of the reporter that last set the Job condition
+	RunIDAnnotation = "hyperfleet.io/status-reporter-run-id"
+
+	// AdapterImageAnnotation records the image of the adapter container that produced the reported result
+	AdapterImageAnnotation = "hyperfleet.io/status-reporter-adapter-image"
+
+	// RestartCountAnnotation records how many times the adapter container restarted during the run
+	RestartCountAnnotation = "hyperfleet.io/status-reporter-restart-count"
+
+	// ResultAnnotation is the default Job annotation receiving the reported reason and message
+	ResultAnnotation = "hyperfleet.io/adapter-result"
+
+	// ResultArchiveAnnotation records the object storage URL of the archived adapter result
+	ResultArchiveAnnotation = "hyperfleet.io/adapter-result-archive"
+
+	// CorrelationIDAnnotation records the correlation ID of the reported condition
+	CorrelationIDAnnotation = "hyperfleet.io/correlation-id"
+
+	// ObservedGenerationAnnotation records the observed generation of the reported condition
+	ObservedGenerationAnnotation = "hyperfleet.io/observed-generation"
+
+	// EmailNotificationAnnotation records when the failure email for the Job was sent, so only one
+	// email is sent per Job
+	EmailNotificationAnnotation = "hyperfleet.io/status-reporter-email-sent"
 )
 
 // Client wraps Kubernetes client operations
 type Client struct {
-	clientset *kubernetes.Clientset
-	namespace string
-	jobName   string
+	clientset               kubernetes.Interface
+	namespace               string
+	jobName                 string
+	allowSpecUpdateFallback bool
+	runID                   string
+	audit                   *auditLog
+	retryable               *RetryableErrorMatcher
+	statePath               string
+	statusTarget            *StatusTarget
+	dynamic                 dynamic.Interface
+	targetMapping           *meta.RESTMapping
+	events                  *EventRecorder
+	jobRef                  *corev1.ObjectReference
+	jobSetRollup            JobSetRollupMode
+	jobSetMember            *JobSetMember
+	ownerReportMode         OwnerReportMode
+	ownerResolved           bool
+	owner                   *Client
+	serverSideApply         bool
+	applyUnsupported        bool
+
+	collapseDuplicateConditions bool
+}
+
+// ClientOption configures optional Client behavior
+type ClientOption func(*Client)
+
+// WithSpecUpdateFallback retries a Forbidden status subresource update as a plain Job update,
+// for clusters whose RBAC grants update on jobs but not on jobs/status
+func WithSpecUpdateFallback(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.allowSpecUpdateFallback = enabled
+	}
+}
+
+// WithRunID makes condition updates idempotent per run: the run ID is stamped into the
+// RunIDAnnotation after an update, and an update is skipped when the Job already carries the
+// same condition (status, reason and message) written under the same run ID, for example by a
+// duplicate reporter invocation. Changed conditions of the run, such as the terminal condition
+// replacing an in-progress one, are still written.
+func WithRunID(runID string) ClientOption {
+	return func(c *Client) {
+		c.runID = runID
+	}
+}
+
+// WithDuplicateConditionCollapse removes all but the first condition of the target type
+// when updating the Job, restoring the one-condition-per-type invariant after a
+// misbehaving controller has written duplicates
+func WithDuplicateConditionCollapse(enabled bool) ClientOption {
+	return func(c *Client) {
+		c.collapseDuplicateConditions = enabled
+	}
+}
+
+// WithClientset makes NewClient use clientset instead of creating one from the in-cluster
+// config, so it can be shared with an EventRecorder
+func WithClientset(clientset kubernetes.Interface) ClientOption {
+	return func(c *Client) {
+		c.clientset = clientset
+	}
 }
 
 // NewClient creates a new Kubernetes client using in-cluster config
-func NewClient(namespace, jobName string) (*Client, error) {
+func NewClient(namespace, jobName string, opts ...ClientOption) (*Client, error) {
+	c := NewClientWithClientset(nil, namespace, jobName, opts...)
+	if c.clientset == nil {
+		clientset, err := NewInClusterClientset()
+		if err != nil {
+			return nil, err
+		}
+		c.clientset = clientset
+	}
+	if (c.statusTarget != nil || c.jobSetRollup != "" || c.ownerReportMode != "") && c.dynamic == nil {
+		config, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get in-cluster config: %w", err)
+		}
+		if c.dynamic, err = dynamic.NewForConfig(config); err != nil {
+			return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+		}
+	}
+	return c, nil
+}
+
+// NewInClusterClientset creates a clientset using in-cluster config
+func NewInClusterClientset() (kubernetes.Interface, error) {
 	config, err := rest.InClusterConfig()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get in-cluster config: %w", err)
@@ -38,11 +150,20 @@ func NewClient(namespace, jobName string) (*Client, error) {
 		return nil, fmt.Errorf("failed to create clientset: %w", err)
 	}
 
-	return &Client{
+	return clientset, nil
+}
+
+// NewClientWithClientset creates a new Kubernetes client from an existing clientset (for testing)
+func NewClientWithClientset(clientset kubernetes.Interface, namespace, jobName string, opts ...ClientOption) *Client {
+	c := &Client{
 		clientset: clientset,
 		namespace: namespace,
 		jobName:   jobName,
-	}, nil
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // JobCondition represents a Kubernetes Job condition
@@ -54,15 +175,67 @@ type JobCondition struct {
 	LastTransitionTime time.Time
 }
 
-// UpdateJobStatus updates the Job status with the given condition
-// Note: RetryOnConflict only retries on conflict errors; NotFound and other errors return immediately
+// UpdateJobStatus updates the Job status with the given condition, or the status of the status
+// target when one is configured
+// Note: only conflicts and errors matched by WithRetryableErrors are retried; NotFound and other errors return immediately
 func (c *Client) UpdateJobStatus(ctx context.Context, condition JobCondition) error {
-	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+	return c.UpdateJobConditions(ctx, []JobCondition{condition})
+}
+
+// UpdateJobConditions sets all the given conditions, each of a different type, in a single
+// status update of the Job or the status target
+func (c *Client) UpdateJobConditions(ctx context.Context, conditions []JobCondition) error {
+	result := AuditResultFailed
+	var err error
+	if c.ownerReportMode != "" {
+		err = c.resolveOwner(ctx)
+	}
+	if err == nil {
+		result, err = c.updateJobConditions(ctx, conditions)
+	}
+	namespace, name := c.namespace, c.jobName
+	if c.statusTarget != nil {
+		namespace, name = c.statusTarget.Namespace, c.statusTarget.Name
+	}
+	for _, condition := range conditions {
+		c.audit.record(namespace, name, condition, result, err)
+	}
+	if err == nil && result == AuditResultApplied && c.statusTarget == nil {
+		if c.jobSetRollup != "" {
+			c.rollUpToJobSet(ctx, conditions)
+		}
+		c.updateOwnerConditions(ctx, conditions)
+	}
+	return err
+}
+
+// updateJobConditions performs the update and reports whether it was applied, a no-op or skipped
+func (c *Client) updateJobConditions(ctx context.Context, conditions []JobCondition) (string, error) {
+	var result string
+	attempts := 0
+	isRetryable := func(err error) bool {
+		if !c.isRetryable(err) {
+			return false
+		}
+		c.writeState(ReportingStateRetrying, attempts, err)
+		return true
+	}
+
+	err := retry.OnError(retry.DefaultBackoff, isRetryable, func() error {
+		attempts++
 		// Basic input validation to avoid creating invalid JobStatus objects.
-		switch corev1.ConditionStatus(condition.Status) {
-		case corev1.ConditionTrue, corev1.ConditionFalse, corev1.ConditionUnknown:
-		default:
-			return fmt.Errorf("invalid condition status: %q (expected True/False/Unknown)", condition.Status)
+		for _, condition := range conditions {
+			switch corev1.ConditionStatus(condition.Status) {
+			case corev1.ConditionTrue, corev1.ConditionFalse, corev1.ConditionUnknown:
+			default:
+				return fmt.Errorf("invalid condition status: %q (expected True/False/Unknown)", condition.Status)
+			}
+		}
+
+		if c.statusTarget != nil {
+			var err error
+			result, err = c.updateTargetConditions(ctx, conditions)
+			return err
 		}
 
 		// Fetch the latest job object to get current resourceVersion
@@ -74,73 +247,517 @@ func (c *Client) UpdateJobStatus(ctx context.Context, condition JobCondition) er
 			return err
 		}
 
+		result = AuditResultSkipped
+		changed := false
+		for _, condition := range conditions {
+			if c.writtenByRun(job, condition) {
+				log.Printf("Condition %s on job %s/%s was already written by run %s; skipping update",
+					condition.Type, c.namespace, c.jobName, c.runID)
+				continue
+			}
+			result = AuditResultNoOp
+			if c.setCondition(job, condition) {
+				changed = true
+			}
+		}
+		if !changed {
+			return nil
+		}
+		result = AuditResultApplied
+
+		if c.serverSideApply && !c.applyUnsupported && !c.collapseDuplicateConditions {
+			err = c.patchJobConditions(ctx, job, conditionTypes(conditions))
+			if !c.fallBackFromApply(err, "job "+c.namespace+"/"+c.jobName) {
+				if err != nil {
+					return err
+				}
+				c.stampRunID(ctx)
+				return nil
+			}
+		}
+
+		_, err = c.clientset.BatchV1().Jobs(c.namespace).UpdateStatus(ctx, job, metav1.UpdateOptions{})
+		if errors.IsForbidden(err) {
+			if !c.allowSpecUpdateFallback {
+				return fmt.Errorf("forbidden to update status of job %s/%s; grant update on jobs/status to the reporter's ServiceAccount: %w",
+					c.namespace, c.jobName, err)
+			}
+			log.Printf("Warning: forbidden to update status subresource of job %s/%s (%v); falling back to updating the Job object. "+
+				"Grant update on jobs/status: the API server may ignore status changes made through the main resource",
+				c.namespace, c.jobName, err)
+			_, err = c.clientset.BatchV1().Jobs(c.namespace).Update(ctx, job, metav1.UpdateOptions{})
+		}
+		if err != nil {
+			return err
+		}
+
+		c.stampRunID(ctx)
+		return nil
+	})
+	if err != nil {
+		c.writeState(ReportingStateFailed, attempts, err)
+		return AuditResultFailed, err
+	}
+	c.writeState(ReportingStateOK, attempts, nil)
+	return result, nil
+}
+
+// conditionTypes returns the types of the conditions
+func conditionTypes(conditions []JobCondition) []string {
+	types := make([]string, len(conditions))
+	for i, condition := range conditions {
+		types[i] = condition.Type
+	}
+	return types
+}
+
+// setCondition adds or replaces the condition in the Job status and reports whether the status
+// changed; a semantically identical condition is kept with its LastTransitionTime
+func (c *Client) setCondition(job *batchv1.Job, condition JobCondition) bool {
+	transitionTime := condition.LastTransitionTime
+	if transitionTime.IsZero() {
+		transitionTime = time.Now()
+	}
+
+	newCondition := batchv1.JobCondition{
+		Type:               batchv1.JobConditionType(condition.Type),
+		Status:             corev1.ConditionStatus(condition.Status),
+		LastTransitionTime: metav1.NewTime(transitionTime),
+		Reason:             condition.Reason,
+		Message:            condition.Message,
+	}
+
+	existingIndex := -1
+	for i, existing := range job.Status.Conditions {
+		if existing.Type == newCondition.Type {
+			existingIndex = i
+			break
+		}
+	}
+
+	duplicates := 0
+	if c.collapseDuplicateConditions && existingIndex >= 0 {
+		duplicates = collapseDuplicateConditions(job, newCondition.Type)
+		if duplicates > 0 {
+			log.Printf("Warning: removing %d duplicate %s condition(s) from job %s/%s",
+				duplicates, newCondition.Type, c.namespace, c.jobName)
+		}
+	}
+
+	if existingIndex >= 0 {
+		existing := job.Status.Conditions[existingIndex]
+		// No-op if semantically identical; preserves LastTransitionTime.
+		if duplicates == 0 && existing.Status == newCondition.Status && existing.Reason == newCondition.Reason && existing.Message == newCondition.Message {
+			return false
+		}
+		job.Status.Conditions[existingIndex] = newCondition
+	} else {
+		job.Status.Conditions = append(job.Status.Conditions, newCondition)
+	}
+	return true
+}
+
+// collapseDuplicateConditions keeps the first condition of the given type and drops the
+// rest, returning the number removed
+func collapseDuplicateConditions(job *batchv1.Job, conditionType batchv1.JobConditionType) int {
+	seen := false
+	kept := job.Status.Conditions[:0]
+	for _, existing := range job.Status.Conditions {
+		if existing.Type == conditionType {
+			if seen {
+				continue
+			}
+			seen = true
+		}
+		kept = append(kept, existing)
+	}
+	removed := len(job.Status.Conditions) - len(kept)
+	job.Status.Conditions = kept
+	return removed
+}
+
+// writtenByRun reports whether the Job already carries the condition, with the same status,
+// reason and message, under the configured run ID
+func (c *Client) writtenByRun(job *batchv1.Job, condition JobCondition) bool {
+	if c.runID == "" || job.Annotations[RunIDAnnotation] != c.runID {
+		return false
+	}
+	for _, existing := range job.Status.Conditions {
+		if string(existing.Type) == condition.Type {
+			return string(existing.Status) == condition.Status && existing.Reason == condition.Reason &&
+				existing.Message == condition.Message
+		}
+	}
+	return false
+}
+
+// stampRunID records the run ID on the Job. It is best-effort: the condition is already written,
+// so a failure only weakens duplicate detection.
+func (c *Client) stampRunID(ctx context.Context) {
+	if c.runID == "" {
+		return
+	}
+
+	if err := c.AnnotateJob(ctx, map[string]string{RunIDAnnotation: c.runID}); err != nil {
+		log.Printf("Warning: failed to annotate job %s/%s with run ID %s: %v", c.namespace, c.jobName, c.runID, err)
+	}
+}
+
+// AnnotateJob merges the annotations into the Job's metadata
+func (c *Client) AnnotateJob(ctx context.Context, annotations map[string]string) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build annotation patch: %w", err)
+	}
+
+	if _, err := c.clientset.BatchV1().Jobs(c.namespace).Patch(ctx, c.jobName, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to patch job annotations: namespace=%s name=%s: %w", c.namespace, c.jobName, err)
+	}
+	return nil
+}
+
+// AnnotateConditionTarget merges the annotations into the metadata of the resource the conditions
+// are written to: the status target, or the owner when it replaces the Job, otherwise the Job. In
+// additional owner mode the owner is annotated too; failing that is logged.
+func (c *Client) AnnotateConditionTarget(ctx context.Context, annotations map[string]string) error {
+	if c.statusTarget == nil {
+		if err := c.AnnotateJob(ctx, annotations); err != nil {
+			return err
+		}
+		if c.owner != nil {
+			if err := c.owner.AnnotateConditionTarget(ctx, annotations); err != nil {
+				log.Printf("Warning: failed to annotate owner %s of job %s/%s: %v", c.owner.statusTarget, c.namespace, c.jobName, err)
+			}
+		}
+		return nil
+	}
+
+	resource, err := c.targetResource()
+	if err != nil {
+		return err
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build annotation patch: %w", err)
+	}
+	if _, err := resource.Patch(ctx, c.statusTarget.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to patch annotations of %s: %w", c.statusTarget, err)
+	}
+	return nil
+}
+
+// ClaimJobAnnotation sets the Job annotation unless it is already present, and reports whether
+// this call set it. The update carries the Job's resourceVersion, so of concurrent claims only one
+// succeeds.
+func (c *Client) ClaimJobAnnotation(ctx context.Context, key, value string) (bool, error) {
+	claimed := false
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		job, err := c.clientset.BatchV1().Jobs(c.namespace).Get(ctx, c.jobName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if _, ok := job.Annotations[key]; ok {
+			return nil
+		}
+		if job.Annotations == nil {
+			job.Annotations = map[string]string{}
+		}
+		job.Annotations[key] = value
+		if _, err := c.clientset.BatchV1().Jobs(c.namespace).Update(ctx, job, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+		claimed = true
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to claim job annotation %s: namespace=%s name=%s: %w", key, c.namespace, c.jobName, err)
+	}
+	return claimed, nil
+}
+
+// RemoveJobAnnotation deletes the annotation from the Job's metadata
+func (c *Client) RemoveJobAnnotation(ctx context.Context, key string) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]any{key: nil},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build annotation patch: %w", err)
+	}
+
+	if _, err := c.clientset.BatchV1().Jobs(c.namespace).Patch(ctx, c.jobName, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to remove job annotation %s: namespace=%s name=%s: %w", key, c.namespace, c.jobName, err)
+	}
+	return nil
+}
+
+// UpdatePodCondition sets the condition in the pod's status.conditions, e.g. for a readiness
+// gate. A condition whose status, reason and message are unchanged is left as is.
+func (c *Client) UpdatePodCondition(ctx context.Context, podName string, condition JobCondition) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		pod, err := c.clientset.CoreV1().Pods(c.namespace).Get(ctx, podName, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get pod: namespace=%s pod=%s: %w", c.namespace, podName, err)
+		}
+
 		transitionTime := condition.LastTransitionTime
 		if transitionTime.IsZero() {
 			transitionTime = time.Now()
 		}
-
-		newCondition := batchv1.JobCondition{
-			Type:               batchv1.JobConditionType(condition.Type),
+		newCondition := corev1.PodCondition{
+			Type:               corev1.PodConditionType(condition.Type),
 			Status:             corev1.ConditionStatus(condition.Status),
-			LastTransitionTime: metav1.NewTime(transitionTime),
 			Reason:             condition.Reason,
 			Message:            condition.Message,
+			LastProbeTime:      metav1.Now(),
+			LastTransitionTime: metav1.NewTime(transitionTime),
 		}
 
-		conditionUpdated := false
-		for i, existing := range job.Status.Conditions {
-			if existing.Type != newCondition.Type {
-				continue
+		existingIndex := -1
+		for i, existing := range pod.Status.Conditions {
+			if existing.Type == newCondition.Type {
+				existingIndex = i
+				break
 			}
-			// No-op if semantically identical; preserves LastTransitionTime.
+		}
+		if existingIndex >= 0 {
+			existing := pod.Status.Conditions[existingIndex]
 			if existing.Status == newCondition.Status && existing.Reason == newCondition.Reason && existing.Message == newCondition.Message {
 				return nil
 			}
-			job.Status.Conditions[i] = newCondition
-			conditionUpdated = true
-			break
+			pod.Status.Conditions[existingIndex] = newCondition
+		} else {
+			pod.Status.Conditions = append(pod.Status.Conditions, newCondition)
 		}
 
-		if !conditionUpdated {
-			job.Status.Conditions = append(job.Status.Conditions, newCondition)
+		if _, err := c.clientset.CoreV1().Pods(c.namespace).UpdateStatus(ctx, pod, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update pod status: namespace=%s pod=%s: %w", c.namespace, podName, err)
 		}
+		return nil
+	})
+}
 
-		_, err = c.clientset.BatchV1().Jobs(c.namespace).UpdateStatus(ctx, job, metav1.UpdateOptions{})
+// ApplyJobConfigMap creates the ConfigMap, or replaces the data of an existing one, with the Job
+// as its owner so the ConfigMap is garbage collected with the Job
+func (c *Client) ApplyJobConfigMap(ctx context.Context, name string, data map[string]string) error {
+	job, err := c.clientset.BatchV1().Jobs(c.namespace).Get(ctx, c.jobName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get job: namespace=%s name=%s: %w", c.namespace, c.jobName, err)
+	}
+	owner := metav1.OwnerReference{
+		APIVersion: "batch/v1",
+		Kind:       "Job",
+		Name:       job.Name,
+		UID:        job.UID,
+	}
+
+	configMaps := c.clientset.CoreV1().ConfigMaps(c.namespace)
+	_, err = configMaps.Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       c.namespace,
+			OwnerReferences: []metav1.OwnerReference{owner},
+		},
+		Data: data,
+	}, metav1.CreateOptions{})
+	if err == nil {
+		return nil
+	}
+	if !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create configmap: namespace=%s name=%s: %w", c.namespace, name, err)
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		cm, err := configMaps.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		cm.Data = data
+		if !hasOwner(cm.OwnerReferences, owner.UID) {
+			cm.OwnerReferences = append(cm.OwnerReferences, owner)
+		}
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
 		return err
 	})
+	if err != nil {
+		return fmt.Errorf("failed to update configmap: namespace=%s name=%s: %w", c.namespace, name, err)
+	}
+	return nil
 }
 
-// GetPodStatus retrieves pod status by name
-func (c *Client) GetPodStatus(ctx context.Context, podName string) (*corev1.PodStatus, error) {
+// hasOwner reports whether the owner references include the object with the given UID
+func hasOwner(owners []metav1.OwnerReference, uid types.UID) bool {
+	for _, owner := range owners {
+		if owner.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// GetJobActiveDeadlineSeconds returns the Job's spec.activeDeadlineSeconds, or nil if unset
+func (c *Client) GetJobActiveDeadlineSeconds(ctx context.Context) (*int64, error) {
+	job, err := c.clientset.BatchV1().Jobs(c.namespace).Get(ctx, c.jobName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: namespace=%s name=%s: %w", c.namespace, c.jobName, err)
+	}
+	return job.Spec.ActiveDeadlineSeconds, nil
+}
+
+// GetJobUID returns the Job's metadata.uid, which unlike its name is unique across re-creations
+func (c *Client) GetJobUID(ctx context.Context) (string, error) {
+	job, err := c.clientset.BatchV1().Jobs(c.namespace).Get(ctx, c.jobName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get job: namespace=%s name=%s: %w", c.namespace, c.jobName, err)
+	}
+	return string(job.UID), nil
+}
+
+// GetContainerLogTail returns the last lines of the container's log
+func (c *Client) GetContainerLogTail(ctx context.Context, podName, containerName string, lines int64) (string, error) {
+	data, err := c.clientset.CoreV1().Pods(c.namespace).GetLogs(podName, &corev1.PodLogOptions{
+		Container: containerName,
+		TailLines: &lines,
+	}).DoRaw(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get container logs: namespace=%s pod=%s container=%s: %w", c.namespace, podName, containerName, err)
+	}
+	return string(data), nil
+}
+
+// FindPodByPrefix returns the name of the single pod in the namespace whose name starts with prefix.
+// It is an error if no pod or more than one pod matches.
+func (c *Client) FindPodByPrefix(ctx context.Context, prefix string) (string, error) {
+	pods, err := c.clientset.CoreV1().Pods(c.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to list pods: namespace=%s: %w", c.namespace, err)
+	}
+
+	var matches []string
+	for _, pod := range pods.Items {
+		if strings.HasPrefix(pod.Name, prefix) {
+			matches = append(matches, pod.Name)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no pod found with name prefix: namespace=%s prefix=%s", c.namespace, prefix)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("multiple pods found with name prefix: namespace=%s prefix=%s pods=%s",
+			c.namespace, prefix, strings.Join(matches, ","))
+	}
+}
+
+// GetPod retrieves the pod by name
+func (c *Client) GetPod(ctx context.Context, podName string) (*corev1.Pod, error) {
 	pod, err := c.clientset.CoreV1().Pods(c.namespace).Get(ctx, podName, metav1.GetOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get pod: namespace=%s pod=%s: %w", c.namespace, podName, err)
 	}
+	return pod, nil
+}
+
+// GetPodStatus retrieves pod status by name
+func (c *Client) GetPodStatus(ctx context.Context, podName string) (*corev1.PodStatus, error) {
+	pod, err := c.GetPod(ctx, podName)
+	if err != nil {
+		return nil, err
+	}
 
 	return &pod.Status, nil
 }
 
 // GetAdapterContainerStatus finds the adapter container status
 func (c *Client) GetAdapterContainerStatus(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
-	podStatus, err := c.GetPodStatus(ctx, podName)
+	pod, err := c.GetPod(ctx, podName)
 	if err != nil {
 		return nil, err
 	}
+	return AdapterContainerStatus(pod, containerName)
+}
 
+// AdapterContainerStatus finds the adapter container status in an already fetched pod. An empty
+// containerName selects the first container other than the status reporter.
+func AdapterContainerStatus(pod *corev1.Pod, containerName string) (*corev1.ContainerStatus, error) {
 	if containerName != "" {
-		for _, cs := range podStatus.ContainerStatuses {
+		for _, cs := range pod.Status.ContainerStatuses {
 			if cs.Name == containerName {
 				return &cs, nil
 			}
 		}
-		return nil, fmt.Errorf("container not found: namespace=%s pod=%s container=%s", c.namespace, podName, containerName)
+		return nil, fmt.Errorf("container not found: namespace=%s pod=%s container=%s", pod.Namespace, pod.Name, containerName)
 	}
 
-	for _, cs := range podStatus.ContainerStatuses {
+	for _, cs := range pod.Status.ContainerStatuses {
 		if cs.Name != StatusReporterContainerName {
 			return &cs, nil
 		}
 	}
 
-	return nil, fmt.Errorf("adapter container not found: namespace=%s pod=%s", c.namespace, podName)
+	return nil, fmt.Errorf("adapter container not found: namespace=%s pod=%s", pod.Namespace, pod.Name)
+}
+
+// GetContainerMemoryLimit returns the memory limit of the adapter container from the pod spec,
+// or nil if no limit is set. An empty containerName selects the adapter as in GetAdapterContainerStatus.
+func (c *Client) GetContainerMemoryLimit(ctx context.Context, podName, containerName string) (*resource.Quantity, error) {
+	pod, err := c.clientset.CoreV1().Pods(c.namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pod: namespace=%s pod=%s: %w", c.namespace, podName, err)
+	}
+
+	for _, container := range pod.Spec.Containers {
+		if container.Name == containerName || (containerName == "" && container.Name != StatusReporterContainerName) {
+			limit, ok := container.Resources.Limits[corev1.ResourceMemory]
+			if !ok {
+				return nil, nil
+			}
+			return &limit, nil
+		}
+	}
+
+	return nil, fmt.Errorf("container not found: namespace=%s pod=%s container=%s", c.namespace, podName, containerName)
+}
+
+// GetFailedInitContainerStatus returns the status of the first init container that terminated
+// with a non-zero exit code, or nil if no init container has failed. Init containers that are
+// waiting to be restarted after a failure are reported using their last termination state.
+func (c *Client) GetFailedInitContainerStatus(ctx context.Context, podName string) (*corev1.ContainerStatus, error) {
+	podStatus, err := c.GetPodStatus(ctx, podName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cs := range podStatus.InitContainerStatuses {
+		if InitContainerTermination(&cs) != nil {
+			return &cs, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// InitContainerTermination returns the abnormal termination state of an init container, or nil
+// if it has not terminated with a non-zero exit code
+func InitContainerTermination(cs *corev1.ContainerStatus) *corev1.ContainerStateTerminated {
+	if t := cs.State.Terminated; t != nil && t.ExitCode != 0 {
+		return t
+	}
+	if cs.State.Waiting != nil {
+		if t := cs.LastTerminationState.Terminated; t != nil && t.ExitCode != 0 {
+			return t
+		}
+	}
+	return nil
 }
@@ -1,10 +1,29 @@
 package k8s_test
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
 )
@@ -40,3 +59,1481 @@ var _ = Describe("JobCondition", func() {
 		})
 	})
 })
+
+var _ = Describe("Client", func() {
+	var (
+		ctx       context.Context
+		clientset *fake.Clientset
+		condition k8s.JobCondition
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		clientset = fake.NewClientset(&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-job", Namespace: "test-ns"},
+		})
+		condition = k8s.JobCondition{
+			Type:    "Available",
+			Status:  "True",
+			Reason:  "AllChecksPassed",
+			Message: "All validations passed",
+		}
+	})
+
+	getJob := func() *batchv1.Job {
+		job, err := clientset.BatchV1().Jobs("test-ns").Get(ctx, "test-job", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return job
+	}
+
+	forbidStatusUpdates := func() {
+		clientset.PrependReactor("update", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "status" {
+				return false, nil, nil
+			}
+			return true, nil, apierrors.NewForbidden(schema.GroupResource{Group: "batch", Resource: "jobs/status"}, "test-job", nil)
+		})
+	}
+
+	Describe("UpdateJobStatus", func() {
+		It("adds the condition to the Job status", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			conditions := getJob().Status.Conditions
+			Expect(conditions).To(HaveLen(1))
+			Expect(string(conditions[0].Type)).To(Equal("Available"))
+			Expect(conditions[0].Reason).To(Equal("AllChecksPassed"))
+		})
+
+		It("rejects invalid condition status", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+			condition.Status = "Maybe"
+
+			err := client.UpdateJobStatus(ctx, condition)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid condition status"))
+		})
+
+		Context("when the status subresource is forbidden", func() {
+			BeforeEach(func() {
+				forbidStatusUpdates()
+			})
+
+			It("returns the Forbidden error with the missing permission by default", func() {
+				client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+				err := client.UpdateJobStatus(ctx, condition)
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err).To(MatchError(ContainSubstring("grant update on jobs/status")))
+
+				for _, action := range clientset.Actions() {
+					Expect(action.GetVerb() == "update" && action.GetSubresource() == "").To(BeFalse())
+				}
+			})
+
+			It("falls back to updating the Job object when enabled", func() {
+				client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithSpecUpdateFallback(true))
+
+				Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+				var updatedMainResource bool
+				for _, action := range clientset.Actions() {
+					if action.GetVerb() == "update" && action.GetSubresource() == "" {
+						updatedMainResource = true
+					}
+				}
+				Expect(updatedMainResource).To(BeTrue())
+			})
+		})
+
+		Context("when the Job carries duplicate conditions of the target type", func() {
+			BeforeEach(func() {
+				job := getJob()
+				job.Status.Conditions = []batchv1.JobCondition{
+					{Type: "Available", Status: corev1.ConditionTrue, Reason: "AllChecksPassed", Message: "All validations passed"},
+					{Type: "Complete", Status: corev1.ConditionTrue},
+					{Type: "Available", Status: corev1.ConditionFalse, Reason: "Stale"},
+				}
+				_, err := clientset.BatchV1().Jobs("test-ns").UpdateStatus(ctx, job, metav1.UpdateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("leaves the duplicates by default", func() {
+				client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+				Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+				Expect(getJob().Status.Conditions).To(HaveLen(3))
+			})
+
+			It("collapses them into the first when enabled", func() {
+				client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithDuplicateConditionCollapse(true))
+
+				Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+				conditions := getJob().Status.Conditions
+				Expect(conditions).To(HaveLen(2))
+				Expect(string(conditions[0].Type)).To(Equal("Available"))
+				Expect(conditions[0].Reason).To(Equal("AllChecksPassed"))
+				Expect(string(conditions[1].Type)).To(Equal("Complete"))
+			})
+		})
+
+		Context("with a run ID", func() {
+			It("stamps the run ID annotation after updating", func() {
+				client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithRunID("run-1"))
+
+				Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+				Expect(getJob().Annotations).To(HaveKeyWithValue(k8s.RunIDAnnotation, "run-1"))
+			})
+
+			It("skips the update when the same run already wrote the condition", func() {
+				Expect(k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithRunID("run-1")).
+					UpdateJobStatus(ctx, condition)).To(Succeed())
+				written := getJob().Status.Conditions[0]
+
+				condition.LastTransitionTime = written.LastTransitionTime.Add(time.Hour)
+				Expect(k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithRunID("run-1")).
+					UpdateJobStatus(ctx, condition)).To(Succeed())
+
+				conditions := getJob().Status.Conditions
+				Expect(conditions).To(HaveLen(1))
+				Expect(conditions[0].LastTransitionTime).To(Equal(written.LastTransitionTime))
+			})
+
+			It("writes the terminal condition after an in-progress condition of the same run", func() {
+				client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithRunID("run-1"))
+				Expect(client.UpdateJobConditions(ctx, []k8s.JobCondition{
+					{Type: "Available", Status: "Unknown", Reason: "AdapterRunning", Message: "Adapter is running"},
+					{Type: "AvailableProgressing", Status: "True", Reason: "AdapterRunning", Message: "Adapter is running"},
+				})).To(Succeed())
+
+				client = k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithRunID("run-1"))
+				Expect(client.UpdateJobConditions(ctx, []k8s.JobCondition{
+					condition,
+					{Type: "AvailableProgressing", Status: "False", Reason: "AdapterFinished", Message: "Adapter finished"},
+				})).To(Succeed())
+
+				job := getJob()
+				Expect(job.Status.Conditions).To(ConsistOf(
+					And(HaveField("Type", batchv1.JobConditionType("Available")), HaveField("Status", corev1.ConditionTrue),
+						HaveField("Reason", "AllChecksPassed")),
+					And(HaveField("Type", batchv1.JobConditionType("AvailableProgressing")), HaveField("Status", corev1.ConditionFalse)),
+				))
+				Expect(job.Annotations).To(HaveKeyWithValue(k8s.RunIDAnnotation, "run-1"))
+			})
+
+			It("updates the condition written by a different run", func() {
+				Expect(k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithRunID("run-1")).
+					UpdateJobStatus(ctx, condition)).To(Succeed())
+				condition.Status = "False"
+				condition.Reason = "AdapterCrashed"
+
+				Expect(k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithRunID("run-2")).
+					UpdateJobStatus(ctx, condition)).To(Succeed())
+
+				job := getJob()
+				Expect(job.Status.Conditions[0].Reason).To(Equal("AdapterCrashed"))
+				Expect(job.Annotations).To(HaveKeyWithValue(k8s.RunIDAnnotation, "run-2"))
+			})
+		})
+
+		Context("with an audit log", func() {
+			var auditPath string
+
+			readAudit := func() []k8s.AuditRecord {
+				f, err := os.Open(auditPath)
+				Expect(err).NotTo(HaveOccurred())
+				defer func() { _ = f.Close() }()
+
+				var records []k8s.AuditRecord
+				scanner := bufio.NewScanner(f)
+				for scanner.Scan() {
+					var rec k8s.AuditRecord
+					Expect(json.Unmarshal(scanner.Bytes(), &rec)).To(Succeed())
+					records = append(records, rec)
+				}
+				return records
+			}
+
+			BeforeEach(func() {
+				auditPath = filepath.Join(GinkgoT().TempDir(), "audit.log")
+			})
+
+			It("appends a record per update, marking no-ops", func() {
+				client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithAuditLog(auditPath, "test-pod"))
+
+				Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+				Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+				records := readAudit()
+				Expect(records).To(HaveLen(2))
+				Expect(records[0].Actor).To(Equal("test-pod"))
+				Expect(records[0].JobName).To(Equal("test-job"))
+				Expect(records[0].Reason).To(Equal("AllChecksPassed"))
+				Expect(records[0].Result).To(Equal(k8s.AuditResultApplied))
+				Expect(records[1].Result).To(Equal(k8s.AuditResultNoOp))
+			})
+
+			It("records failed updates with the error", func() {
+				forbidStatusUpdates()
+				client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithAuditLog(auditPath, "test-pod"))
+
+				Expect(client.UpdateJobStatus(ctx, condition)).NotTo(Succeed())
+
+				records := readAudit()
+				Expect(records).To(HaveLen(1))
+				Expect(records[0].Result).To(Equal(k8s.AuditResultFailed))
+				Expect(records[0].Error).NotTo(BeEmpty())
+			})
+
+			It("does not fail the update when the audit log cannot be written", func() {
+				client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+					k8s.WithAuditLog(filepath.Join(auditPath, "missing", "audit.log"), "test-pod"))
+
+				Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+			})
+		})
+
+		Context("with retryable error patterns", func() {
+			var attempts int
+
+			BeforeEach(func() {
+				attempts = 0
+				clientset.PrependReactor("update", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					attempts++
+					if attempts > 1 {
+						return false, nil, nil
+					}
+					return true, nil, &apierrors.StatusError{ErrStatus: metav1.Status{
+						Status: metav1.StatusFailure, Code: 502, Message: "upstream proxy: bad gateway",
+					}}
+				})
+			})
+
+			It("does not retry unmatched errors", func() {
+				client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+				Expect(client.UpdateJobStatus(ctx, condition)).NotTo(Succeed())
+				Expect(attempts).To(Equal(1))
+			})
+
+			It("retries errors matching a status code", func() {
+				client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+					k8s.WithRetryableErrors(k8s.NewRetryableErrorMatcher([]string{"502"})))
+
+				Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+				Expect(attempts).To(Equal(2))
+			})
+
+			It("records the retrying and final reporting state", func() {
+				statePath := filepath.Join(GinkgoT().TempDir(), "reporting-state.json")
+				readState := func() k8s.ReportingState {
+					data, err := os.ReadFile(statePath)
+					Expect(err).NotTo(HaveOccurred())
+					var state k8s.ReportingState
+					Expect(json.Unmarshal(data, &state)).To(Succeed())
+					return state
+				}
+				var stateDuringRetry k8s.ReportingState
+				clientset.PrependReactor("update", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					if attempts == 1 {
+						stateDuringRetry = readState()
+					}
+					return false, nil, nil
+				})
+				client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+					k8s.WithRetryableErrors(k8s.NewRetryableErrorMatcher([]string{"502"})), k8s.WithReportingStateFile(statePath))
+
+				Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+				Expect(stateDuringRetry.State).To(Equal(k8s.ReportingStateRetrying))
+				Expect(stateDuringRetry.LastError).To(ContainSubstring("bad gateway"))
+				final := readState()
+				Expect(final.State).To(Equal(k8s.ReportingStateOK))
+				Expect(final.Attempts).To(Equal(2))
+			})
+
+			It("retries errors matching a message substring", func() {
+				client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+					k8s.WithRetryableErrors(k8s.NewRetryableErrorMatcher([]string{"upstream proxy"})))
+
+				Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+				Expect(getJob().Status.Conditions).To(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("status target", func() {
+		var (
+			dynamicClient *dynamicfake.FakeDynamicClient
+			gvr           schema.GroupVersionResource
+			target        k8s.StatusTarget
+		)
+
+		BeforeEach(func() {
+			gvr = schema.GroupVersionResource{Group: "hyperfleet.io", Version: "v1", Resource: "clustervalidations"}
+			clientset.Resources = []*metav1.APIResourceList{{
+				GroupVersion: "hyperfleet.io/v1",
+				APIResources: []metav1.APIResource{
+					{Name: "clustervalidations", Kind: "ClusterValidation", Namespaced: true},
+					{Name: "clustervalidations/status", Kind: "ClusterValidation", Namespaced: true},
+				},
+			}}
+			validation := &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "hyperfleet.io/v1",
+				"kind":       "ClusterValidation",
+				"metadata":   map[string]any{"name": "my-cluster", "namespace": "target-ns", "generation": int64(3)},
+				"status": map[string]any{
+					"conditions": []any{map[string]any{"type": "Other", "status": "True"}},
+				},
+			}}
+			dynamicClient = dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{gvr: "ClusterValidationList"}, validation)
+			target = k8s.StatusTarget{Group: "hyperfleet.io", Kind: "ClusterValidation", Namespace: "target-ns", Name: "my-cluster"}
+		})
+
+		getConditions := func() []any {
+			obj, err := dynamicClient.Resource(gvr).Namespace("target-ns").Get(ctx, "my-cluster", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			conditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+			Expect(err).NotTo(HaveOccurred())
+			return conditions
+		}
+
+		It("adds the condition to the target's status instead of the Job's", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+				k8s.WithStatusTarget(target), k8s.WithDynamicClient(dynamicClient))
+
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			conditions := getConditions()
+			Expect(conditions).To(HaveLen(2))
+			added := conditions[1].(map[string]any)
+			Expect(added).To(HaveKeyWithValue("type", "Available"))
+			Expect(added).To(HaveKeyWithValue("status", "True"))
+			Expect(added).To(HaveKeyWithValue("reason", "AllChecksPassed"))
+			Expect(added).To(HaveKeyWithValue("observedGeneration", int64(3)))
+			Expect(added).To(HaveKey("lastTransitionTime"))
+			Expect(getJob().Status.Conditions).To(BeEmpty())
+		})
+
+		It("replaces an existing condition of the same type", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+				k8s.WithStatusTarget(target), k8s.WithDynamicClient(dynamicClient))
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			condition.Status = "False"
+			condition.Reason = "ChecksFailed"
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			conditions := getConditions()
+			Expect(conditions).To(HaveLen(2))
+			Expect(conditions[1]).To(HaveKeyWithValue("reason", "ChecksFailed"))
+		})
+
+		It("leaves an unchanged condition as is", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+				k8s.WithStatusTarget(target), k8s.WithDynamicClient(dynamicClient))
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+			transitionTime := getConditions()[1].(map[string]any)["lastTransitionTime"]
+
+			condition.LastTransitionTime = time.Now().Add(time.Hour)
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			Expect(getConditions()[1]).To(HaveKeyWithValue("lastTransitionTime", transitionTime))
+		})
+
+		It("returns an error when the kind cannot be resolved", func() {
+			target.Kind = "Unknown"
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+				k8s.WithStatusTarget(target), k8s.WithDynamicClient(dynamicClient))
+
+			Expect(client.UpdateJobStatus(ctx, condition)).To(MatchError(ContainSubstring("failed to resolve status target")))
+		})
+
+		It("returns an error when the target does not exist", func() {
+			target.Name = "missing"
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+				k8s.WithStatusTarget(target), k8s.WithDynamicClient(dynamicClient))
+
+			Expect(client.UpdateJobStatus(ctx, condition)).To(MatchError(ContainSubstring("not found")))
+		})
+
+		It("annotates the target instead of the Job", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+				k8s.WithStatusTarget(target), k8s.WithDynamicClient(dynamicClient))
+
+			Expect(client.AnnotateConditionTarget(ctx, map[string]string{k8s.CorrelationIDAnnotation: "reconcile-7f3a"})).To(Succeed())
+
+			obj, err := dynamicClient.Resource(gvr).Namespace("target-ns").Get(ctx, "my-cluster", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj.GetAnnotations()).To(HaveKeyWithValue(k8s.CorrelationIDAnnotation, "reconcile-7f3a"))
+			Expect(getJob().Annotations).NotTo(HaveKey(k8s.CorrelationIDAnnotation))
+		})
+	})
+
+	Describe("JobSet roll-up", func() {
+		var dynamicClient *dynamicfake.FakeDynamicClient
+
+		BeforeEach(func() {
+			controller := true
+			clientset = fake.NewClientset(&batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-job",
+					Namespace: "test-ns",
+					Labels: map[string]string{
+						k8s.ReplicatedJobNameLabel: "cluster-workers",
+						k8s.JobIndexLabel:          "3",
+					},
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: "jobset.x-k8s.io/v1alpha2",
+						Kind:       "JobSet",
+						Name:       "provision",
+						Controller: &controller,
+					}},
+				},
+			})
+			jobSet := &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "jobset.x-k8s.io/v1alpha2",
+				"kind":       "JobSet",
+				"metadata":   map[string]any{"name": "provision", "namespace": "test-ns"},
+				"spec": map[string]any{
+					"replicatedJobs": []any{map[string]any{"name": "cluster-workers", "replicas": int64(2)}},
+				},
+			}}
+			dynamicClient = dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{k8s.JobSetGVR: "JobSetList"}, jobSet)
+		})
+
+		getJobSet := func() *unstructured.Unstructured {
+			obj, err := dynamicClient.Resource(k8s.JobSetGVR).Namespace("test-ns").Get(ctx, "provision", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			return obj
+		}
+
+		getJobSetConditions := func() []any {
+			conditions, _, err := unstructured.NestedSlice(getJobSet().Object, "status", "conditions")
+			Expect(err).NotTo(HaveOccurred())
+			return conditions
+		}
+
+		It("finds the JobSet and the Job's place in it", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			member, err := client.GetJobSetMember(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(member).To(Equal(&k8s.JobSetMember{JobSet: "provision", ReplicatedJob: "cluster-workers", Index: "3"}))
+		})
+
+		It("reports the JobSet condition as pending until every child Job succeeded", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+				k8s.WithJobSetRollup(k8s.JobSetRollupStatus), k8s.WithDynamicClient(dynamicClient))
+
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			Expect(getJob().Status.Conditions).To(HaveLen(1))
+			conditions := getJobSetConditions()
+			Expect(conditions).To(HaveLen(1))
+			Expect(conditions[0]).To(HaveKeyWithValue("type", "Available"))
+			Expect(conditions[0]).To(HaveKeyWithValue("status", "Unknown"))
+			Expect(conditions[0]).To(HaveKeyWithValue("reason", k8s.ReasonJobSetChildrenPending))
+			Expect(conditions[0]).To(HaveKeyWithValue("message", "1 of 2 child Jobs reported True"))
+		})
+
+		It("reports True once all child Jobs succeeded", func() {
+			obj := getJobSet()
+			obj.SetAnnotations(map[string]string{"hyperfleet.io/adapter-result.cluster-workers-1": `{"conditionType":"Available","status":"True","reason":"cluster_workers_1_AllChecksPassed","message":"ok"}`})
+			_, err := dynamicClient.Resource(k8s.JobSetGVR).Namespace("test-ns").Update(ctx, obj, metav1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+				k8s.WithJobSetRollup(k8s.JobSetRollupStatus), k8s.WithDynamicClient(dynamicClient))
+
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			conditions := getJobSetConditions()
+			Expect(conditions[0]).To(HaveKeyWithValue("status", "True"))
+			Expect(conditions[0]).To(HaveKeyWithValue("reason", k8s.ReasonJobSetChildrenSucceeded))
+		})
+
+		It("keeps a sibling's failure over a success", func() {
+			obj := getJobSet()
+			obj.SetAnnotations(map[string]string{"hyperfleet.io/adapter-result.cluster-workers-1": `{"conditionType":"Available","status":"False","reason":"cluster_workers_1_ChecksFailed","message":"broken"}`})
+			_, err := dynamicClient.Resource(k8s.JobSetGVR).Namespace("test-ns").Update(ctx, obj, metav1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+				k8s.WithJobSetRollup(k8s.JobSetRollupStatus), k8s.WithDynamicClient(dynamicClient))
+
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+			Expect(getJobSetConditions()[0]).To(HaveKeyWithValue("reason", "cluster_workers_1_ChecksFailed"))
+
+			condition.Status = "False"
+			condition.Reason = "ChecksFailed"
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+			Expect(getJobSetConditions()[0]).To(HaveKeyWithValue("reason", k8s.ReasonJobSetChildrenFailed))
+		})
+
+		It("refuses a child Job without a replicated job label", func() {
+			job := getJob()
+			delete(job.Labels, k8s.ReplicatedJobNameLabel)
+			_, err := clientset.BatchV1().Jobs("test-ns").Update(ctx, job, metav1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			_, err = client.GetJobSetMember(ctx)
+			Expect(err).To(MatchError(ContainSubstring(k8s.ReplicatedJobNameLabel)))
+		})
+
+		It("annotates the JobSet per child Job", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+				k8s.WithJobSetRollup(k8s.JobSetRollupAnnotation), k8s.WithDynamicClient(dynamicClient))
+
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			Expect(getJobSet().GetAnnotations()).To(HaveKeyWithValue("hyperfleet.io/adapter-result.cluster-workers-3",
+				`{"conditionType":"Available","message":"All validations passed","reason":"cluster_workers_3_AllChecksPassed","status":"True"}`))
+		})
+
+		It("skips the roll-up when the Job is not owned by a JobSet", func() {
+			clientset = fake.NewClientset(&batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-job", Namespace: "test-ns"},
+			})
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+				k8s.WithJobSetRollup(k8s.JobSetRollupStatus), k8s.WithDynamicClient(dynamicClient))
+
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			Expect(getJob().Status.Conditions).To(HaveLen(1))
+			Expect(getJobSetConditions()).To(BeEmpty())
+		})
+	})
+
+	Describe("owner reporting", func() {
+		var (
+			dynamicClient *dynamicfake.FakeDynamicClient
+			cronJobGVR    schema.GroupVersionResource
+			validationGVR schema.GroupVersionResource
+		)
+
+		ownedBy := func(apiVersion, kind, name string) {
+			controller := true
+			clientset = fake.NewClientset(&batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-job",
+					Namespace: "test-ns",
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: apiVersion,
+						Kind:       kind,
+						Name:       name,
+						Controller: &controller,
+					}},
+				},
+			})
+			clientset.Resources = []*metav1.APIResourceList{
+				{
+					GroupVersion: "batch/v1",
+					APIResources: []metav1.APIResource{{Name: "cronjobs", Kind: "CronJob", Namespaced: true}},
+				},
+				{
+					GroupVersion: "hyperfleet.io/v1",
+					APIResources: []metav1.APIResource{{Name: "clustervalidations", Kind: "ClusterValidation", Namespaced: true}},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			cronJobGVR = schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"}
+			validationGVR = schema.GroupVersionResource{Group: "hyperfleet.io", Version: "v1", Resource: "clustervalidations"}
+			cronJob := &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "batch/v1",
+				"kind":       "CronJob",
+				"metadata":   map[string]any{"name": "nightly", "namespace": "test-ns"},
+			}}
+			validation := &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "hyperfleet.io/v1",
+				"kind":       "ClusterValidation",
+				"metadata":   map[string]any{"name": "my-cluster", "namespace": "test-ns", "generation": int64(2)},
+			}}
+			dynamicClient = dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{cronJobGVR: "CronJobList", validationGVR: "ClusterValidationList"},
+				cronJob, validation)
+		})
+
+		getValidationConditions := func() []any {
+			obj, err := dynamicClient.Resource(validationGVR).Namespace("test-ns").Get(ctx, "my-cluster", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			conditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+			Expect(err).NotTo(HaveOccurred())
+			return conditions
+		}
+
+		It("resolves the controller owner", func() {
+			ownedBy("hyperfleet.io/v1", "ClusterValidation", "my-cluster")
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			owner, err := client.GetJobOwner(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(owner).To(Equal(&k8s.StatusTarget{
+				Group: "hyperfleet.io", Version: "v1", Kind: "ClusterValidation", Namespace: "test-ns", Name: "my-cluster",
+			}))
+		})
+
+		It("reports on the owner instead of the Job", func() {
+			ownedBy("hyperfleet.io/v1", "ClusterValidation", "my-cluster")
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+				k8s.WithOwnerReporting(k8s.OwnerReportReplace), k8s.WithDynamicClient(dynamicClient))
+
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			conditions := getValidationConditions()
+			Expect(conditions).To(HaveLen(1))
+			Expect(conditions[0]).To(HaveKeyWithValue("reason", "AllChecksPassed"))
+			Expect(conditions[0]).To(HaveKeyWithValue("observedGeneration", int64(2)))
+			Expect(getJob().Status.Conditions).To(BeEmpty())
+		})
+
+		It("reports on the owner in addition to the Job", func() {
+			ownedBy("hyperfleet.io/v1", "ClusterValidation", "my-cluster")
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+				k8s.WithOwnerReporting(k8s.OwnerReportAdditional), k8s.WithDynamicClient(dynamicClient))
+
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			Expect(getValidationConditions()).To(HaveLen(1))
+			Expect(getJob().Status.Conditions).To(HaveLen(1))
+		})
+
+		It("annotates the owner in addition to the Job", func() {
+			ownedBy("hyperfleet.io/v1", "ClusterValidation", "my-cluster")
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+				k8s.WithOwnerReporting(k8s.OwnerReportAdditional), k8s.WithDynamicClient(dynamicClient))
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			Expect(client.AnnotateConditionTarget(ctx, map[string]string{k8s.CorrelationIDAnnotation: "reconcile-7f3a"})).To(Succeed())
+
+			obj, err := dynamicClient.Resource(validationGVR).Namespace("test-ns").Get(ctx, "my-cluster", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj.GetAnnotations()).To(HaveKeyWithValue(k8s.CorrelationIDAnnotation, "reconcile-7f3a"))
+			Expect(getJob().Annotations).To(HaveKeyWithValue(k8s.CorrelationIDAnnotation, "reconcile-7f3a"))
+		})
+
+		It("annotates a CronJob owner", func() {
+			ownedBy("batch/v1", "CronJob", "nightly")
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+				k8s.WithOwnerReporting(k8s.OwnerReportReplace), k8s.WithDynamicClient(dynamicClient))
+
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			obj, err := dynamicClient.Resource(cronJobGVR).Namespace("test-ns").Get(ctx, "nightly", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj.GetAnnotations()).To(HaveKeyWithValue(k8s.ResultAnnotation,
+				`{"conditionType":"Available","message":"All validations passed","reason":"AllChecksPassed","status":"True"}`))
+		})
+
+		It("reports on the Job when it has no controller owner", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job",
+				k8s.WithOwnerReporting(k8s.OwnerReportReplace), k8s.WithDynamicClient(dynamicClient))
+
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			Expect(getJob().Status.Conditions).To(HaveLen(1))
+		})
+	})
+
+	Describe("server-side apply", func() {
+		jobPatches := func() []k8stesting.PatchActionImpl {
+			var patches []k8stesting.PatchActionImpl
+			for _, action := range clientset.Actions() {
+				if patch, ok := action.(k8stesting.PatchActionImpl); ok && action.GetResource().Resource == "jobs" {
+					patches = append(patches, patch)
+				}
+			}
+			return patches
+		}
+
+		It("patches only the reported condition, keeping conditions of other controllers", func() {
+			job := getJob()
+			job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+			_, err := clientset.BatchV1().Jobs("test-ns").UpdateStatus(ctx, job, metav1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithServerSideApply(true))
+
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			patches := jobPatches()
+			Expect(patches).To(HaveLen(1))
+			Expect(patches[0].GetPatchType()).To(Equal(types.StrategicMergePatchType))
+			Expect(patches[0].GetSubresource()).To(Equal("status"))
+			Expect(patches[0].PatchOptions.FieldManager).To(Equal(k8s.FieldManager))
+			Expect(string(patches[0].GetPatch())).NotTo(ContainSubstring("Complete"))
+
+			conditions := getJob().Status.Conditions
+			Expect(conditions).To(HaveLen(2))
+			Expect(conditions).To(ContainElement(HaveField("Type", batchv1.JobComplete)))
+			Expect(conditions).To(ContainElement(SatisfyAll(
+				HaveField("Type", batchv1.JobConditionType("Available")),
+				HaveField("Reason", "AllChecksPassed"),
+			)))
+		})
+
+		It("falls back to a status update when the patch is not supported", func() {
+			clientset.PrependReactor("patch", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, apierrors.NewMethodNotSupported(schema.GroupResource{Group: "batch", Resource: "jobs/status"}, "patch")
+			})
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithServerSideApply(true))
+
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+			Expect(getJob().Status.Conditions).To(HaveLen(1))
+
+			condition.Reason = "ChecksRerun"
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+			Expect(jobPatches()).To(HaveLen(1))
+			Expect(getJob().Status.Conditions[0].Reason).To(Equal("ChecksRerun"))
+		})
+
+		It("applies the reported and previously applied conditions of the status target", func() {
+			gvr := schema.GroupVersionResource{Group: "hyperfleet.io", Version: "v1", Resource: "clustervalidations"}
+			clientset.Resources = []*metav1.APIResourceList{{
+				GroupVersion: "hyperfleet.io/v1",
+				APIResources: []metav1.APIResource{{Name: "clustervalidations", Kind: "ClusterValidation", Namespaced: true}},
+			}}
+			validation := &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "hyperfleet.io/v1",
+				"kind":       "ClusterValidation",
+				"metadata":   map[string]any{"name": "my-cluster", "namespace": "target-ns"},
+				"status": map[string]any{
+					"conditions": []any{
+						map[string]any{"type": "Other", "status": "True"},
+						map[string]any{"type": "Progressing", "status": "False", "reason": "Done"},
+					},
+				},
+			}}
+			validation.SetManagedFields([]metav1.ManagedFieldsEntry{{
+				Manager:     k8s.FieldManager,
+				Operation:   metav1.ManagedFieldsOperationApply,
+				Subresource: "status",
+				FieldsType:  "FieldsV1",
+				FieldsV1:    &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:conditions":{"k:{\"type\":\"Progressing\"}":{".":{}}}}}`)},
+			}})
+			dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{gvr: "ClusterValidationList"}, validation)
+			var applied *unstructured.Unstructured
+			dynamicClient.PrependReactor("patch", "clustervalidations", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				patch := action.(k8stesting.PatchActionImpl)
+				Expect(patch.GetPatchType()).To(Equal(types.ApplyPatchType))
+				Expect(patch.GetSubresource()).To(Equal("status"))
+				applied = &unstructured.Unstructured{}
+				Expect(json.Unmarshal(patch.GetPatch(), &applied.Object)).To(Succeed())
+				return true, applied, nil
+			})
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithServerSideApply(true),
+				k8s.WithStatusTarget(k8s.StatusTarget{Group: "hyperfleet.io", Kind: "ClusterValidation", Namespace: "target-ns", Name: "my-cluster"}),
+				k8s.WithDynamicClient(dynamicClient))
+
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			Expect(applied.GetName()).To(Equal("my-cluster"))
+			conditions, _, err := unstructured.NestedSlice(applied.Object, "status", "conditions")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conditions).To(HaveLen(2))
+			Expect(conditions[0]).To(HaveKeyWithValue("type", "Progressing"))
+			Expect(conditions[1]).To(HaveKeyWithValue("type", "Available"))
+		})
+
+		It("updates the status target when its conditions are an atomic list", func() {
+			gvr := schema.GroupVersionResource{Group: "hyperfleet.io", Version: "v1", Resource: "clustervalidations"}
+			clientset.Resources = []*metav1.APIResourceList{{
+				GroupVersion: "hyperfleet.io/v1",
+				APIResources: []metav1.APIResource{{Name: "clustervalidations", Kind: "ClusterValidation", Namespaced: true}},
+			}}
+			validation := &unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "hyperfleet.io/v1",
+				"kind":       "ClusterValidation",
+				"metadata":   map[string]any{"name": "my-cluster", "namespace": "target-ns"},
+				"status": map[string]any{
+					"conditions": []any{map[string]any{"type": "Other", "status": "True"}},
+				},
+			}}
+			validation.SetManagedFields([]metav1.ManagedFieldsEntry{{
+				Manager:     "other-controller",
+				Operation:   metav1.ManagedFieldsOperationApply,
+				Subresource: "status",
+				FieldsType:  "FieldsV1",
+				FieldsV1:    &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:conditions":{}}}`)},
+			}})
+			dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{gvr: "ClusterValidationList"}, validation)
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithServerSideApply(true),
+				k8s.WithStatusTarget(k8s.StatusTarget{Group: "hyperfleet.io", Kind: "ClusterValidation", Namespace: "target-ns", Name: "my-cluster"}),
+				k8s.WithDynamicClient(dynamicClient))
+
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			for _, action := range dynamicClient.Actions() {
+				Expect(action.GetVerb()).NotTo(Equal("patch"))
+			}
+			updated, err := dynamicClient.Resource(gvr).Namespace("target-ns").Get(ctx, "my-cluster", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			conditions, _, err := unstructured.NestedSlice(updated.Object, "status", "conditions")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conditions).To(HaveLen(2))
+			Expect(conditions[0]).To(HaveKeyWithValue("type", "Other"))
+		})
+
+		It("returns a Forbidden patch error instead of falling back to a status update", func() {
+			clientset.PrependReactor("patch", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				return true, nil, apierrors.NewForbidden(schema.GroupResource{Group: "batch", Resource: "jobs/status"}, "test-job", nil)
+			})
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithServerSideApply(true))
+
+			err := client.UpdateJobStatus(ctx, condition)
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(getJob().Status.Conditions).To(BeEmpty())
+		})
+	})
+
+	Describe("UpdateJobConditions", func() {
+		It("sets all conditions in a single status update", func() {
+			updates := 0
+			clientset.PrependReactor("update", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				updates++
+				return false, nil, nil
+			})
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			Expect(client.UpdateJobConditions(ctx, []k8s.JobCondition{
+				condition,
+				{Type: "DNSReady", Status: "True", Reason: "DNSConfigured", Message: "DNS resolves"},
+				{Type: "QuotaAvailable", Status: "False", Reason: "QuotaExceeded", Message: "CPU quota exceeded"},
+			})).To(Succeed())
+
+			Expect(updates).To(Equal(1))
+			conditions := getJob().Status.Conditions
+			Expect(conditions).To(HaveLen(3))
+			Expect(conditions[1].Type).To(Equal(batchv1.JobConditionType("DNSReady")))
+			Expect(conditions[2].Reason).To(Equal("QuotaExceeded"))
+		})
+
+		It("does not update the Job when no condition changed", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+			dns := k8s.JobCondition{Type: "DNSReady", Status: "True", Reason: "DNSConfigured", Message: "DNS resolves"}
+			Expect(client.UpdateJobConditions(ctx, []k8s.JobCondition{condition, dns})).To(Succeed())
+
+			updates := 0
+			clientset.PrependReactor("update", "jobs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				updates++
+				return false, nil, nil
+			})
+			Expect(client.UpdateJobConditions(ctx, []k8s.JobCondition{condition, dns})).To(Succeed())
+			Expect(updates).To(BeZero())
+		})
+
+		It("rejects the update when any condition status is invalid", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			Expect(client.UpdateJobConditions(ctx, []k8s.JobCondition{
+				condition,
+				{Type: "DNSReady", Status: "Yes"},
+			})).To(MatchError(ContainSubstring("invalid condition status")))
+			Expect(getJob().Status.Conditions).To(BeEmpty())
+		})
+	})
+
+	Describe("GetContainerMemoryLimit", func() {
+		BeforeEach(func() {
+			clientset = fake.NewClientset(&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: "test-ns"},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{
+					{Name: "adapter", Resources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+					}},
+					{Name: k8s.StatusReporterContainerName},
+				}},
+			})
+		})
+
+		It("returns the adapter container memory limit", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			limit, err := client.GetContainerMemoryLimit(ctx, "test-pod", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limit.String()).To(Equal("512Mi"))
+		})
+
+		It("returns nil when the container has no memory limit", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			limit, err := client.GetContainerMemoryLimit(ctx, "test-pod", k8s.StatusReporterContainerName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(limit).To(BeNil())
+		})
+	})
+
+	Describe("GetJobUID", func() {
+		It("returns the Job UID", func() {
+			clientset = fake.NewClientset(&batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-job", Namespace: "test-ns", UID: "8d0c1f6e-uid"},
+			})
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			uid, err := client.GetJobUID(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(uid).To(Equal("8d0c1f6e-uid"))
+		})
+	})
+
+	Describe("GetContainerLogTail", func() {
+		It("returns the container log", func() {
+			clientset = fake.NewClientset(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: "test-ns"}})
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			logs, err := client.GetContainerLogTail(ctx, "test-pod", "adapter", 100)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(logs).To(Equal("fake logs"))
+		})
+	})
+
+	Describe("FindPodByPrefix", func() {
+		pod := func(name string) *corev1.Pod {
+			return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-ns"}}
+		}
+
+		It("returns the single pod matching the prefix", func() {
+			clientset = fake.NewClientset(pod("validator-abc12"), pod("other-xyz"))
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			name, err := client.FindPodByPrefix(ctx, "validator-")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(name).To(Equal("validator-abc12"))
+		})
+
+		It("returns error when no pod matches", func() {
+			clientset = fake.NewClientset(pod("other-xyz"))
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			_, err := client.FindPodByPrefix(ctx, "validator-")
+			Expect(err).To(MatchError(ContainSubstring("no pod found")))
+		})
+
+		It("returns error when several pods match", func() {
+			clientset = fake.NewClientset(pod("validator-abc12"), pod("validator-def34"))
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			_, err := client.FindPodByPrefix(ctx, "validator-")
+			Expect(err).To(MatchError(ContainSubstring("multiple pods found")))
+		})
+	})
+
+	Describe("AnnotateJob", func() {
+		It("merges the annotations into the Job", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithRunID("run-1"))
+			Expect(client.UpdateJobStatus(ctx, condition)).To(Succeed())
+
+			Expect(client.AnnotateJob(ctx, map[string]string{k8s.AdapterImageAnnotation: "quay.io/hyperfleet/adapter@sha256:0123"})).To(Succeed())
+
+			annotations := getJob().Annotations
+			Expect(annotations).To(HaveKeyWithValue(k8s.AdapterImageAnnotation, "quay.io/hyperfleet/adapter@sha256:0123"))
+			Expect(annotations).To(HaveKeyWithValue(k8s.RunIDAnnotation, "run-1"))
+		})
+
+		It("returns an error when the Job does not exist", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "missing-job")
+
+			Expect(client.AnnotateJob(ctx, map[string]string{k8s.AdapterImageAnnotation: "adapter"})).NotTo(Succeed())
+		})
+	})
+
+	Describe("ClaimJobAnnotation", func() {
+		It("sets the annotation only on the first claim", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			claimed, err := client.ClaimJobAnnotation(ctx, k8s.EmailNotificationAnnotation, "first")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimed).To(BeTrue())
+
+			claimed, err = client.ClaimJobAnnotation(ctx, k8s.EmailNotificationAnnotation, "second")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimed).To(BeFalse())
+			Expect(getJob().Annotations).To(HaveKeyWithValue(k8s.EmailNotificationAnnotation, "first"))
+		})
+
+		It("can be claimed again after the annotation is removed", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+			Expect(client.AnnotateJob(ctx, map[string]string{k8s.EmailNotificationAnnotation: "first"})).To(Succeed())
+
+			Expect(client.RemoveJobAnnotation(ctx, k8s.EmailNotificationAnnotation)).To(Succeed())
+			Expect(getJob().Annotations).NotTo(HaveKey(k8s.EmailNotificationAnnotation))
+
+			claimed, err := client.ClaimJobAnnotation(ctx, k8s.EmailNotificationAnnotation, "second")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimed).To(BeTrue())
+		})
+
+		It("returns an error when the Job does not exist", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "missing-job")
+
+			_, err := client.ClaimJobAnnotation(ctx, k8s.EmailNotificationAnnotation, "first")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("UpdatePodCondition", func() {
+		getPod := func() *corev1.Pod {
+			pod, err := clientset.CoreV1().Pods("test-ns").Get(ctx, "test-pod", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			return pod
+		}
+
+		BeforeEach(func() {
+			_, err := clientset.CoreV1().Pods("test-ns").Create(ctx, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: "test-ns"},
+				Status: corev1.PodStatus{Conditions: []corev1.PodCondition{
+					{Type: corev1.PodReady, Status: corev1.ConditionFalse},
+				}},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("adds the condition to the pod status", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			Expect(client.UpdatePodCondition(ctx, "test-pod", condition)).To(Succeed())
+
+			conditions := getPod().Status.Conditions
+			Expect(conditions).To(HaveLen(2))
+			Expect(conditions[1].Type).To(Equal(corev1.PodConditionType("Available")))
+			Expect(conditions[1].Status).To(Equal(corev1.ConditionTrue))
+			Expect(conditions[1].Reason).To(Equal("AllChecksPassed"))
+			Expect(getJob().Status.Conditions).To(BeEmpty())
+		})
+
+		It("replaces a changed condition and keeps an unchanged one", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+			Expect(client.UpdatePodCondition(ctx, "test-pod", condition)).To(Succeed())
+			transitionTime := getPod().Status.Conditions[1].LastTransitionTime
+
+			condition.LastTransitionTime = time.Now().Add(time.Hour)
+			Expect(client.UpdatePodCondition(ctx, "test-pod", condition)).To(Succeed())
+			Expect(getPod().Status.Conditions[1].LastTransitionTime).To(Equal(transitionTime))
+
+			condition.Status = "False"
+			Expect(client.UpdatePodCondition(ctx, "test-pod", condition)).To(Succeed())
+			conditions := getPod().Status.Conditions
+			Expect(conditions).To(HaveLen(2))
+			Expect(conditions[1].Status).To(Equal(corev1.ConditionFalse))
+		})
+
+		It("returns an error when the pod does not exist", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			Expect(client.UpdatePodCondition(ctx, "missing-pod", condition)).To(MatchError(ContainSubstring("failed to get pod")))
+		})
+	})
+
+	Describe("RecordEvent", func() {
+		It("records the Event on the Job before returning", func() {
+			recorder := k8s.NewEventRecorder(clientset)
+			defer recorder.Shutdown()
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithEventRecorder(recorder))
+
+			client.RecordEvent(ctx, corev1.EventTypeWarning, "AdapterOOMKilled", "Adapter container was killed due to out of memory")
+
+			events, err := clientset.CoreV1().Events("test-ns").List(ctx, metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events.Items).To(HaveLen(1))
+			event := events.Items[0]
+			Expect(event.Type).To(Equal(corev1.EventTypeWarning))
+			Expect(event.Reason).To(Equal("AdapterOOMKilled"))
+			Expect(event.Source.Component).To(Equal(k8s.EventComponent))
+			Expect(event.InvolvedObject.Kind).To(Equal("Job"))
+			Expect(event.InvolvedObject.Name).To(Equal("test-job"))
+		})
+
+		It("stops waiting for Events once a write timed out", func() {
+			release := make(chan struct{})
+			clientset.PrependReactor("create", "events", func(k8stesting.Action) (bool, runtime.Object, error) {
+				<-release
+				return false, nil, nil
+			})
+			recorder := k8s.NewEventRecorder(clientset)
+			DeferCleanup(recorder.Shutdown)
+			DeferCleanup(func() { close(release) })
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job", k8s.WithEventRecorder(recorder))
+
+			start := time.Now()
+			client.RecordEvent(ctx, corev1.EventTypeNormal, "AdapterRunning", "started")
+			Expect(time.Since(start)).To(BeNumerically(">=", 5*time.Second))
+
+			start = time.Now()
+			client.RecordEvent(ctx, corev1.EventTypeWarning, "AdapterTimeout", "no result")
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		})
+
+		It("does nothing without an EventRecorder", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			client.RecordEvent(ctx, corev1.EventTypeNormal, "ResultReceived", "ok")
+
+			events, err := clientset.CoreV1().Events("test-ns").List(ctx, metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events.Items).To(BeEmpty())
+		})
+	})
+
+	Describe("ApplyJobConfigMap", func() {
+		BeforeEach(func() {
+			clientset = fake.NewClientset(&batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-job", Namespace: "test-ns", UID: "job-uid"},
+			})
+		})
+
+		getConfigMap := func() *corev1.ConfigMap {
+			cm, err := clientset.CoreV1().ConfigMaps("test-ns").Get(ctx, "test-job-result", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			return cm
+		}
+
+		It("creates the ConfigMap owned by the Job", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			Expect(client.ApplyJobConfigMap(ctx, "test-job-result", map[string]string{"result.json": "{}"})).To(Succeed())
+
+			cm := getConfigMap()
+			Expect(cm.Data).To(Equal(map[string]string{"result.json": "{}"}))
+			Expect(cm.OwnerReferences).To(ConsistOf(metav1.OwnerReference{
+				APIVersion: "batch/v1", Kind: "Job", Name: "test-job", UID: "job-uid",
+			}))
+		})
+
+		It("replaces the data of an existing ConfigMap", func() {
+			_, err := clientset.CoreV1().ConfigMaps("test-ns").Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-job-result", Namespace: "test-ns"},
+				Data:       map[string]string{"stale.json": "{}"},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			Expect(client.ApplyJobConfigMap(ctx, "test-job-result", map[string]string{"outcome.json": "{}"})).To(Succeed())
+			Expect(client.ApplyJobConfigMap(ctx, "test-job-result", map[string]string{"outcome.json": "{}"})).To(Succeed())
+
+			cm := getConfigMap()
+			Expect(cm.Data).To(Equal(map[string]string{"outcome.json": "{}"}))
+			Expect(cm.OwnerReferences).To(HaveLen(1))
+		})
+
+		It("returns an error when the Job does not exist", func() {
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "missing-job")
+
+			Expect(client.ApplyJobConfigMap(ctx, "missing-job-result", nil)).To(MatchError(ContainSubstring("failed to get job")))
+		})
+	})
+
+	Describe("Preflight", func() {
+		allowAccess := func(allowed bool) {
+			clientset.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+				review.Status.Allowed = allowed
+				return true, review, nil
+			})
+		}
+
+		failed := func(results []k8s.PreflightResult) []string {
+			var names []string
+			for _, r := range results {
+				if r.Err != nil {
+					names = append(names, r.Name)
+				}
+			}
+			return names
+		}
+
+		BeforeEach(func() {
+			_, err := clientset.CoreV1().Pods("test-ns").Create(ctx, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: "test-ns"},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("passes when access is granted and the Job and pod exist", func() {
+			allowAccess(true)
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			results := client.Preflight(ctx, "test-pod", 5*time.Minute, true)
+			Expect(failed(results)).To(BeEmpty())
+			Expect(results).To(HaveLen(5))
+		})
+
+		It("reports denied access", func() {
+			allowAccess(false)
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			Expect(failed(client.Preflight(ctx, "test-pod", 5*time.Minute, true))).To(ConsistOf("rbac: update jobs/status", "rbac: get pods"))
+		})
+
+		It("reports a missing Job and pod", func() {
+			allowAccess(true)
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "missing-job")
+
+			Expect(failed(client.Preflight(ctx, "missing-pod", 5*time.Minute, true))).To(ConsistOf("job exists", "pod exists"))
+		})
+
+		It("reports an active deadline shorter than the max wait time", func() {
+			allowAccess(true)
+			job := getJob()
+			deadline := int64(60)
+			job.Spec.ActiveDeadlineSeconds = &deadline
+			_, err := clientset.BatchV1().Jobs("test-ns").Update(ctx, job, metav1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			Expect(failed(client.Preflight(ctx, "test-pod", 5*time.Minute, true))).To(ConsistOf("active deadline"))
+		})
+
+		It("skips the active deadline check when it is disabled", func() {
+			allowAccess(true)
+			job := getJob()
+			deadline := int64(60)
+			job.Spec.ActiveDeadlineSeconds = &deadline
+			_, err := clientset.BatchV1().Jobs("test-ns").Update(ctx, job, metav1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			results := client.Preflight(ctx, "test-pod", 5*time.Minute, false)
+			Expect(failed(results)).To(BeEmpty())
+			Expect(results).To(HaveLen(4))
+		})
+	})
+
+	Describe("GetFailedInitContainerStatus", func() {
+		createPod := func(initStatuses ...corev1.ContainerStatus) {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: "test-ns"},
+				Status:     corev1.PodStatus{InitContainerStatuses: initStatuses},
+			}
+			_, err := clientset.CoreV1().Pods("test-ns").Create(ctx, pod, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		It("returns nil when all init containers succeeded", func() {
+			createPod(corev1.ContainerStatus{
+				Name:  "data-prep",
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"}},
+			})
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			cs, err := client.GetFailedInitContainerStatus(ctx, "test-pod")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cs).To(BeNil())
+		})
+
+		It("returns the init container that exited with an error", func() {
+			createPod(
+				corev1.ContainerStatus{
+					Name:  "setup",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"}},
+				},
+				corev1.ContainerStatus{
+					Name:  "data-prep",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 2, Reason: "Error"}},
+				},
+			)
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			cs, err := client.GetFailedInitContainerStatus(ctx, "test-pod")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cs).NotTo(BeNil())
+			Expect(cs.Name).To(Equal("data-prep"))
+		})
+
+		It("uses the last termination state of an init container waiting to restart", func() {
+			createPod(corev1.ContainerStatus{
+				Name:                 "data-prep",
+				State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+				LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}},
+			})
+			client := k8s.NewClientWithClientset(clientset, "test-ns", "test-job")
+
+			cs, err := client.GetFailedInitContainerStatus(ctx, "test-pod")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(k8s.InitContainerTermination(cs).ExitCode).To(Equal(int32(1)))
+		})
+	})
+})
+
+var _ = Describe("AggregatorClient", func() {
+	var (
+		ctx           context.Context
+		dynamicClient *dynamicfake.FakeDynamicClient
+		gvr           schema.GroupVersionResource
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		gvr = schema.GroupVersionResource{Group: "hyperfleet.io", Version: "v1", Resource: "adapterruns"}
+		run := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "hyperfleet.io/v1",
+			"kind":       "AdapterRun",
+			"metadata":   map[string]any{"name": "fleet-run", "namespace": "test-ns"},
+			"status": map[string]any{
+				"runs": map[string]any{"other-pod": map[string]any{"status": "False"}},
+			},
+		}}
+		dynamicClient = dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{gvr: "AdapterRunList"}, run)
+	})
+
+	It("merges the run into status.runs under its key", func() {
+		client := k8s.NewAggregatorClientWithDynamic(dynamicClient, gvr, "test-ns", "fleet-run")
+
+		Expect(client.PatchRun(ctx, "test-pod", map[string]string{"status": "True", "reason": "Done"})).To(Succeed())
+
+		obj, err := dynamicClient.Resource(gvr).Namespace("test-ns").Get(ctx, "fleet-run", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		runs, _, err := unstructured.NestedMap(obj.Object, "status", "runs")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(runs).To(HaveKeyWithValue("test-pod", map[string]any{"status": "True", "reason": "Done"}))
+		Expect(runs).To(HaveKey("other-pod"))
+	})
+
+	It("returns an error when the resource does not exist", func() {
+		client := k8s.NewAggregatorClientWithDynamic(dynamicClient, gvr, "test-ns", "missing")
+
+		Expect(client.PatchRun(ctx, "test-pod", map[string]string{"status": "True"})).NotTo(Succeed())
+	})
+})
+
+var _ = Describe("WorkflowClient", func() {
+	var (
+		ctx           context.Context
+		dynamicClient *dynamicfake.FakeDynamicClient
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		workflow := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "argoproj.io/v1alpha1",
+			"kind":       "Workflow",
+			"metadata":   map[string]any{"name": "provision", "namespace": "test-ns"},
+			"status": map[string]any{
+				"nodes": map[string]any{
+					"provision-123": map[string]any{"phase": "Running", "displayName": "validate"},
+					"provision-456": map[string]any{"phase": "Succeeded"},
+				},
+			},
+		}}
+		dynamicClient = dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{k8s.WorkflowGVR: "WorkflowList"}, workflow)
+	})
+
+	It("sets the message of the node only", func() {
+		client := k8s.NewWorkflowClientWithDynamic(dynamicClient, "test-ns", "provision")
+
+		Expect(client.PatchNodeMessage(ctx, "provision-123", "AllChecksPassed: ok")).To(Succeed())
+
+		obj, err := dynamicClient.Resource(k8s.WorkflowGVR).Namespace("test-ns").Get(ctx, "provision", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		node, _, err := unstructured.NestedMap(obj.Object, "status", "nodes", "provision-123")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(node).To(Equal(map[string]any{"phase": "Running", "displayName": "validate", "message": "AllChecksPassed: ok"}))
+		other, _, err := unstructured.NestedMap(obj.Object, "status", "nodes", "provision-456")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(other).To(Equal(map[string]any{"phase": "Succeeded"}))
+	})
+
+	It("returns an error when the workflow does not exist", func() {
+		client := k8s.NewWorkflowClientWithDynamic(dynamicClient, "test-ns", "missing")
+
+		Expect(client.PatchNodeMessage(ctx, "provision-123", "ok")).NotTo(Succeed())
+	})
+
+	It("refuses to create a node that is not in the workflow status", func() {
+		client := k8s.NewWorkflowClientWithDynamic(dynamicClient, "test-ns", "provision")
+
+		Expect(client.PatchNodeMessage(ctx, "provision-789", "ok")).To(MatchError(ContainSubstring("node provision-789 not found")))
+
+		obj, err := dynamicClient.Resource(k8s.WorkflowGVR).Namespace("test-ns").Get(ctx, "provision", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		_, found, err := unstructured.NestedMap(obj.Object, "status", "nodes", "provision-789")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+})
+
+var _ = Describe("LeaseClient", func() {
+	var (
+		ctx       context.Context
+		clientset *fake.Clientset
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		clientset = fake.NewClientset(&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-job", Namespace: "test-ns", UID: "job-uid"},
+		})
+	})
+
+	getLease := func() *coordinationv1.Lease {
+		lease, err := clientset.CoordinationV1().Leases("test-ns").Get(ctx, "test-job", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return lease
+	}
+
+	It("creates the Lease named after the Job, held by the pod, and renews it", func() {
+		client := k8s.NewLeaseClientWithClientset(clientset, "test-ns", "test-job", "test-pod", 2*time.Second)
+
+		Expect(client.Renew(ctx)).To(Succeed())
+		lease := getLease()
+		Expect(*lease.Spec.HolderIdentity).To(Equal("test-pod"))
+		Expect(*lease.Spec.LeaseDurationSeconds).To(Equal(int32(6)))
+		Expect(lease.OwnerReferences).To(HaveLen(1))
+		Expect(lease.OwnerReferences[0].Kind).To(Equal("Job"))
+		Expect(lease.OwnerReferences[0].UID).To(Equal(types.UID("job-uid")))
+		Expect(lease.OwnerReferences[0].Controller).To(BeNil())
+		Expect(lease.Labels).To(HaveKeyWithValue(batchv1.JobNameLabel, "test-job"))
+		firstRenewal := lease.Spec.RenewTime.Time
+
+		time.Sleep(10 * time.Millisecond)
+		Expect(client.Renew(ctx)).To(Succeed())
+		Expect(getLease().Spec.RenewTime.Time).To(BeTemporally(">", firstRenewal))
+	})
+
+	It("takes over a Lease left by an earlier reporter of the Job", func() {
+		previous := "old-holder"
+		_, err := clientset.CoordinationV1().Leases("test-ns").Create(ctx, &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-job", Namespace: "test-ns"},
+			Spec:       coordinationv1.LeaseSpec{HolderIdentity: &previous},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		client := k8s.NewLeaseClientWithClientset(clientset, "test-ns", "test-job", "test-pod", time.Second)
+		Expect(client.Renew(ctx)).To(Succeed())
+
+		lease := getLease()
+		Expect(*lease.Spec.HolderIdentity).To(Equal("test-pod"))
+		Expect(lease.Spec.AcquireTime).NotTo(BeNil())
+		Expect(lease.Spec.RenewTime).NotTo(BeNil())
+	})
+
+	It("deletes the Lease on release only while holding it", func() {
+		client := k8s.NewLeaseClientWithClientset(clientset, "test-ns", "test-job", "test-pod", time.Second)
+		Expect(client.Renew(ctx)).To(Succeed())
+
+		lease := getLease()
+		other := "other-holder"
+		lease.Spec.HolderIdentity = &other
+		_, err := clientset.CoordinationV1().Leases("test-ns").Update(ctx, lease, metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(client.Release(ctx)).To(Succeed())
+		getLease()
+
+		Expect(client.Renew(ctx)).To(Succeed())
+		Expect(client.Release(ctx)).To(Succeed())
+		_, err = clientset.CoordinationV1().Leases("test-ns").Get(ctx, "test-job", metav1.GetOptions{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		Expect(client.Release(ctx)).To(Succeed())
+	})
+})
@@ -0,0 +1,143 @@
+package k8s
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+)
+
+const (
+	// EventComponent is the source component of the Events the reporter records
+	EventComponent = "status-reporter"
+
+	// eventWriteTimeout bounds how long recording an Event waits for it to be written
+	eventWriteTimeout = 5 * time.Second
+)
+
+// EventRecorder records Kubernetes Events through an EventRecorder with the status-reporter
+// component. Unlike a plain recorder, Event waits until the Event is written, so Events are not
+// lost when the reporter exits right after recording them; once a write has timed out, later
+// Events are recorded without waiting. One recorder can be shared by clients.
+type EventRecorder struct {
+	clientset   kubernetes.Interface
+	broadcaster record.EventBroadcaster
+	recorder    record.EventRecorder
+
+	mu      sync.Mutex
+	emitted uint64
+	written uint64
+	// stalled is set once waiting for a write timed out
+	stalled bool
+	// writtenCh is closed and replaced whenever an Event is written
+	writtenCh chan struct{}
+}
+
+// NewEventRecorder creates an EventRecorder writing Events with clientset
+func NewEventRecorder(clientset kubernetes.Interface) *EventRecorder {
+	e := &EventRecorder{
+		clientset:   clientset,
+		broadcaster: record.NewBroadcaster(),
+		writtenCh:   make(chan struct{}),
+	}
+	e.recorder = e.broadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: EventComponent})
+	e.broadcaster.StartEventWatcher(e.write)
+	return e
+}
+
+// Event records an Event on the referenced object and waits for it to be written. Failures are
+// logged, since Events are informational.
+func (e *EventRecorder) Event(ref *corev1.ObjectReference, eventType, reason, message string) {
+	// Events are written in the order they are recorded, so the sequence number tells when this
+	// one has been written
+	e.mu.Lock()
+	e.emitted++
+	seq := e.emitted
+	e.recorder.Event(ref, eventType, reason, message)
+	stalled := e.stalled
+	e.mu.Unlock()
+	if stalled {
+		return
+	}
+
+	timer := time.NewTimer(eventWriteTimeout)
+	defer timer.Stop()
+	for {
+		e.mu.Lock()
+		if e.written >= seq {
+			e.mu.Unlock()
+			return
+		}
+		writtenCh := e.writtenCh
+		e.mu.Unlock()
+
+		select {
+		case <-writtenCh:
+		case <-timer.C:
+			log.Printf("Warning: timed out writing event %s on %s %s/%s; not waiting for later events",
+				reason, ref.Kind, ref.Namespace, ref.Name)
+			e.mu.Lock()
+			e.stalled = true
+			e.mu.Unlock()
+			return
+		}
+	}
+}
+
+// Shutdown stops the recorder; Events recorded afterwards are dropped
+func (e *EventRecorder) Shutdown() {
+	e.broadcaster.Shutdown()
+}
+
+// write creates the Event and marks it written
+func (e *EventRecorder) write(event *corev1.Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), eventWriteTimeout)
+	defer cancel()
+	if _, err := e.clientset.CoreV1().Events(event.Namespace).Create(ctx, event, metav1.CreateOptions{}); err != nil {
+		log.Printf("Warning: failed to write event %s on %s %s/%s: %v", event.Reason, event.InvolvedObject.Kind,
+			event.InvolvedObject.Namespace, event.InvolvedObject.Name, err)
+	}
+
+	e.mu.Lock()
+	e.written++
+	close(e.writtenCh)
+	e.writtenCh = make(chan struct{})
+	e.mu.Unlock()
+}
+
+// WithEventRecorder records Events on the Job through recorder
+func WithEventRecorder(recorder *EventRecorder) ClientOption {
+	return func(c *Client) {
+		c.events = recorder
+	}
+}
+
+// RecordEvent records an Event of eventType (corev1.EventTypeNormal or corev1.EventTypeWarning)
+// on the Job, so it shows in `kubectl describe job`. It does nothing unless an EventRecorder is
+// configured, and failures are logged.
+func (c *Client) RecordEvent(ctx context.Context, eventType, reason, message string) {
+	if c.events == nil {
+		return
+	}
+	if c.jobRef == nil {
+		job, err := c.clientset.BatchV1().Jobs(c.namespace).Get(ctx, c.jobName, metav1.GetOptions{})
+		if err != nil {
+			log.Printf("Warning: failed to get job %s/%s for event %s: %v", c.namespace, c.jobName, reason, err)
+			return
+		}
+		c.jobRef = &corev1.ObjectReference{
+			APIVersion: "batch/v1",
+			Kind:       "Job",
+			Namespace:  job.Namespace,
+			Name:       job.Name,
+			UID:        job.UID,
+		}
+	}
+	c.events.Event(c.jobRef, eventType, reason, message)
+}
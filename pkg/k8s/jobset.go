@@ -0,0 +1,277 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/util/retry"
+)
+
+// JobSetGVR is the JobSet resource that owns the child Jobs of a replicated job
+var JobSetGVR = schema.GroupVersionResource{Group: "jobset.x-k8s.io", Version: "v1alpha2", Resource: "jobsets"}
+
+const (
+	// ReplicatedJobNameLabel is set by the JobSet controller on each child Job
+	ReplicatedJobNameLabel = "jobset.sigs.k8s.io/replicatedjob-name"
+
+	// JobIndexLabel is the index of the child Job within its replicated job
+	JobIndexLabel = "jobset.sigs.k8s.io/job-index"
+
+	// JobSetResultAnnotationPrefix prefixes the JobSet annotation written for each child Job,
+	// followed by "<replicated job>-<index>"
+	JobSetResultAnnotationPrefix = "hyperfleet.io/adapter-result."
+)
+
+// Reasons of the JobSet condition aggregated from its child Jobs
+const (
+	ReasonJobSetChildrenSucceeded = "AllJobsSucceeded"
+	ReasonJobSetChildrenFailed    = "MultipleJobsFailed"
+	ReasonJobSetChildrenPending   = "JobsPending"
+)
+
+// JobSetRollupMode selects how a child Job's condition is rolled up to its JobSet
+type JobSetRollupMode string
+
+const (
+	// JobSetRollupStatus annotates the JobSet and sets its .status.conditions to the aggregate of
+	// all child Jobs
+	JobSetRollupStatus JobSetRollupMode = "status"
+
+	// JobSetRollupAnnotation writes the condition to a per-child annotation of the JobSet
+	JobSetRollupAnnotation JobSetRollupMode = "annotation"
+)
+
+// JobSetMember identifies the JobSet owning the Job and the Job's place in it
+type JobSetMember struct {
+	JobSet        string
+	ReplicatedJob string
+	Index         string
+}
+
+// Reason returns the condition reason prefixed with the replicated job and index, e.g.
+// "workers_3_DNSFailed", in the metav1.Condition reason format
+func (m JobSetMember) Reason(reason string) string {
+	return fmt.Sprintf("%s_%s_%s", strings.ReplaceAll(m.ReplicatedJob, "-", "_"), m.Index, reason)
+}
+
+// WithJobSetRollup also reports each applied Job condition to the JobSet that owns the Job, when
+// there is one. Roll-up is best-effort: failures are logged and do not fail the Job update.
+func WithJobSetRollup(mode JobSetRollupMode) ClientOption {
+	return func(c *Client) {
+		c.jobSetRollup = mode
+	}
+}
+
+// GetJobSetMember returns the JobSet that controls the Job, or nil when the Job is not part of one
+func (c *Client) GetJobSetMember(ctx context.Context) (*JobSetMember, error) {
+	job, err := c.clientset.BatchV1().Jobs(c.namespace).Get(ctx, c.jobName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: namespace=%s name=%s: %w", c.namespace, c.jobName, err)
+	}
+
+	owner := metav1.GetControllerOf(job)
+	if owner == nil || owner.Kind != "JobSet" {
+		return nil, nil
+	}
+	if gv, err := schema.ParseGroupVersion(owner.APIVersion); err != nil || gv.Group != JobSetGVR.Group {
+		return nil, nil
+	}
+	member := &JobSetMember{
+		JobSet:        owner.Name,
+		ReplicatedJob: job.Labels[ReplicatedJobNameLabel],
+		Index:         job.Labels[JobIndexLabel],
+	}
+	// Both end up in condition reasons and annotation keys
+	if errs := validation.IsDNS1123Label(member.ReplicatedJob); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid %s label %q of job %s/%s: %s", ReplicatedJobNameLabel, member.ReplicatedJob, c.namespace, c.jobName, strings.Join(errs, "; "))
+	}
+	if _, err := strconv.ParseUint(member.Index, 10, 32); err != nil {
+		return nil, fmt.Errorf("invalid %s label %q of job %s/%s", JobIndexLabel, member.Index, c.namespace, c.jobName)
+	}
+	return member, nil
+}
+
+// rollUpToJobSet reports the conditions to the owning JobSet, logging failures
+func (c *Client) rollUpToJobSet(ctx context.Context, conditions []JobCondition) {
+	if c.jobSetMember == nil {
+		member, err := c.GetJobSetMember(ctx)
+		if err != nil {
+			log.Printf("Warning: failed to look up JobSet of job %s/%s: %v", c.namespace, c.jobName, err)
+			return
+		}
+		if member == nil {
+			log.Printf("Job %s/%s is not owned by a JobSet; skipping JobSet roll-up", c.namespace, c.jobName)
+			c.jobSetRollup = ""
+			return
+		}
+		c.jobSetMember = member
+	}
+
+	var err error
+	if c.jobSetRollup == JobSetRollupAnnotation {
+		err = c.annotateJobSet(ctx, *c.jobSetMember, conditions)
+	} else {
+		err = c.updateJobSetConditions(ctx, *c.jobSetMember, conditions)
+	}
+	if err != nil {
+		log.Printf("Warning: failed to roll up conditions to JobSet %s/%s: %v", c.namespace, c.jobSetMember.JobSet, err)
+	}
+}
+
+// updateJobSetConditions records the member's conditions in its JobSet annotation, then sets each
+// condition type in the JobSet's .status.conditions to the aggregate of all child Jobs' annotations:
+// False when any child reported False, True only once every child of the JobSet's replicated jobs
+// reported True, and Unknown until then. Annotating bumps the JobSet's resourceVersion, so an
+// aggregate computed from stale annotations conflicts and is recomputed.
+func (c *Client) updateJobSetConditions(ctx context.Context, member JobSetMember, conditions []JobCondition) error {
+	if err := c.annotateJobSet(ctx, member, conditions); err != nil {
+		return err
+	}
+
+	resource := c.dynamic.Resource(JobSetGVR).Namespace(c.namespace)
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		obj, err := resource.Get(ctx, member.JobSet, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		statusConditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+		if err != nil {
+			return fmt.Errorf("invalid status.conditions: %w", err)
+		}
+
+		expected := jobSetChildCount(obj)
+		reported := childConditions(obj)
+		changed := false
+		for _, condition := range conditions {
+			var ok bool
+			statusConditions, ok = setTargetCondition(statusConditions, aggregateChildConditions(condition.Type, reported[condition.Type], expected), obj.GetGeneration())
+			changed = changed || ok
+		}
+		if !changed {
+			return nil
+		}
+
+		if err := unstructured.SetNestedSlice(obj.Object, statusConditions, "status", "conditions"); err != nil {
+			return fmt.Errorf("failed to set status.conditions: %w", err)
+		}
+		_, err = resource.UpdateStatus(ctx, obj, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// jobSetChildCount returns the number of child Jobs of the JobSet, the sum of the replicas of its
+// replicated jobs
+func jobSetChildCount(obj *unstructured.Unstructured) int {
+	replicatedJobs, _, _ := unstructured.NestedSlice(obj.Object, "spec", "replicatedJobs")
+	count := 0
+	for _, item := range replicatedJobs {
+		replicas := int64(1)
+		if replicatedJob, ok := item.(map[string]any); ok {
+			if value, found, err := unstructured.NestedInt64(replicatedJob, "replicas"); found && err == nil {
+				replicas = value
+			}
+		}
+		count += int(replicas)
+	}
+	return count
+}
+
+// childConditions returns the conditions recorded in the JobSet's child annotations by type, in
+// annotation key order
+func childConditions(obj *unstructured.Unstructured) map[string][]JobCondition {
+	annotations := obj.GetAnnotations()
+	keys := make([]string, 0, len(annotations))
+	for key := range annotations {
+		if strings.HasPrefix(key, JobSetResultAnnotationPrefix) {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	byType := map[string][]JobCondition{}
+	for _, key := range keys {
+		var value struct {
+			ConditionType string `json:"conditionType"`
+			Status        string `json:"status"`
+			Reason        string `json:"reason"`
+			Message       string `json:"message"`
+		}
+		if err := json.Unmarshal([]byte(annotations[key]), &value); err != nil || value.ConditionType == "" {
+			continue
+		}
+		byType[value.ConditionType] = append(byType[value.ConditionType], JobCondition{
+			Type:    value.ConditionType,
+			Status:  value.Status,
+			Reason:  value.Reason,
+			Message: value.Message,
+		})
+	}
+	return byType
+}
+
+// aggregateChildConditions combines the conditions the child Jobs reported for conditionType. A
+// single failed child keeps its prefixed reason and message.
+func aggregateChildConditions(conditionType string, reported []JobCondition, expected int) JobCondition {
+	var failed, succeeded []JobCondition
+	for _, condition := range reported {
+		switch condition.Status {
+		case "False":
+			failed = append(failed, condition)
+		case "True":
+			succeeded = append(succeeded, condition)
+		}
+	}
+	expected = max(expected, len(reported))
+
+	switch {
+	case len(failed) == 1:
+		return failed[0]
+	case len(failed) > 1:
+		return JobCondition{
+			Type:    conditionType,
+			Status:  "False",
+			Reason:  ReasonJobSetChildrenFailed,
+			Message: fmt.Sprintf("%d of %d child Jobs reported False; first: %s: %s", len(failed), expected, failed[0].Reason, failed[0].Message),
+		}
+	case len(succeeded) < expected:
+		return JobCondition{
+			Type:    conditionType,
+			Status:  "Unknown",
+			Reason:  ReasonJobSetChildrenPending,
+			Message: fmt.Sprintf("%d of %d child Jobs reported True", len(succeeded), expected),
+		}
+	default:
+		return JobCondition{
+			Type:    conditionType,
+			Status:  "True",
+			Reason:  ReasonJobSetChildrenSucceeded,
+			Message: fmt.Sprintf("All %d child Jobs reported True", expected),
+		}
+	}
+}
+
+// annotateJobSet writes each condition to the JobSet annotation of the member, as compact JSON
+func (c *Client) annotateJobSet(ctx context.Context, member JobSetMember, conditions []JobCondition) error {
+	memberConditions := make([]JobCondition, len(conditions))
+	for i, condition := range conditions {
+		condition.Reason = member.Reason(condition.Reason)
+		memberConditions[i] = condition
+	}
+	patch, err := conditionAnnotationPatch(JobSetResultAnnotationPrefix+member.ReplicatedJob+"-"+member.Index, memberConditions)
+	if err != nil {
+		return err
+	}
+	_, err = c.dynamic.Resource(JobSetGVR).Namespace(c.namespace).Patch(ctx, member.JobSet, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
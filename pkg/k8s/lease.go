@@ -0,0 +1,155 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// LeaseRenewalsPerDuration is how many renew intervals a heartbeat Lease stays valid for, so a
+// single late renewal does not make a live reporter look dead
+const LeaseRenewalsPerDuration = 3
+
+// LeaseClient maintains a liveness Lease named after the Job while the reporter runs, held by the
+// reporter's pod. A Lease whose renewTime is older than its leaseDurationSeconds belongs to a
+// reporter that died or was killed.
+type LeaseClient struct {
+	clientset    kubernetes.Interface
+	namespace    string
+	jobName      string
+	holder       string
+	durationSecs int32
+	created      bool
+}
+
+// NewLeaseClient creates a Lease client using in-cluster config. The Lease is named after the Job,
+// held by holder (the reporter's pod, or the Job when holder is empty) and valid for
+// LeaseRenewalsPerDuration renew intervals.
+func NewLeaseClient(namespace, jobName, holder string, renewInterval time.Duration) (*LeaseClient, error) {
+	clientset, err := NewInClusterClientset()
+	if err != nil {
+		return nil, err
+	}
+	return NewLeaseClientWithClientset(clientset, namespace, jobName, holder, renewInterval), nil
+}
+
+// NewLeaseClientWithClientset creates a Lease client from an existing clientset (for testing)
+func NewLeaseClientWithClientset(clientset kubernetes.Interface, namespace, jobName, holder string, renewInterval time.Duration) *LeaseClient {
+	duration := math.Ceil((LeaseRenewalsPerDuration * renewInterval).Seconds())
+	if holder == "" {
+		holder = jobName
+	}
+	return &LeaseClient{
+		clientset:    clientset,
+		namespace:    namespace,
+		jobName:      jobName,
+		holder:       holder,
+		durationSecs: int32(max(duration, 1)),
+	}
+}
+
+// Renew sets the Lease's renewTime to now, creating the Lease owned by the Job on first use so it
+// is garbage collected with the Job if the reporter never releases it. A Lease left behind by an
+// earlier reporter of the Job is taken over.
+func (l *LeaseClient) Renew(ctx context.Context) error {
+	now := metav1.NewMicroTime(time.Now())
+	leases := l.clientset.CoordinationV1().Leases(l.namespace)
+
+	if !l.created {
+		lease, err := l.newLease(ctx, now)
+		if err != nil {
+			return err
+		}
+		_, err = leases.Create(ctx, lease, metav1.CreateOptions{})
+		if err == nil {
+			l.created = true
+			return nil
+		}
+		if !errors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create lease: namespace=%s name=%s: %w", l.namespace, l.jobName, err)
+		}
+		// Left behind by an earlier reporter of the Job, e.g. one that was killed; take it over
+		l.created = true
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		lease, err := leases.Get(ctx, l.jobName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity != l.holder {
+			lease.Spec.HolderIdentity = &l.holder
+			lease.Spec.AcquireTime = &now
+		}
+		lease.Spec.LeaseDurationSeconds = &l.durationSecs
+		lease.Spec.RenewTime = &now
+		_, err = leases.Update(ctx, lease, metav1.UpdateOptions{})
+		return err
+	})
+	if errors.IsNotFound(err) {
+		// Deleted while the reporter runs; recreate it on the next renewal
+		l.created = false
+	}
+	if err != nil {
+		return fmt.Errorf("failed to renew lease: namespace=%s name=%s: %w", l.namespace, l.jobName, err)
+	}
+	return nil
+}
+
+// newLease builds the Lease held by the reporter, owned by the Job. The owner reference is not a
+// controller reference, since the Job controller does not manage Leases.
+func (l *LeaseClient) newLease(ctx context.Context, now metav1.MicroTime) (*coordinationv1.Lease, error) {
+	job, err := l.clientset.BatchV1().Jobs(l.namespace).Get(ctx, l.jobName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: namespace=%s name=%s: %w", l.namespace, l.jobName, err)
+	}
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      l.jobName,
+			Namespace: l.namespace,
+			Labels:    map[string]string{batchv1.JobNameLabel: l.jobName},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: batchv1.SchemeGroupVersion.String(),
+				Kind:       "Job",
+				Name:       job.Name,
+				UID:        job.UID,
+			}},
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       &l.holder,
+			LeaseDurationSeconds: &l.durationSecs,
+			AcquireTime:          &now,
+			RenewTime:            &now,
+		},
+	}, nil
+}
+
+// Release deletes the Lease if the reporter still holds it
+func (l *LeaseClient) Release(ctx context.Context) error {
+	leases := l.clientset.CoordinationV1().Leases(l.namespace)
+	lease, err := leases.Get(ctx, l.jobName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get lease: namespace=%s name=%s: %w", l.namespace, l.jobName, err)
+	}
+	if lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity != l.holder {
+		return nil
+	}
+
+	err = leases.Delete(ctx, l.jobName, metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: &lease.UID}})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete lease: namespace=%s name=%s: %w", l.namespace, l.jobName, err)
+	}
+	l.created = false
+	return nil
+}
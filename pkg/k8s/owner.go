@@ -0,0 +1,99 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// OwnerReportMode selects whether the Job's controller owner receives the condition instead of,
+// or in addition to, the Job
+type OwnerReportMode string
+
+const (
+	// OwnerReportReplace writes the condition to the owner instead of the Job
+	OwnerReportReplace OwnerReportMode = "replace"
+
+	// OwnerReportAdditional writes the condition to the Job and then, best-effort, to the owner
+	OwnerReportAdditional OwnerReportMode = "additional"
+)
+
+// WithOwnerReporting reports to the resource named by the Job's controller ownerReference, e.g.
+// the CronJob or custom resource that created it, which is resolved on the first update. A Job
+// without a controller owner is reported on as usual.
+func WithOwnerReporting(mode OwnerReportMode) ClientOption {
+	return func(c *Client) {
+		c.ownerReportMode = mode
+	}
+}
+
+// GetJobOwner returns the Job's controller owner as a status target, or nil when the Job has none
+func (c *Client) GetJobOwner(ctx context.Context) (*StatusTarget, error) {
+	job, err := c.clientset.BatchV1().Jobs(c.namespace).Get(ctx, c.jobName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: namespace=%s name=%s: %w", c.namespace, c.jobName, err)
+	}
+
+	owner := metav1.GetControllerOf(job)
+	if owner == nil {
+		return nil, nil
+	}
+	gv, err := schema.ParseGroupVersion(owner.APIVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid owner apiVersion %q of job %s/%s: %w", owner.APIVersion, c.namespace, c.jobName, err)
+	}
+	return &StatusTarget{
+		Group:     gv.Group,
+		Version:   gv.Version,
+		Kind:      owner.Kind,
+		Namespace: c.namespace,
+		Name:      owner.Name,
+	}, nil
+}
+
+// resolveOwner looks up the Job's owner on first use. In replace mode the owner becomes the status
+// target; in additional mode a client targeting the owner is kept for updateOwnerConditions.
+func (c *Client) resolveOwner(ctx context.Context) error {
+	if c.ownerResolved {
+		return nil
+	}
+
+	owner, err := c.GetJobOwner(ctx)
+	if err != nil {
+		return err
+	}
+	c.ownerResolved = true
+	if owner == nil {
+		log.Printf("Job %s/%s has no controller owner; reporting on the Job", c.namespace, c.jobName)
+		return nil
+	}
+
+	log.Printf("Reporting on owner %s of job %s/%s", owner, c.namespace, c.jobName)
+	if c.ownerReportMode == OwnerReportAdditional {
+		c.owner = &Client{
+			clientset:       c.clientset,
+			dynamic:         c.dynamic,
+			namespace:       c.namespace,
+			jobName:         c.jobName,
+			statusTarget:    owner,
+			serverSideApply: c.serverSideApply,
+		}
+		return nil
+	}
+	c.statusTarget = owner
+	return nil
+}
+
+// updateOwnerConditions writes the conditions to the owner in additional mode, logging failures
+func (c *Client) updateOwnerConditions(ctx context.Context, conditions []JobCondition) {
+	if c.owner == nil {
+		return
+	}
+	if _, err := c.owner.updateTargetConditions(ctx, conditions); err != nil {
+		log.Printf("Warning: failed to report conditions to owner %s of job %s/%s: %v",
+			c.owner.statusTarget, c.namespace, c.jobName, err)
+	}
+}
@@ -0,0 +1,75 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PreflightResult is the outcome of a single preflight check; Err is nil when the check passed
+type PreflightResult struct {
+	Name string
+	Err  error
+}
+
+// Preflight checks that the reporter can do its job against the cluster: the Job and pod exist,
+// the service account may read pods and update the Job status, and, when checkDeadline is set, the
+// Job's activeDeadlineSeconds leaves room for the reporter's own maxWait timeout to fire first
+func (c *Client) Preflight(ctx context.Context, podName string, maxWait time.Duration, checkDeadline bool) []PreflightResult {
+	results := []PreflightResult{
+		{Name: "rbac: update jobs/status", Err: c.checkAccess(ctx, "update", "batch", "jobs", "status")},
+		{Name: "rbac: get pods", Err: c.checkAccess(ctx, "get", "", "pods", "")},
+	}
+
+	deadline, err := c.GetJobActiveDeadlineSeconds(ctx)
+	results = append(results, PreflightResult{Name: "job exists", Err: err})
+	if err == nil && checkDeadline {
+		results = append(results, PreflightResult{Name: "active deadline", Err: checkActiveDeadline(deadline, maxWait)})
+	}
+
+	if podName != "" {
+		_, err := c.GetPodStatus(ctx, podName)
+		results = append(results, PreflightResult{Name: "pod exists", Err: err})
+	}
+
+	return results
+}
+
+// checkAccess asks the API server whether the reporter's service account may perform the verb
+func (c *Client) checkAccess(ctx context.Context, verb, group, resource, subresource string) error {
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace:   c.namespace,
+				Verb:        verb,
+				Group:       group,
+				Resource:    resource,
+				Subresource: subresource,
+			},
+		},
+	}
+
+	resp, err := c.clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to review access: %w", err)
+	}
+	if !resp.Status.Allowed {
+		return fmt.Errorf("service account is not allowed to %s %s in namespace %s", verb, resource, c.namespace)
+	}
+	return nil
+}
+
+// checkActiveDeadline flags a Job deadline that would kill the pod before the reporter times out
+func checkActiveDeadline(deadline *int64, maxWait time.Duration) error {
+	if deadline == nil {
+		return nil
+	}
+	if limit := time.Duration(*deadline) * time.Second; limit <= maxWait {
+		return fmt.Errorf("activeDeadlineSeconds (%s) does not exceed the reporter's max wait time (%s); "+
+			"the pod may be killed before a timeout condition is reported", limit, maxWait)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package k8s
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// RetryableErrorMatcher classifies additional update errors as retryable. A pattern made of three
+// digits matches API errors with that HTTP status code; any other pattern matches errors whose
+// message contains it.
+type RetryableErrorMatcher struct {
+	codes      []int32
+	substrings []string
+}
+
+// NewRetryableErrorMatcher builds a matcher from patterns; empty patterns are ignored
+func NewRetryableErrorMatcher(patterns []string) *RetryableErrorMatcher {
+	m := &RetryableErrorMatcher{}
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+		if code, ok := statusCodePattern(pattern); ok {
+			m.codes = append(m.codes, code)
+			continue
+		}
+		m.substrings = append(m.substrings, pattern)
+	}
+	return m
+}
+
+// Matches reports whether err matches one of the patterns
+func (m *RetryableErrorMatcher) Matches(err error) bool {
+	if m == nil || err == nil {
+		return false
+	}
+
+	var status apierrors.APIStatus
+	if len(m.codes) > 0 && errors.As(err, &status) {
+		for _, code := range m.codes {
+			if status.Status().Code == code {
+				return true
+			}
+		}
+	}
+
+	message := err.Error()
+	for _, substring := range m.substrings {
+		if strings.Contains(message, substring) {
+			return true
+		}
+	}
+	return false
+}
+
+func statusCodePattern(pattern string) (int32, bool) {
+	if len(pattern) != 3 {
+		return 0, false
+	}
+	code, err := strconv.Atoi(pattern)
+	if err != nil || code < 100 {
+		return 0, false
+	}
+	return int32(code), true
+}
+
+// WithRetryableErrors retries Job status updates that fail with errors matched by matcher,
+// in addition to conflicts
+func WithRetryableErrors(matcher *RetryableErrorMatcher) ClientOption {
+	return func(c *Client) {
+		c.retryable = matcher
+	}
+}
+
+// isRetryable reports whether a failed update attempt should be retried
+func (c *Client) isRetryable(err error) bool {
+	return apierrors.IsConflict(err) || c.retryable.Matches(err)
+}
@@ -0,0 +1,76 @@
+package k8s
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Reporting states written to the reporting state file
+const (
+	ReportingStateOK       = "ok"
+	ReportingStateRetrying = "retrying"
+	ReportingStateFailed   = "failed"
+)
+
+// ReportingState is the content of the reporting state file. While status updates are being
+// retried the state is ReportingStateRetrying, so observers can tell the reporter is degraded.
+type ReportingState struct {
+	State     string    `json:"state"`
+	JobName   string    `json:"jobName"`
+	Attempts  int       `json:"attempts,omitempty"`
+	LastError string    `json:"lastError,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// WithReportingStateFile keeps a JSON ReportingState at path that reflects whether Job status
+// updates are succeeding, being retried or have failed
+func WithReportingStateFile(path string) ClientOption {
+	return func(c *Client) {
+		c.statePath = path
+	}
+}
+
+// writeState records the reporting state; failures are logged since the state file is advisory
+func (c *Client) writeState(state string, attempts int, lastErr error) {
+	if c.statePath == "" {
+		return
+	}
+
+	s := ReportingState{State: state, JobName: c.jobName, Attempts: attempts, UpdatedAt: time.Now().UTC()}
+	if lastErr != nil {
+		s.LastError = lastErr.Error()
+	}
+	if err := writeFileAtomic(c.statePath, s); err != nil {
+		log.Printf("Warning: failed to write reporting state: %v", err)
+	}
+}
+
+// writeFileAtomic writes v as JSON to path through a temporary file, so readers never see a partial file
+func writeFileAtomic(path string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", filepath.Base(path), err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".state-*")
+	if err != nil {
+		return fmt.Errorf("failed to create file in %s: %w", filepath.Dir(path), err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write file path=%s: %w", path, err)
+	}
+	return nil
+}
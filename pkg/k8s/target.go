@@ -0,0 +1,215 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+)
+
+// StatusTarget identifies a resource of any kind whose .status.conditions receive the reported
+// condition instead of the Job's. Version may be empty to use the API server's preferred version.
+type StatusTarget struct {
+	Group     string
+	Version   string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func (t StatusTarget) String() string {
+	return fmt.Sprintf("%s %s/%s", t.Kind, t.Namespace, t.Name)
+}
+
+// WithStatusTarget writes conditions to the status of target instead of the Job. The kind is
+// resolved to its resource through API discovery on the first update. A CronJob, which has no
+// status conditions, receives them in its ResultAnnotation instead.
+func WithStatusTarget(target StatusTarget) ClientOption {
+	return func(c *Client) {
+		c.statusTarget = &target
+	}
+}
+
+// WithDynamicClient sets the dynamic client used to update the status target (for testing);
+// NewClient creates one from the in-cluster config
+func WithDynamicClient(client dynamic.Interface) ClientOption {
+	return func(c *Client) {
+		c.dynamic = client
+	}
+}
+
+// targetResource returns the client for the status target, resolving its kind on first use
+func (c *Client) targetResource() (dynamic.ResourceInterface, error) {
+	if c.targetMapping == nil {
+		mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(c.clientset.Discovery()))
+		var versions []string
+		if c.statusTarget.Version != "" {
+			versions = append(versions, c.statusTarget.Version)
+		}
+		mapping, err := mapper.RESTMapping(schema.GroupKind{Group: c.statusTarget.Group, Kind: c.statusTarget.Kind}, versions...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve status target kind=%s group=%s: %w", c.statusTarget.Kind, c.statusTarget.Group, err)
+		}
+		c.targetMapping = mapping
+	}
+
+	resource := c.dynamic.Resource(c.targetMapping.Resource)
+	if c.targetMapping.Scope.Name() == meta.RESTScopeNameRoot {
+		return resource, nil
+	}
+	return resource.Namespace(c.statusTarget.Namespace), nil
+}
+
+// updateTargetConditions sets the conditions in the status target's .status.conditions, in the
+// metav1.Condition layout, and reports whether they were applied or a no-op
+func (c *Client) updateTargetConditions(ctx context.Context, conditions []JobCondition) (string, error) {
+	resource, err := c.targetResource()
+	if err != nil {
+		return "", err
+	}
+
+	if c.statusTarget.Group == "batch" && c.statusTarget.Kind == "CronJob" {
+		// CronJobs have no status conditions; the API server would drop them
+		return c.annotateTarget(ctx, resource, conditions)
+	}
+
+	obj, err := resource.Get(ctx, c.statusTarget.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", fmt.Errorf("%s not found: %w", c.statusTarget, err)
+		}
+		return "", err
+	}
+
+	statusConditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil {
+		return "", fmt.Errorf("invalid status.conditions of %s: %w", c.statusTarget, err)
+	}
+
+	changed := false
+	for _, condition := range conditions {
+		var ok bool
+		statusConditions, ok = setTargetCondition(statusConditions, condition, obj.GetGeneration())
+		changed = changed || ok
+	}
+	if !changed {
+		return AuditResultNoOp, nil
+	}
+
+	apply := c.serverSideApply && !c.applyUnsupported
+	if apply && !conditionsKeyedByType(obj) {
+		log.Printf("Warning: status.conditions of %s is not a list keyed by type; updating the status instead of applying it", c.statusTarget)
+		apply = false
+	}
+	if apply {
+		err := c.applyTargetConditions(ctx, resource, obj, statusConditions, conditionTypes(conditions))
+		if !c.fallBackFromApply(err, c.statusTarget.String()) {
+			if err != nil {
+				return "", err
+			}
+			return AuditResultApplied, nil
+		}
+	}
+
+	if err := unstructured.SetNestedSlice(obj.Object, statusConditions, "status", "conditions"); err != nil {
+		return "", fmt.Errorf("failed to set status.conditions of %s: %w", c.statusTarget, err)
+	}
+	if _, err := resource.UpdateStatus(ctx, obj, metav1.UpdateOptions{}); err != nil {
+		return "", err
+	}
+	return AuditResultApplied, nil
+}
+
+// annotateTarget writes the conditions to the ResultAnnotation of the status target
+func (c *Client) annotateTarget(ctx context.Context, resource dynamic.ResourceInterface, conditions []JobCondition) (string, error) {
+	patch, err := conditionAnnotationPatch(ResultAnnotation, conditions)
+	if err != nil {
+		return "", err
+	}
+	if _, err := resource.Patch(ctx, c.statusTarget.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		if errors.IsNotFound(err) {
+			return "", fmt.Errorf("%s not found: %w", c.statusTarget, err)
+		}
+		return "", err
+	}
+	return AuditResultApplied, nil
+}
+
+// setTargetCondition adds or replaces the condition in an unstructured conditions list and
+// reports whether the list changed; an unchanged status, reason and message is left as is
+func setTargetCondition(conditions []any, condition JobCondition, generation int64) ([]any, bool) {
+	transitionTime := condition.LastTransitionTime
+	if transitionTime.IsZero() {
+		transitionTime = time.Now()
+	}
+	newCondition := map[string]any{
+		"type":               condition.Type,
+		"status":             condition.Status,
+		"reason":             condition.Reason,
+		"message":            condition.Message,
+		"lastTransitionTime": transitionTime.UTC().Format(time.RFC3339),
+		"observedGeneration": generation,
+	}
+
+	existingIndex := -1
+	for i, item := range conditions {
+		if existing, ok := item.(map[string]any); ok && existing["type"] == condition.Type {
+			existingIndex = i
+			break
+		}
+	}
+
+	if existingIndex >= 0 {
+		existing := conditions[existingIndex].(map[string]any)
+		// No-op if semantically identical; preserves lastTransitionTime
+		if existing["status"] == condition.Status && existing["reason"] == condition.Reason && existing["message"] == condition.Message {
+			return conditions, false
+		}
+		conditions[existingIndex] = newCondition
+	} else {
+		conditions = append(conditions, newCondition)
+	}
+	return conditions, true
+}
+
+// conditionAnnotationPatch builds a merge patch writing the conditions to the annotation key as
+// compact JSON, for resources without status conditions; with several conditions, each goes to
+// key.<type>
+func conditionAnnotationPatch(key string, conditions []JobCondition) ([]byte, error) {
+	annotations := make(map[string]string, len(conditions))
+	for _, condition := range conditions {
+		value, err := json.Marshal(map[string]string{
+			"conditionType": condition.Type,
+			"status":        condition.Status,
+			"reason":        condition.Reason,
+			"message":       condition.Message,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode condition annotation: %w", err)
+		}
+		if len(conditions) > 1 {
+			annotations[key+"."+condition.Type] = string(value)
+		} else {
+			annotations[key] = string(value)
+		}
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{"annotations": annotations},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build annotation patch: %w", err)
+	}
+	return patch, nil
+}
@@ -0,0 +1,85 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+)
+
+// WorkflowGVR is the Argo Workflows Workflow resource
+var WorkflowGVR = schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "workflows"}
+
+// WorkflowClient patches the status of the Argo Workflow node that runs the reporter's pod
+type WorkflowClient struct {
+	client    dynamic.Interface
+	namespace string
+	name      string
+}
+
+// NewWorkflowClient creates a Workflow client using in-cluster config
+func NewWorkflowClient(namespace, name string) (*WorkflowClient, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get in-cluster config: %w", err)
+	}
+
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
+	return NewWorkflowClientWithDynamic(client, namespace, name), nil
+}
+
+// NewWorkflowClientWithDynamic creates a Workflow client from an existing dynamic client (for testing)
+func NewWorkflowClientWithDynamic(client dynamic.Interface, namespace, name string) *WorkflowClient {
+	return &WorkflowClient{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+	}
+}
+
+// PatchNodeMessage sets status.nodes[nodeID].message of the Workflow. Workflows have no status
+// subresource, so the merge patch goes to the object itself and only touches that field. The node
+// must exist: a merge patch would otherwise create a node the workflow controller does not know.
+// The patch is made against the resourceVersion the node was found in, so a concurrent update by
+// the controller conflicts and is retried instead of being raced.
+func (w *WorkflowClient) PatchNodeMessage(ctx context.Context, nodeID, message string) error {
+	resource := w.client.Resource(WorkflowGVR).Namespace(w.namespace)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		workflow, err := resource.Get(ctx, w.name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if _, found, _ := unstructured.NestedMap(workflow.Object, "status", "nodes", nodeID); !found {
+			return fmt.Errorf("node %s not found in workflow status", nodeID)
+		}
+
+		patch, err := json.Marshal(map[string]any{
+			"metadata": map[string]any{"resourceVersion": workflow.GetResourceVersion()},
+			"status": map[string]any{
+				"nodes": map[string]any{
+					nodeID: map[string]any{"message": message},
+				},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to build workflow patch: %w", err)
+		}
+		_, err = resource.Patch(ctx, w.name, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to patch workflow node: namespace=%s name=%s node=%s: %w", w.namespace, w.name, nodeID, err)
+	}
+	return nil
+}
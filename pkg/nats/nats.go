@@ -0,0 +1,224 @@
+// Package nats publishes messages to a NATS server with the nats.go client, connecting for each
+// publish since the reporter makes a single one per run.
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	natsgo "github.com/nats-io/nats.go"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/retry"
+)
+
+const (
+	// DefaultTimeout bounds a single publish attempt, from connecting to the server's PONG
+	DefaultTimeout = 10 * time.Second
+
+	// clientName identifies the reporter's connections on the server
+	clientName = "status-reporter"
+)
+
+// Config configures the NATS client. At most one of TokenFile, CredsFile, NKeySeedFile and
+// User may be set.
+type Config struct {
+	// URL is the server, as nats://host:port or tls://host:port
+	URL string
+
+	// Subject is the subject messages are published to
+	Subject string
+
+	// TokenFile holds the server's auth token; it is read before every attempt so rotated tokens
+	// are picked up
+	TokenFile string
+
+	// CredsFile is a NATS credentials file with the user JWT and nkey seed, as generated by nsc
+	CredsFile string
+
+	// NKeySeedFile holds the seed of the user nkey the client authenticates with
+	NKeySeedFile string
+
+	// User and PasswordFile authenticate with a user name and the password in the file, which is
+	// read before every attempt
+	User         string
+	PasswordFile string
+
+	// CAFile is a PEM bundle used to verify the server certificate instead of the system roots
+	CAFile string
+
+	// Timeout bounds a single attempt (DefaultTimeout when zero)
+	Timeout time.Duration
+
+	// Retry bounds the retries of failed attempts; authorization and permission errors are not retried
+	Retry retry.Policy
+}
+
+// Client publishes JSON payloads to a NATS subject
+type Client struct {
+	url          string
+	subject      string
+	tokenFile    string
+	credsFile    string
+	nkeySeedFile string
+	user         string
+	passwordFile string
+	caFile       string
+	timeout      time.Duration
+	retry        retry.Policy
+}
+
+// NewClient creates a NATS client, validating the URL, subject and credentials up front
+func NewClient(cfg Config) (*Client, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid NATS URL %q: expected nats://host:port or tls://host:port", cfg.URL)
+	}
+	if u.Scheme != "nats" && u.Scheme != "tls" {
+		return nil, fmt.Errorf("invalid NATS URL %q: scheme must be nats or tls", cfg.URL)
+	}
+	if cfg.Subject == "" || strings.ContainsAny(cfg.Subject, " \t\r\n") {
+		return nil, fmt.Errorf("invalid NATS subject %q", cfg.Subject)
+	}
+
+	methods := 0
+	for _, set := range []bool{cfg.TokenFile != "", cfg.CredsFile != "", cfg.NKeySeedFile != "", cfg.User != ""} {
+		if set {
+			methods++
+		}
+	}
+	if methods > 1 {
+		return nil, errors.New("only one NATS authentication method may be set: token file, credentials file, nkey seed file or user")
+	}
+	if (cfg.User == "") != (cfg.PasswordFile == "") {
+		return nil, errors.New("NATS user and password file must be set together")
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Client{
+		url:          u.String(),
+		subject:      cfg.Subject,
+		tokenFile:    cfg.TokenFile,
+		credsFile:    cfg.CredsFile,
+		nkeySeedFile: cfg.NKeySeedFile,
+		user:         cfg.User,
+		passwordFile: cfg.PasswordFile,
+		caFile:       cfg.CAFile,
+		timeout:      timeout,
+		retry:        cfg.Retry,
+	}, nil
+}
+
+// Post marshals payload as JSON and publishes it to the subject, retrying failed attempts. A
+// publish is confirmed by a PING/PONG round trip, so the server has processed the message when
+// Post returns nil.
+func (c *Client) Post(ctx context.Context, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal NATS payload: %w", err)
+	}
+
+	return retry.Do(ctx, "NATS publish", c.retry, func(ctx context.Context) error {
+		return permanentIfRefused(c.publish(ctx, body))
+	})
+}
+
+// publish makes a single connection and publishes body on it
+func (c *Client) publish(ctx context.Context, body []byte) error {
+	opts, err := c.options()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	conn, err := natsgo.Connect(c.url, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS server url=%s: %w", c.url, err)
+	}
+	defer conn.Close()
+
+	if err := conn.Publish(c.subject, body); err != nil {
+		return fmt.Errorf("failed to publish NATS message: %w", err)
+	}
+	if err := conn.FlushWithContext(ctx); err != nil {
+		return fmt.Errorf("failed to confirm NATS publish: %w", err)
+	}
+	// A publish the server refuses, e.g. for a subject the user may not publish to, is answered with
+	// an -ERR ahead of the PONG and recorded as the connection's last error
+	if err := conn.LastError(); err != nil {
+		return fmt.Errorf("NATS server refused the publish: %w", err)
+	}
+	return nil
+}
+
+// options returns the connection options, reading the token and password files
+func (c *Client) options() ([]natsgo.Option, error) {
+	opts := []natsgo.Option{
+		natsgo.Name(clientName),
+		natsgo.Timeout(c.timeout),
+		natsgo.NoReconnect(),
+	}
+	if c.caFile != "" {
+		opts = append(opts, natsgo.RootCAs(c.caFile))
+	}
+
+	switch {
+	case c.tokenFile != "":
+		token, err := readSecret(c.tokenFile, "token")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, natsgo.Token(token))
+	case c.credsFile != "":
+		opts = append(opts, natsgo.UserCredentials(c.credsFile))
+	case c.nkeySeedFile != "":
+		opt, err := natsgo.NkeyOptionFromSeed(c.nkeySeedFile)
+		if err != nil {
+			return nil, retry.Permanent(fmt.Errorf("failed to load NATS nkey seed file path=%s: %w", c.nkeySeedFile, err))
+		}
+		opts = append(opts, opt)
+	case c.user != "":
+		password, err := readSecret(c.passwordFile, "password")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, natsgo.UserInfo(c.user, password))
+	}
+	return opts, nil
+}
+
+// readSecret reads a credential from a mounted file
+func readSecret(path, what string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read NATS %s file path=%s: %w", what, path, err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// permanentIfRefused marks authentication and permission errors as permanent: another attempt
+// with the same credentials is refused again
+func permanentIfRefused(err error) error {
+	for _, refused := range []error{
+		natsgo.ErrAuthorization,
+		natsgo.ErrAuthExpired,
+		natsgo.ErrAuthRevoked,
+		natsgo.ErrAccountAuthExpired,
+		natsgo.ErrPermissionViolation,
+	} {
+		if errors.Is(err, refused) {
+			return retry.Permanent(err)
+		}
+	}
+	return err
+}
@@ -0,0 +1,241 @@
+package nats_test
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"errors"
+	"net"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/nats-io/nkeys"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/nats"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/retry"
+)
+
+// newServer starts an in-process NATS server on 127.0.0.1, on a free port unless opts sets one
+func newServer(opts *server.Options) (*server.Server, error) {
+	opts.Host = "127.0.0.1"
+	if opts.Port == 0 {
+		opts.Port = -1
+	}
+	opts.NoLog = true
+	opts.NoSigs = true
+	s, err := server.NewServer(opts)
+	if err != nil {
+		return nil, err
+	}
+	s.Start()
+	return s, nil
+}
+
+// startServer starts a NATS server that is shut down after the spec
+func startServer(opts *server.Options) *server.Server {
+	s, err := newServer(opts)
+	Expect(err).NotTo(HaveOccurred())
+	DeferCleanup(s.Shutdown)
+	Expect(s.ReadyForConnections(5 * time.Second)).To(BeTrue())
+	return s
+}
+
+// subscribe subscribes to subject on the server, so published messages can be received
+func subscribe(url, subject string, opts ...natsgo.Option) *natsgo.Subscription {
+	conn, err := natsgo.Connect(url, opts...)
+	Expect(err).NotTo(HaveOccurred())
+	DeferCleanup(conn.Close)
+	sub, err := conn.SubscribeSync(subject)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(conn.Flush()).To(Succeed())
+	return sub
+}
+
+// writeFile writes a credential file
+func writeFile(name, content string) string {
+	path := filepath.Join(GinkgoT().TempDir(), name)
+	Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+	return path
+}
+
+var _ = Describe("Client", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	Describe("NewClient", func() {
+		It("rejects URLs that are not nats or tls", func() {
+			_, err := nats.NewClient(nats.Config{URL: "http://nats:4222", Subject: "results"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("scheme must be nats or tls"))
+		})
+
+		It("requires a subject without whitespace", func() {
+			_, err := nats.NewClient(nats.Config{URL: "nats://nats:4222", Subject: "bad subject"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid NATS subject"))
+		})
+
+		It("rejects more than one authentication method", func() {
+			_, err := nats.NewClient(nats.Config{
+				URL: "nats://nats:4222", Subject: "results", TokenFile: "/secrets/token", CredsFile: "/secrets/user.creds",
+			})
+			Expect(err).To(MatchError(ContainSubstring("only one NATS authentication method")))
+		})
+
+		It("requires the password file with the user", func() {
+			_, err := nats.NewClient(nats.Config{URL: "nats://nats:4222", Subject: "results", User: "reporter"})
+			Expect(err).To(MatchError(ContainSubstring("must be set together")))
+		})
+	})
+
+	Describe("Post", func() {
+		It("publishes the JSON payload to the subject with the token from the file", func() {
+			s := startServer(&server.Options{Authorization: "s3cret"})
+			sub := subscribe(s.ClientURL(), "hyperfleet.results", natsgo.Token("s3cret"))
+
+			client, err := nats.NewClient(nats.Config{
+				URL: s.ClientURL(), Subject: "hyperfleet.results", TokenFile: writeFile("token", "s3cret\n"),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.Post(ctx, map[string]string{"reason": "AllChecksPassed"})).To(Succeed())
+
+			msg, err := sub.NextMsg(time.Second)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(msg.Data)).To(MatchJSON(`{"reason":"AllChecksPassed"}`))
+		})
+
+		It("authenticates with a user and the password from the file", func() {
+			s := startServer(&server.Options{Users: []*server.User{{Username: "reporter", Password: "pa55"}}})
+			sub := subscribe(s.ClientURL(), "results", natsgo.UserInfo("reporter", "pa55"))
+
+			client, err := nats.NewClient(nats.Config{
+				URL: s.ClientURL(), Subject: "results", User: "reporter", PasswordFile: writeFile("password", "pa55\n"),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.Post(ctx, map[string]string{"status": "True"})).To(Succeed())
+
+			_, err = sub.NextMsg(time.Second)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("authenticates with the nkey from the seed file", func() {
+			user, err := nkeys.CreateUser()
+			Expect(err).NotTo(HaveOccurred())
+			publicKey, err := user.PublicKey()
+			Expect(err).NotTo(HaveOccurred())
+			seed, err := user.Seed()
+			Expect(err).NotTo(HaveOccurred())
+			s := startServer(&server.Options{Nkeys: []*server.NkeyUser{{Nkey: publicKey}}})
+			sub := subscribe(s.ClientURL(), "results", natsgo.Nkey(publicKey, user.Sign))
+
+			client, err := nats.NewClient(nats.Config{
+				URL: s.ClientURL(), Subject: "results", NKeySeedFile: writeFile("user.nk", string(seed)),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.Post(ctx, map[string]string{"status": "True"})).To(Succeed())
+
+			_, err = sub.NextMsg(time.Second)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("verifies a TLS server against the CA file", func() {
+			// The httptest certificate is valid for 127.0.0.1
+			certSource := httptest.NewTLSServer(nil)
+			certSource.Close()
+			s := startServer(&server.Options{
+				TLS:       true,
+				TLSConfig: &tls.Config{Certificates: certSource.TLS.Certificates, MinVersion: tls.VersionTLS12},
+			})
+			caFile := writeFile("ca.crt", string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certSource.Certificate().Raw})))
+			url := "tls://" + net.JoinHostPort("127.0.0.1", strconv.Itoa(s.Addr().(*net.TCPAddr).Port))
+			sub := subscribe(url, "results", natsgo.RootCAs(caFile))
+
+			client, err := nats.NewClient(nats.Config{URL: url, Subject: "results", CAFile: caFile})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(client.Post(ctx, map[string]string{"status": "True"})).To(Succeed())
+
+			_, err = sub.NextMsg(time.Second)
+			Expect(err).NotTo(HaveOccurred())
+
+			untrusted, err := nats.NewClient(nats.Config{URL: url, Subject: "results"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(untrusted.Post(ctx, map[string]string{"status": "True"})).NotTo(Succeed())
+		})
+
+		It("does not retry a refused token", func() {
+			s := startServer(&server.Options{Authorization: "s3cret"})
+
+			client, err := nats.NewClient(nats.Config{
+				URL: s.ClientURL(), Subject: "results", TokenFile: writeFile("token", "wrong"),
+				Retry: retry.Policy{MaxRetries: 2, Interval: 10 * time.Millisecond},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = client.Post(ctx, map[string]string{"status": "True"})
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, natsgo.ErrAuthorization)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("after 1 attempt(s)"))
+		})
+
+		It("does not retry a publish the user is not permitted to make", func() {
+			s := startServer(&server.Options{Users: []*server.User{{
+				Username:    "reporter",
+				Password:    "pa55",
+				Permissions: &server.Permissions{Publish: &server.SubjectPermission{Allow: []string{"other"}}},
+			}}})
+
+			client, err := nats.NewClient(nats.Config{
+				URL: s.ClientURL(), Subject: "results", User: "reporter", PasswordFile: writeFile("password", "pa55"),
+				Retry: retry.Policy{MaxRetries: 2, Interval: 10 * time.Millisecond},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = client.Post(ctx, map[string]string{"status": "True"})
+			Expect(err).To(HaveOccurred())
+			Expect(errors.Is(err, natsgo.ErrPermissionViolation)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("after 1 attempt(s)"))
+		})
+
+		It("retries until the server is reachable", func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			port := listener.Addr().(*net.TCPAddr).Port
+			Expect(listener.Close()).To(Succeed())
+
+			client, err := nats.NewClient(nats.Config{
+				URL: "nats://127.0.0.1:" + strconv.Itoa(port), Subject: "results",
+				Retry: retry.Policy{MaxRetries: 5, Interval: 100 * time.Millisecond},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			started := make(chan *server.Server, 1)
+			time.AfterFunc(150*time.Millisecond, func() {
+				s, _ := newServer(&server.Options{Port: port})
+				started <- s
+			})
+
+			Expect(client.Post(ctx, map[string]string{"status": "True"})).To(Succeed())
+			s := <-started
+			Expect(s).NotTo(BeNil())
+			s.Shutdown()
+		})
+
+		It("fails when the token file cannot be read", func() {
+			client, err := nats.NewClient(nats.Config{URL: "nats://127.0.0.1:4222", Subject: "results", TokenFile: "/nonexistent/token"})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = client.Post(ctx, map[string]string{"status": "True"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to read NATS token file"))
+		})
+	})
+})
@@ -0,0 +1,263 @@
+// Package objectstore uploads objects to S3-compatible storage: Amazon S3, Google Cloud Storage
+// through its XML API with HMAC keys, and self-hosted stores such as MinIO. Requests are made with
+// the minio-go client, which signs them with AWS Signature Version 4.
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/s3utils"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/retry"
+)
+
+const (
+	// DefaultTimeout bounds a single upload request
+	DefaultTimeout = 30 * time.Second
+
+	// DefaultRegion is the signing region used when none is configured
+	DefaultRegion = "us-east-1"
+
+	// AddressingAuto addresses buckets virtual-hosted style on Amazon S3 and GCS, and path-style on
+	// other endpoints
+	AddressingAuto = "auto"
+
+	// AddressingPath addresses objects as <endpoint>/<bucket>/<key>
+	AddressingPath = "path"
+
+	// AddressingVirtual addresses objects as <bucket>.<endpoint host>/<key>
+	AddressingVirtual = "virtual"
+)
+
+// Config configures the object store client. Credentials come from the first of: the access key
+// files, the web identity token file, or the default chain.
+type Config struct {
+	// Endpoint is the storage API base URL without a path, e.g. https://s3.eu-west-1.amazonaws.com
+	// or https://storage.googleapis.com
+	Endpoint string
+
+	// Region is the signing region (DefaultRegion when empty; GCS accepts "auto")
+	Region string
+
+	// Bucket is the bucket objects are uploaded to
+	Bucket string
+
+	// Addressing is AddressingAuto (when empty), AddressingPath or AddressingVirtual
+	Addressing string
+
+	// AccessKeyFile and SecretKeyFile hold the access key ID and secret access key, typically
+	// from a mounted Secret; they are read before every request so rotated keys are picked up
+	AccessKeyFile string
+	SecretKeyFile string
+
+	// WebIdentityTokenFile holds a projected service account token that is exchanged for temporary
+	// credentials of RoleARN with STS AssumeRoleWithWebIdentity. The credentials are cached until
+	// they expire.
+	WebIdentityTokenFile string
+	RoleARN              string
+
+	// STSEndpoint is the STS URL used for the web identity exchange (the regional AWS STS endpoint
+	// of Region when empty)
+	STSEndpoint string
+
+	// Timeout bounds a single request (DefaultTimeout when zero)
+	Timeout time.Duration
+
+	// Retry bounds the retries of network errors, 429 and 5xx responses
+	Retry retry.Policy
+}
+
+// Client uploads objects to one bucket
+type Client struct {
+	client   *minio.Client
+	endpoint *url.URL
+	bucket   string
+	virtual  bool
+	timeout  time.Duration
+	retry    retry.Policy
+}
+
+// NewClient creates an object store client, validating the endpoint, bucket and credentials up
+// front
+func NewClient(cfg Config) (*Client, error) {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid object store endpoint %q: must be an absolute http or https URL", cfg.Endpoint)
+	}
+	if strings.Trim(endpoint.Path, "/") != "" {
+		return nil, fmt.Errorf("invalid object store endpoint %q: must not have a path", cfg.Endpoint)
+	}
+	endpoint.Path = ""
+	if cfg.Bucket == "" || strings.Contains(cfg.Bucket, "/") {
+		return nil, fmt.Errorf("invalid object store bucket %q", cfg.Bucket)
+	}
+
+	region := cfg.Region
+	if region == "" {
+		region = DefaultRegion
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	var lookup minio.BucketLookupType
+	virtual := false
+	switch cfg.Addressing {
+	case "", AddressingAuto:
+		lookup = minio.BucketLookupAuto
+		virtual = s3utils.IsVirtualHostSupported(*endpoint, cfg.Bucket)
+	case AddressingPath:
+		lookup = minio.BucketLookupPath
+	case AddressingVirtual:
+		lookup = minio.BucketLookupDNS
+		virtual = true
+	default:
+		return nil, fmt.Errorf("invalid object store addressing %q: must be %s, %s or %s",
+			cfg.Addressing, AddressingAuto, AddressingPath, AddressingVirtual)
+	}
+
+	creds, err := newCredentials(cfg, region)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := minio.New(endpoint.Host, &minio.Options{
+		Creds:        creds,
+		Secure:       endpoint.Scheme == "https",
+		Region:       region,
+		BucketLookup: lookup,
+		// Attempts are retried by Put, with the reporter's policy
+		MaxRetries: 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create object store client: %w", err)
+	}
+
+	return &Client{
+		client:   client,
+		endpoint: endpoint,
+		bucket:   cfg.Bucket,
+		virtual:  virtual,
+		timeout:  timeout,
+		retry:    cfg.Retry,
+	}, nil
+}
+
+// newCredentials returns the credentials of the access key files, the web identity token file,
+// or, without either, the default chain: the AWS_ACCESS_KEY_ID/AWS_SECRET_ACCESS_KEY variables,
+// the web identity injected by IRSA (AWS_WEB_IDENTITY_TOKEN_FILE and AWS_ROLE_ARN), EKS Pod
+// Identity or ECS container credentials, and the EC2 instance profile
+func newCredentials(cfg Config, region string) (*credentials.Credentials, error) {
+	hasKeys := cfg.AccessKeyFile != "" || cfg.SecretKeyFile != ""
+	switch {
+	case hasKeys && cfg.WebIdentityTokenFile != "":
+		return nil, errors.New("object store access key files and web identity token file are mutually exclusive")
+	case hasKeys:
+		if cfg.AccessKeyFile == "" || cfg.SecretKeyFile == "" {
+			return nil, errors.New("object store access key and secret key files must be set together")
+		}
+		return credentials.New(&fileKeys{accessKeyFile: cfg.AccessKeyFile, secretKeyFile: cfg.SecretKeyFile}), nil
+	case cfg.WebIdentityTokenFile != "":
+		if cfg.RoleARN == "" {
+			return nil, errors.New("object store web identity token file requires a role ARN")
+		}
+		stsEndpoint := cfg.STSEndpoint
+		if stsEndpoint == "" {
+			stsEndpoint = "https://sts." + region + ".amazonaws.com"
+		}
+		tokenFile := cfg.WebIdentityTokenFile
+		return credentials.NewSTSWebIdentity(stsEndpoint, func() (*credentials.WebIdentityToken, error) {
+			token, err := os.ReadFile(tokenFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read object store web identity token file path=%s: %w", tokenFile, err)
+			}
+			return &credentials.WebIdentityToken{Token: strings.TrimSpace(string(token))}, nil
+		}, func(i *credentials.STSWebIdentity) {
+			i.RoleARN = cfg.RoleARN
+		})
+	}
+	return credentials.NewChainCredentials([]credentials.Provider{
+		&credentials.EnvAWS{},
+		&credentials.IAM{},
+	}), nil
+}
+
+// fileKeys reads static keys from files on every request, so rotated keys are picked up
+type fileKeys struct {
+	accessKeyFile string
+	secretKeyFile string
+}
+
+func (k *fileKeys) Retrieve() (credentials.Value, error) {
+	return k.RetrieveWithCredContext(nil)
+}
+
+func (k *fileKeys) RetrieveWithCredContext(_ *credentials.CredContext) (credentials.Value, error) {
+	accessKey, err := os.ReadFile(k.accessKeyFile)
+	if err != nil {
+		return credentials.Value{}, fmt.Errorf("failed to read object store access key file path=%s: %w", k.accessKeyFile, err)
+	}
+	secretKey, err := os.ReadFile(k.secretKeyFile)
+	if err != nil {
+		return credentials.Value{}, fmt.Errorf("failed to read object store secret key file path=%s: %w", k.secretKeyFile, err)
+	}
+	return credentials.Value{
+		AccessKeyID:     strings.TrimSpace(string(accessKey)),
+		SecretAccessKey: strings.TrimSpace(string(secretKey)),
+		SignerType:      credentials.SignatureV4,
+	}, nil
+}
+
+func (k *fileKeys) IsExpired() bool {
+	return true
+}
+
+// ObjectURL returns the URL of the object with the given key
+func (c *Client) ObjectURL(key string) string {
+	if c.virtual {
+		return c.endpoint.Scheme + "://" + c.bucket + "." + c.endpoint.Host + "/" + s3utils.EncodePath(key)
+	}
+	return c.endpoint.Scheme + "://" + c.endpoint.Host + "/" + c.bucket + "/" + s3utils.EncodePath(key)
+}
+
+// Put uploads body as the object with the given key, retrying transient failures, and returns
+// the object URL
+func (c *Client) Put(ctx context.Context, key, contentType string, body []byte) (string, error) {
+	err := retry.Do(ctx, "object upload", c.retry, func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+
+		_, err := c.client.PutObject(ctx, c.bucket, key, bytes.NewReader(body), int64(len(body)),
+			minio.PutObjectOptions{ContentType: contentType})
+		if err != nil && !isRetryable(err) {
+			return retry.Permanent(err)
+		}
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return c.ObjectURL(key), nil
+}
+
+// isRetryable reports whether a failed upload may succeed when repeated: responses other than 429
+// and 5xx are not, while network and credential retrieval failures are, unless they are failures
+// to read a credential file
+func isRetryable(err error) bool {
+	var resp minio.ErrorResponse
+	if !errors.As(err, &resp) {
+		return !retry.IsPermanent(err)
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
@@ -0,0 +1,13 @@
+package objectstore_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestObjectstore(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Objectstore Suite")
+}
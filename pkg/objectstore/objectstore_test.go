@@ -0,0 +1,317 @@
+package objectstore_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/johannesboyne/gofakes3"
+	"github.com/johannesboyne/gofakes3/backend/s3mem"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/objectstore"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/retry"
+)
+
+const (
+	testAccessKey = "AKIDEXAMPLE"
+	testSecretKey = "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"
+)
+
+// expectedAuthorization recomputes the SigV4 Authorization header of a request the way the server
+// does, over the headers the request says it signed
+func expectedAuthorization(req *http.Request, accessKey, secretKey, region string) string {
+	auth := req.Header.Get("Authorization")
+	start := strings.Index(auth, "SignedHeaders=")
+	Expect(start).To(BeNumerically(">=", 0), "unsigned request: %q", auth)
+	signedHeaders := strings.SplitN(auth[start+len("SignedHeaders="):], ",", 2)[0]
+
+	names := strings.Split(signedHeaders, ";")
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		value := strings.Join(req.Header.Values(name), ",")
+		if name == "host" {
+			value = req.Host
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.Join(strings.Fields(value), " ") + "\n")
+	}
+
+	amzDate := req.Header.Get("X-Amz-Date")
+	date := amzDate[:8]
+	canonical := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		req.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+	canonicalHash := sha256.Sum256([]byte(canonical))
+	scope := date + "/" + region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalHash[:])
+
+	sign := func(key []byte, data string) []byte {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(data))
+		return mac.Sum(nil)
+	}
+	key := sign(sign(sign(sign([]byte("AWS4"+secretKey), date), region), "s3"), "aws4_request")
+	return "AWS4-HMAC-SHA256 Credential=" + accessKey + "/" + scope +
+		",SignedHeaders=" + signedHeaders + ",Signature=" + hex.EncodeToString(sign(key, stringToSign))
+}
+
+// normalizeAuthorization removes the optional spaces between the Authorization header parameters
+func normalizeAuthorization(auth string) string {
+	return strings.ReplaceAll(auth, ", ", ",")
+}
+
+var _ = Describe("Client", func() {
+	var (
+		ctx           context.Context
+		dir           string
+		accessKeyFile string
+		secretKeyFile string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dir = GinkgoT().TempDir()
+		accessKeyFile = filepath.Join(dir, "access-key")
+		secretKeyFile = filepath.Join(dir, "secret-key")
+		Expect(os.WriteFile(accessKeyFile, []byte(testAccessKey+"\n"), 0o600)).To(Succeed())
+		Expect(os.WriteFile(secretKeyFile, []byte(testSecretKey+"\n"), 0o600)).To(Succeed())
+	})
+
+	Describe("NewClient", func() {
+		It("rejects an endpoint that is not an http URL", func() {
+			_, err := objectstore.NewClient(objectstore.Config{
+				Endpoint: "s3.amazonaws.com", Bucket: "audit", AccessKeyFile: accessKeyFile, SecretKeyFile: secretKeyFile,
+			})
+			Expect(err).To(MatchError(ContainSubstring("invalid object store endpoint")))
+		})
+
+		It("rejects an endpoint with a path", func() {
+			_, err := objectstore.NewClient(objectstore.Config{Endpoint: "https://minio.storage/s3", Bucket: "audit"})
+			Expect(err).To(MatchError(ContainSubstring("must not have a path")))
+		})
+
+		It("requires both access key files", func() {
+			_, err := objectstore.NewClient(objectstore.Config{
+				Endpoint: "https://s3.amazonaws.com", Bucket: "audit", AccessKeyFile: accessKeyFile,
+			})
+			Expect(err).To(MatchError(ContainSubstring("must be set together")))
+		})
+
+		It("requires a role ARN with the web identity token file", func() {
+			_, err := objectstore.NewClient(objectstore.Config{
+				Endpoint: "https://s3.amazonaws.com", Bucket: "audit", WebIdentityTokenFile: "/var/run/secrets/token",
+			})
+			Expect(err).To(MatchError(ContainSubstring("requires a role ARN")))
+		})
+
+		It("rejects an unknown addressing style", func() {
+			_, err := objectstore.NewClient(objectstore.Config{Endpoint: "https://s3.amazonaws.com", Bucket: "audit", Addressing: "dns"})
+			Expect(err).To(MatchError(ContainSubstring("invalid object store addressing")))
+		})
+	})
+
+	Describe("ObjectURL", func() {
+		DescribeTable("addresses the object",
+			func(endpoint, addressing, want string) {
+				client, err := objectstore.NewClient(objectstore.Config{
+					Endpoint: endpoint, Bucket: "audit", Addressing: addressing,
+					AccessKeyFile: accessKeyFile, SecretKeyFile: secretKeyFile,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(client.ObjectURL("runs/job+1/result.json")).To(Equal(want))
+			},
+			Entry("path-style on a self-hosted store", "https://minio.storage:9000", "",
+				"https://minio.storage:9000/audit/runs/job%2B1/result.json"),
+			Entry("virtual-hosted style on Amazon S3", "https://s3.amazonaws.com", objectstore.AddressingAuto,
+				"https://audit.s3.amazonaws.com/runs/job%2B1/result.json"),
+			Entry("path-style when requested", "https://s3.amazonaws.com", objectstore.AddressingPath,
+				"https://s3.amazonaws.com/audit/runs/job%2B1/result.json"),
+			Entry("virtual-hosted style when requested", "https://minio.storage", objectstore.AddressingVirtual,
+				"https://audit.minio.storage/runs/job%2B1/result.json"),
+		)
+	})
+
+	Describe("Put", func() {
+		It("uploads the object to an S3-compatible store", func() {
+			backend := s3mem.New()
+			Expect(backend.CreateBucket("audit")).To(Succeed())
+			server := httptest.NewServer(gofakes3.New(backend).Server())
+			defer server.Close()
+
+			client, err := objectstore.NewClient(objectstore.Config{
+				Endpoint: server.URL, Bucket: "audit", AccessKeyFile: accessKeyFile, SecretKeyFile: secretKeyFile,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			objectURL, err := client.Put(ctx, "runs/job+1/result.json", "application/json", []byte(`{"status":"success"}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objectURL).To(Equal(server.URL + "/audit/runs/job%2B1/result.json"))
+
+			object, err := backend.GetObject("audit", "runs/job+1/result.json", nil)
+			Expect(err).NotTo(HaveOccurred())
+			defer func() { _ = object.Contents.Close() }()
+			body, err := io.ReadAll(object.Contents)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal(`{"status":"success"}`))
+			Expect(object.Metadata).To(HaveKeyWithValue("Content-Type", "application/json"))
+		})
+
+		It("signs the request with the keys from the files", func() {
+			var gotAuth, wantAuth string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				gotAuth = normalizeAuthorization(req.Header.Get("Authorization"))
+				wantAuth = expectedAuthorization(req, testAccessKey, testSecretKey, "eu-west-1")
+				_, _ = io.Copy(io.Discard, req.Body)
+			}))
+			defer server.Close()
+
+			client, err := objectstore.NewClient(objectstore.Config{
+				Endpoint: server.URL, Region: "eu-west-1", Bucket: "audit",
+				AccessKeyFile: accessKeyFile, SecretKeyFile: secretKeyFile,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = client.Put(ctx, "result.json", "application/json", []byte(`{}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gotAuth).To(ContainSubstring("Credential=" + testAccessKey + "/"))
+			Expect(gotAuth).To(Equal(wantAuth))
+		})
+
+		It("exchanges the web identity token for temporary credentials", func() {
+			var stsForm map[string][]string
+			sts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				Expect(req.ParseForm()).To(Succeed())
+				stsForm = req.PostForm
+				w.Header().Set("Content-Type", "text/xml")
+				_, _ = io.WriteString(w, `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <Credentials>
+      <AccessKeyId>ASIAEXAMPLE</AccessKeyId>
+      <SecretAccessKey>session-secret</SecretAccessKey>
+      <SessionToken>session-token</SessionToken>
+      <Expiration>`+time.Now().Add(time.Hour).UTC().Format(time.RFC3339)+`</Expiration>
+    </Credentials>
+  </AssumeRoleWithWebIdentityResult>
+</AssumeRoleWithWebIdentityResponse>`)
+			}))
+			defer sts.Close()
+
+			var gotAuth, wantAuth, gotSessionToken string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				gotAuth = normalizeAuthorization(req.Header.Get("Authorization"))
+				wantAuth = expectedAuthorization(req, "ASIAEXAMPLE", "session-secret", "us-east-1")
+				gotSessionToken = req.Header.Get("X-Amz-Security-Token")
+				_, _ = io.Copy(io.Discard, req.Body)
+			}))
+			defer server.Close()
+
+			tokenFile := filepath.Join(dir, "token")
+			Expect(os.WriteFile(tokenFile, []byte("projected-jwt\n"), 0o600)).To(Succeed())
+			client, err := objectstore.NewClient(objectstore.Config{
+				Endpoint: server.URL, Bucket: "audit", WebIdentityTokenFile: tokenFile,
+				RoleARN: "arn:aws:iam::123456789012:role/status-reporter", STSEndpoint: sts.URL,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = client.Put(ctx, "result.json", "application/json", []byte(`{}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stsForm).To(HaveKeyWithValue("Action", []string{"AssumeRoleWithWebIdentity"}))
+			Expect(stsForm).To(HaveKeyWithValue("RoleArn", []string{"arn:aws:iam::123456789012:role/status-reporter"}))
+			Expect(stsForm).To(HaveKeyWithValue("WebIdentityToken", []string{"projected-jwt"}))
+			Expect(gotAuth).To(Equal(wantAuth))
+			Expect(gotSessionToken).To(Equal("session-token"))
+		})
+
+		It("falls back to the keys in the AWS environment variables", func() {
+			GinkgoT().Setenv("AWS_ACCESS_KEY_ID", "AKIDENV")
+			GinkgoT().Setenv("AWS_SECRET_ACCESS_KEY", "env-secret")
+			var gotAuth, wantAuth string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				gotAuth = normalizeAuthorization(req.Header.Get("Authorization"))
+				wantAuth = expectedAuthorization(req, "AKIDENV", "env-secret", "us-east-1")
+				_, _ = io.Copy(io.Discard, req.Body)
+			}))
+			defer server.Close()
+
+			client, err := objectstore.NewClient(objectstore.Config{Endpoint: server.URL, Bucket: "audit"})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = client.Put(ctx, "result.json", "application/json", []byte(`{}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gotAuth).To(Equal(wantAuth))
+		})
+
+		It("retries server errors", func() {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				_, _ = io.Copy(io.Discard, req.Body)
+				if attempts.Add(1) == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+				}
+			}))
+			defer server.Close()
+
+			client, err := objectstore.NewClient(objectstore.Config{
+				Endpoint: server.URL, Bucket: "audit", AccessKeyFile: accessKeyFile, SecretKeyFile: secretKeyFile,
+				Retry: retry.Policy{MaxRetries: 2, Interval: 10 * time.Millisecond},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = client.Put(ctx, "result.json", "application/json", []byte(`{}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(attempts.Load()).To(Equal(int32(2)))
+		})
+
+		It("does not retry client errors", func() {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				_, _ = io.Copy(io.Discard, req.Body)
+				attempts.Add(1)
+				w.Header().Set("Content-Type", "application/xml")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte("<Error><Code>SignatureDoesNotMatch</Code><Message>The request signature we calculated does not match</Message></Error>"))
+			}))
+			defer server.Close()
+
+			client, err := objectstore.NewClient(objectstore.Config{
+				Endpoint: server.URL, Bucket: "audit", AccessKeyFile: accessKeyFile, SecretKeyFile: secretKeyFile,
+				Retry: retry.Policy{MaxRetries: 3, Interval: 10 * time.Millisecond},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = client.Put(ctx, "result.json", "application/json", []byte(`{}`))
+			Expect(err).To(MatchError(ContainSubstring("signature we calculated does not match")))
+			Expect(attempts.Load()).To(Equal(int32(1)))
+		})
+
+		It("does not retry when a key file cannot be read", func() {
+			Expect(os.Remove(secretKeyFile)).To(Succeed())
+			client, err := objectstore.NewClient(objectstore.Config{
+				Endpoint: "http://127.0.0.1:1", Bucket: "audit", AccessKeyFile: accessKeyFile, SecretKeyFile: secretKeyFile,
+				Retry: retry.Policy{MaxRetries: 3, Interval: 10 * time.Millisecond},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = client.Put(ctx, "result.json", "application/json", []byte(`{}`))
+			Expect(err).To(MatchError(ContainSubstring("failed to read object store secret key file")))
+			Expect(err.Error()).To(ContainSubstring("after 1 attempt(s)"))
+		})
+	})
+})
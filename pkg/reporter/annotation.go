@@ -0,0 +1,67 @@
+package reporter
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// ResultAnnotation is the value of the Job annotation written by WithResultAnnotation, as
+// compact JSON
+type ResultAnnotation struct {
+	ConditionType string `json:"conditionType"`
+	Status        string `json:"status"`
+	Reason        string `json:"reason"`
+	Message       string `json:"message"`
+
+	// DetailsDigest is the SHA-256 digest of the adapter result details in compact JSON, as
+	// "sha256:<hex>", so automation can tell whether the details changed between runs
+	DetailsDigest string `json:"detailsDigest,omitempty"`
+}
+
+// WithResultAnnotation writes the reported reason and message to the Job annotation key after the
+// Job status is updated, for automation that reads annotations rather than conditions. With
+// digest, the annotation also carries a digest of the adapter result details. Delivery is
+// best-effort: failures are logged and ignored.
+func WithResultAnnotation(key string, digest bool) Option {
+	return func(r *StatusReporter) {
+		r.publishers = append(r.publishers, outcomePublisher{
+			name:  "result annotation",
+			retry: &r.sinkRetry,
+			publish: func(ctx context.Context, outcome Outcome) error {
+				value, err := r.resultAnnotation(outcome, digest)
+				if err != nil {
+					return err
+				}
+				return r.k8sClient.AnnotateJob(ctx, map[string]string{key: value})
+			},
+		})
+	}
+}
+
+// resultAnnotation formats the annotation value for the outcome
+func (r *StatusReporter) resultAnnotation(outcome Outcome, digest bool) (string, error) {
+	annotation := ResultAnnotation{
+		ConditionType: outcome.ConditionType,
+		Status:        outcome.Status,
+		Reason:        outcome.Reason,
+		Message:       outcome.Message,
+	}
+	if digest && r.reportedResult != nil && len(r.reportedResult.Details) > 0 {
+		var details bytes.Buffer
+		if err := json.Compact(&details, r.reportedResult.Details); err != nil {
+			return "", fmt.Errorf("failed to compact result details: %w", err)
+		}
+		sum := sha256.Sum256(details.Bytes())
+		annotation.DetailsDigest = "sha256:" + hex.EncodeToString(sum[:])
+	}
+
+	value, err := json.Marshal(annotation)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode result annotation: %w", err)
+	}
+	return string(value), nil
+}
@@ -0,0 +1,116 @@
+package reporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
+)
+
+const (
+	// ResultArchiveOutcomeObject holds the run outcome, as published to the other outcome targets
+	ResultArchiveOutcomeObject = "outcome.json"
+
+	// ResultArchiveResultObject holds the full adapter result, including its details. It is only
+	// uploaded when the condition was reported from an adapter result.
+	ResultArchiveResultObject = "result.json"
+
+	// ResultArchiveRawObject holds the result exactly as the adapter wrote it, in whatever format. It
+	// is only uploaded when the condition was reported from a single parsed result.
+	ResultArchiveRawObject = "result.raw"
+
+	// ResultArchiveLogObject holds the tail of the adapter container log
+	ResultArchiveLogObject = "adapter.log"
+)
+
+// ObjectUploader stores an object in external storage and returns its URL
+type ObjectUploader interface {
+	Put(ctx context.Context, key, contentType string, body []byte) (string, error)
+}
+
+// WithResultArchive uploads the run outcome, the full adapter result with the raw bytes it was
+// parsed from and, when logTailLines is positive, the tail of the adapter container log under
+// "<prefix><job UID>/" after the Job status is updated, and records the URL of the result (or,
+// without one, of the outcome) in the k8s.ResultArchiveAnnotation Job annotation. Keying by UID
+// keeps runs of re-created Jobs apart. Delivery is best-effort: failures are logged and ignored.
+func WithResultArchive(uploader ObjectUploader, prefix string, logTailLines int64) Option {
+	return func(r *StatusReporter) {
+		r.publishers = append(r.publishers, outcomePublisher{
+			name: "result archive",
+			publish: func(ctx context.Context, outcome Outcome) error {
+				return r.archiveResult(ctx, uploader, prefix, logTailLines, outcome)
+			},
+		})
+	}
+}
+
+// archiveResult uploads the run objects and annotates the Job with the archive URL
+func (r *StatusReporter) archiveResult(ctx context.Context, uploader ObjectUploader, prefix string, logTailLines int64, outcome Outcome) error {
+	uid, err := r.k8sClient.GetJobUID(ctx)
+	if err != nil {
+		return err
+	}
+	if uid == "" {
+		return fmt.Errorf("job UID is unknown")
+	}
+	keyPrefix := prefix + uid + "/"
+
+	encodedOutcome, err := json.Marshal(outcome)
+	if err != nil {
+		return fmt.Errorf("failed to encode outcome: %w", err)
+	}
+	archiveURL, err := uploader.Put(ctx, keyPrefix+ResultArchiveOutcomeObject, "application/json", encodedOutcome)
+	if err != nil {
+		return err
+	}
+
+	if r.reportedResult != nil {
+		encodedResult, err := json.Marshal(r.reportedResult)
+		if err != nil {
+			return fmt.Errorf("failed to encode adapter result: %w", err)
+		}
+		archiveURL, err = uploader.Put(ctx, keyPrefix+ResultArchiveResultObject, "application/json", encodedResult)
+		if err != nil {
+			return err
+		}
+		if len(r.reportedResult.Raw) > 0 {
+			if _, err := uploader.Put(ctx, keyPrefix+ResultArchiveRawObject, "application/octet-stream", r.reportedResult.Raw); err != nil {
+				return err
+			}
+		}
+	}
+
+	if logTailLines > 0 {
+		r.archiveAdapterLog(ctx, uploader, keyPrefix+ResultArchiveLogObject, logTailLines)
+	}
+
+	log.Printf("Archived adapter result: url=%s", archiveURL)
+	return r.k8sClient.AnnotateJob(ctx, map[string]string{k8s.ResultArchiveAnnotation: archiveURL})
+}
+
+// archiveAdapterLog uploads the tail of the adapter container log. Failures are logged, since
+// the log is supplementary to the result.
+func (r *StatusReporter) archiveAdapterLog(ctx context.Context, uploader ObjectUploader, key string, lines int64) {
+	if r.podName == "" {
+		return
+	}
+	status, err := r.getAdapterContainerStatus(ctx)
+	if err != nil {
+		log.Printf("Warning: failed to resolve adapter container for log archive: %v", err)
+		return
+	}
+	if status == nil {
+		log.Printf("Warning: adapter container not found, skipping log archive: pod=%s", r.podName)
+		return
+	}
+	logs, err := r.k8sClient.GetContainerLogTail(ctx, r.podName, status.Name, lines)
+	if err != nil {
+		log.Printf("Warning: failed to get adapter log for archive: %v", err)
+		return
+	}
+	if _, err := uploader.Put(ctx, key, "text/plain; charset=utf-8", []byte(logs)); err != nil {
+		log.Printf("Warning: failed to archive adapter log: %v", err)
+	}
+}
@@ -0,0 +1,98 @@
+package reporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// Files written by WithArgoOutputs, for outputs.parameters[].valueFrom.path and
+	// outputs.artifacts[].path of an Argo Workflows template
+	ArgoOutputStatus  = "status"
+	ArgoOutputReason  = "reason"
+	ArgoOutputMessage = "message"
+	ArgoOutputOutcome = "outcome.json"
+)
+
+// WorkflowNodeClient updates the Argo Workflow node that runs the reporter's pod
+type WorkflowNodeClient interface {
+	PatchNodeMessage(ctx context.Context, nodeID, message string) error
+}
+
+// WithArgoOutputs writes the reported status, reason and message, one per file, and the outcome
+// with the adapter result as outcome.json to dir after the Job status is updated, so an Argo
+// template can expose them as output parameters and artifacts. Delivery is best-effort: failures
+// are logged and ignored.
+func WithArgoOutputs(dir string) Option {
+	return func(r *StatusReporter) {
+		r.publishers = append(r.publishers, outcomePublisher{
+			name:  "Argo outputs",
+			retry: &r.sinkRetry,
+			publish: func(ctx context.Context, outcome Outcome) error {
+				return writeArgoOutputs(dir, CallbackPayload{Outcome: outcome, Result: r.reportedResult})
+			},
+		})
+	}
+}
+
+// writeArgoOutputs writes the output files; each file is replaced atomically
+func writeArgoOutputs(dir string, payload CallbackPayload) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create Argo outputs directory path=%s: %w", dir, err)
+	}
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode outcome: %w", err)
+	}
+
+	files := map[string][]byte{
+		ArgoOutputStatus:  []byte(payload.Status),
+		ArgoOutputReason:  []byte(payload.Reason),
+		ArgoOutputMessage: []byte(payload.Message),
+		ArgoOutputOutcome: encoded,
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		tmp := path + ".tmp"
+		if err := os.WriteFile(tmp, data, 0o644); err != nil {
+			return fmt.Errorf("failed to write Argo output path=%s: %w", path, err)
+		}
+		if err := os.Rename(tmp, path); err != nil {
+			return fmt.Errorf("failed to write Argo output path=%s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// WithArgoNodeStatus sets the message of the Argo Workflow node nodeID to the reported condition.
+// With primary, the node replaces the Job as the primary sink, for adapters run directly as
+// Workflow steps without a Job; its failure is then the report error. Otherwise it receives the
+// outcome after the Job status is updated and failures are logged and ignored.
+func WithArgoNodeStatus(client WorkflowNodeClient, nodeID string, primary bool) Option {
+	return func(r *StatusReporter) {
+		sink := argoNodeSink{client: client, nodeID: nodeID}
+		if primary {
+			r.primarySink = sink
+			return
+		}
+		r.publishers = append(r.publishers, outcomePublisher{name: sink.Name(), retry: &r.sinkRetry, publish: sink.Publish})
+	}
+}
+
+// argoNodeSink writes the reported condition to the message of an Argo Workflow node
+type argoNodeSink struct {
+	client WorkflowNodeClient
+	nodeID string
+}
+
+func (s argoNodeSink) Name() string {
+	return "Argo workflow node"
+}
+
+func (s argoNodeSink) Publish(ctx context.Context, outcome Outcome) error {
+	message := fmt.Sprintf("%s=%s %s: %s", outcome.ConditionType, outcome.Status, outcome.Reason, outcome.Message)
+	return s.client.PatchNodeMessage(ctx, s.nodeID, message)
+}
@@ -0,0 +1,137 @@
+package reporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+)
+
+// ResultCheck maps the result file of one check in the results directory to its condition type
+type ResultCheck struct {
+	File          string
+	ConditionType string
+}
+
+// checkPaths returns the paths of the result check files, in configuration order
+func (r *StatusReporter) checkPaths() []string {
+	paths := make([]string, 0, len(r.resultChecks))
+	for _, check := range r.resultChecks {
+		paths = append(paths, filepath.Join(r.resultChecksDir, check.File))
+	}
+	return paths
+}
+
+// statResultChecks returns the check files present in the results directory. While polling, all of
+// them must be present; once the adapter is done (final), any will do. Too few files are reported
+// as os.ErrNotExist, like a missing result file. An empty file is one the adapter has created but
+// not yet written, so it does not count as present.
+func (r *StatusReporter) statResultChecks(final bool) ([]string, error) {
+	var present []string
+	for _, path := range r.checkPaths() {
+		info, err := os.Stat(path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to stat result file path=%s: %w", path, err)
+		}
+		if info.Size() == 0 {
+			continue
+		}
+		present = append(present, path)
+	}
+
+	needed := len(r.resultChecks)
+	if final {
+		needed = 1
+	}
+	if len(present) < needed {
+		return nil, fmt.Errorf("%d of %d result files in dir=%s: %w", len(present), len(r.resultChecks), r.resultChecksDir, os.ErrNotExist)
+	}
+	return present, nil
+}
+
+// parseResultChecks parses the check files and aggregates them into the result of the main
+// condition, counting check files that were not written as failures. An intermediate result in
+// any file is returned as is, so the aggregate waits for all of them.
+func (r *StatusReporter) parseResultChecks(present []string) (*result.AdapterResult, error) {
+	files := r.parser.ParseFiles(present, r.aggregateConcurrency)
+	for _, f := range files {
+		if f.Err == nil && r.isIntermediate(f.Result) {
+			return f.Result, nil
+		}
+	}
+
+	for _, path := range r.checkPaths() {
+		if !slices.Contains(present, path) {
+			files = append(files, result.FileResult{Path: path, Result: missingCheckResult()})
+		}
+	}
+	return result.Aggregate(files)
+}
+
+// reportResultChecks sets the condition of each check from its own result file. Checks without a
+// file are reported as AdapterMissingResults, and files that cannot be parsed as invalid results.
+// Intermediate results are skipped since the run is over. It returns the failed status updates.
+func (r *StatusReporter) reportResultChecks(ctx context.Context) error {
+	var errs []error
+	for i, path := range r.checkPaths() {
+		check := r.resultChecks[i]
+		condition, ok := r.checkCondition(check, path)
+		if !ok {
+			continue
+		}
+		if err := r.writeConditions(ctx, []k8s.JobCondition{condition}); err != nil {
+			log.Printf("Failed to update condition %s for result file %s: %v", condition.Type, check.File, err)
+			errs = append(errs, fmt.Errorf("failed to update job status: condition=%s: %w", condition.Type, err))
+			continue
+		}
+		log.Printf("Job status updated: %s=%s (reason: %s)", condition.Type, condition.Status, condition.Reason)
+	}
+	return errors.Join(errs...)
+}
+
+// checkCondition builds the condition of one check from its result file. It returns false when
+// the file holds an intermediate result.
+func (r *StatusReporter) checkCondition(check ResultCheck, path string) (k8s.JobCondition, bool) {
+	adapterResult, err := r.parser.ParseFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		adapterResult = missingCheckResult()
+	case err != nil:
+		reason := ReasonInvalidResultFormat
+		var syntaxErr *result.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			reason = ReasonInvalidResultSyntax
+		}
+		return k8s.JobCondition{
+			Type:    check.ConditionType,
+			Status:  ConditionStatusFalse,
+			Reason:  reason,
+			Message: fmt.Sprintf("Failed to parse adapter result: %v", err),
+		}, true
+	case r.isIntermediate(adapterResult):
+		log.Printf("Result file %s holds an intermediate result (reason=%s); not reporting %s", check.File, adapterResult.Reason, check.ConditionType)
+		return k8s.JobCondition{}, false
+	}
+
+	condition := r.conditionFromResult(adapterResult)
+	condition.Type = check.ConditionType
+	return condition, true
+}
+
+// missingCheckResult is the result of a check whose file was not written
+func missingCheckResult() *result.AdapterResult {
+	return &result.AdapterResult{
+		Status:  result.StatusFailure,
+		Reason:  ReasonAdapterMissingResults,
+		Message: "Expected result file was not written",
+	}
+}
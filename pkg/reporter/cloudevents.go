@@ -0,0 +1,97 @@
+package reporter
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+)
+
+const (
+	// CloudEventsContentType is the Content-Type of a structured-mode CloudEvent
+	CloudEventsContentType = "application/cloudevents+json"
+
+	// CloudEventOutcomeType is the CloudEvent type of a run outcome
+	CloudEventOutcomeType = "io.hyperfleet.statusreporter.outcome"
+
+	// CloudEventResultType is the CloudEvent type of a binary-mode adapter result event
+	CloudEventResultType = "io.hyperfleet.adapter.result.v1"
+
+	cloudEventsSpecVersion = "1.0"
+)
+
+// CloudEvent is a structured-mode CloudEvents 1.0 envelope carrying a run outcome
+type CloudEvent struct {
+	SpecVersion     string  `json:"specversion"`
+	ID              string  `json:"id"`
+	Source          string  `json:"source"`
+	Type            string  `json:"type"`
+	Subject         string  `json:"subject"`
+	Time            string  `json:"time"`
+	DataContentType string  `json:"datacontenttype"`
+	Data            Outcome `json:"data"`
+}
+
+// newOutcomeEvent wraps the outcome in a CloudEvent whose source is the reporting pod and
+// whose subject is the Job
+func newOutcomeEvent(outcome Outcome) (CloudEvent, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return CloudEvent{}, fmt.Errorf("failed to generate event id: %w", err)
+	}
+
+	return CloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              hex.EncodeToString(id),
+		Source:          fmt.Sprintf("/status-reporter/namespaces/%s/pods/%s", outcome.JobNamespace, outcome.PodName),
+		Type:            CloudEventOutcomeType,
+		Subject:         fmt.Sprintf("/apis/batch/v1/namespaces/%s/jobs/%s", outcome.JobNamespace, outcome.JobName),
+		Time:            outcome.Timestamp.Format(time.RFC3339Nano),
+		DataContentType: "application/json",
+		Data:            outcome,
+	}, nil
+}
+
+// BinaryCloudEvent is a binary-mode CloudEvents 1.0 message: the context attributes travel as
+// ce-* HTTP headers and the body is the event data. It implements the callback client's Message.
+type BinaryCloudEvent struct {
+	Attributes map[string]string
+	Data       any
+}
+
+// Headers returns the ce-* headers and the data content type
+func (e BinaryCloudEvent) Headers() map[string]string {
+	headers := map[string]string{"Content-Type": "application/json"}
+	for name, value := range e.Attributes {
+		headers["ce-"+name] = value
+	}
+	return headers
+}
+
+// Body returns the event data
+func (e BinaryCloudEvent) Body() any {
+	return e.Data
+}
+
+// newResultEvent builds a binary-mode CloudEvent of type CloudEventResultType carrying the outcome
+// and the adapter result, with the same source and subject as newOutcomeEvent
+func newResultEvent(outcome Outcome, adapterResult *result.AdapterResult) (BinaryCloudEvent, error) {
+	event, err := newOutcomeEvent(outcome)
+	if err != nil {
+		return BinaryCloudEvent{}, err
+	}
+
+	return BinaryCloudEvent{
+		Attributes: map[string]string{
+			"specversion": event.SpecVersion,
+			"id":          event.ID,
+			"source":      event.Source,
+			"type":        CloudEventResultType,
+			"subject":     event.Subject,
+			"time":        event.Time,
+		},
+		Data: CallbackPayload{Outcome: outcome, Result: adapterResult},
+	}, nil
+}
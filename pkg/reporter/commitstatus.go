@@ -0,0 +1,51 @@
+package reporter
+
+import (
+	"unicode/utf8"
+)
+
+const (
+	// Commit status states of the GitHub-compatible statuses API
+	CommitStatusSuccess = "success"
+	CommitStatusFailure = "failure"
+	CommitStatusError   = "error"
+
+	// maxCommitStatusDescription is the longest description the statuses API accepts
+	maxCommitStatusDescription = 140
+)
+
+// CommitStatus is the request body of a GitHub-compatible commit status
+type CommitStatus struct {
+	State       string `json:"state"`
+	Context     string `json:"context"`
+	Description string `json:"description"`
+}
+
+// newCommitStatus maps the outcome onto a commit status: a True condition is a success, a False
+// condition a failure, and anything else (including a failed status update) an error
+func newCommitStatus(outcome Outcome) CommitStatus {
+	state := CommitStatusError
+	if outcome.Error == "" {
+		switch outcome.Status {
+		case "True":
+			state = CommitStatusSuccess
+		case "False":
+			state = CommitStatusFailure
+		}
+	}
+
+	return CommitStatus{
+		State:       state,
+		Context:     outcome.Reason,
+		Description: truncateDescription(outcome.Message),
+	}
+}
+
+// truncateDescription shortens the message to the statuses API limit without splitting a rune
+func truncateDescription(message string) string {
+	if utf8.RuneCountInString(message) <= maxCommitStatusDescription {
+		return message
+	}
+	runes := []rune(message)
+	return string(runes[:maxCommitStatusDescription-3]) + "..."
+}
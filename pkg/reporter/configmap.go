@@ -0,0 +1,57 @@
+package reporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// ResultConfigMapSuffix is appended to the Job name to name the result ConfigMap
+	ResultConfigMapSuffix = "-result"
+
+	// ResultConfigMapOutcomeKey holds the run outcome, as published to the other outcome targets
+	ResultConfigMapOutcomeKey = "outcome.json"
+
+	// ResultConfigMapResultKey holds the full adapter result, including its details. It is only
+	// present when the condition was reported from an adapter result.
+	ResultConfigMapResultKey = "result.json"
+)
+
+// WithResultConfigMap persists the run outcome and the full adapter result in a ConfigMap named
+// after the Job (<job>-result) after the Job status is updated, so consumers can fetch details that
+// do not fit in a condition message. The ConfigMap is owned by the Job. Delivery is best-effort:
+// failures are logged and ignored.
+func WithResultConfigMap() Option {
+	return func(r *StatusReporter) {
+		r.publishers = append(r.publishers, outcomePublisher{
+			name:  "result ConfigMap",
+			retry: &r.sinkRetry,
+			publish: func(ctx context.Context, outcome Outcome) error {
+				data, err := r.resultConfigMapData(outcome)
+				if err != nil {
+					return err
+				}
+				return r.k8sClient.ApplyJobConfigMap(ctx, r.jobName+ResultConfigMapSuffix, data)
+			},
+		})
+	}
+}
+
+// resultConfigMapData encodes the outcome and the reported adapter result as ConfigMap data
+func (r *StatusReporter) resultConfigMapData(outcome Outcome) (map[string]string, error) {
+	encodedOutcome, err := json.Marshal(outcome)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode outcome: %w", err)
+	}
+	data := map[string]string{ResultConfigMapOutcomeKey: string(encodedOutcome)}
+
+	if r.reportedResult != nil {
+		encodedResult, err := json.Marshal(r.reportedResult)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode adapter result: %w", err)
+		}
+		data[ResultConfigMapResultKey] = string(encodedResult)
+	}
+	return data, nil
+}
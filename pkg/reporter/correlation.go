@@ -0,0 +1,87 @@
+package reporter
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+)
+
+// Keys of the correlation metadata in the message suffix
+const (
+	MessageKVCorrelationID      = "correlationId"
+	MessageKVObservedGeneration = "observedGeneration"
+)
+
+// Correlation identifies the reconcile attempt that created the Job, so a controller can match a
+// condition update to it
+type Correlation struct {
+	ID                 string
+	ObservedGeneration int64
+}
+
+// IsZero reports whether no correlation metadata is set
+func (c Correlation) IsZero() bool {
+	return c.ID == "" && c.ObservedGeneration == 0
+}
+
+// withResult returns the correlation with the values present in the adapter result taking precedence
+func (c Correlation) withResult(adapterResult *result.AdapterResult) Correlation {
+	if adapterResult.CorrelationID != "" {
+		c.ID = adapterResult.CorrelationID
+	}
+	if adapterResult.ObservedGeneration != 0 {
+		c.ObservedGeneration = adapterResult.ObservedGeneration
+	}
+	return c
+}
+
+// pairs formats the set values as key=value message suffix pairs
+func (c Correlation) pairs() []string {
+	var pairs []string
+	if c.ID != "" {
+		pairs = append(pairs, MessageKVCorrelationID+"="+c.ID)
+	}
+	if c.ObservedGeneration != 0 {
+		pairs = append(pairs, MessageKVObservedGeneration+"="+strconv.FormatInt(c.ObservedGeneration, 10))
+	}
+	return pairs
+}
+
+// annotations returns the annotations recording the set values
+func (c Correlation) annotations() map[string]string {
+	annotations := map[string]string{}
+	if c.ID != "" {
+		annotations[k8s.CorrelationIDAnnotation] = c.ID
+	}
+	if c.ObservedGeneration != 0 {
+		annotations[k8s.ObservedGenerationAnnotation] = strconv.FormatInt(c.ObservedGeneration, 10)
+	}
+	return annotations
+}
+
+// WithCorrelation embeds the correlation metadata in the reported condition message, as part of
+// the " [key=value ...]" suffix kept intact when the message is truncated, and records it in
+// annotations of the resource the condition is written to. A correlation ID or observed generation
+// in the adapter result takes precedence.
+func WithCorrelation(correlation Correlation) Option {
+	return func(r *StatusReporter) {
+		r.correlation = correlation
+		r.reportedCorrelation = correlation
+	}
+}
+
+// annotateCorrelation records the reported correlation metadata on the resource the condition was
+// written to: the Job, status target or owner. A primary sink other than the Job, such as an Argo
+// node, has no annotations and only carries it in the message. Failures are logged, since the
+// condition message already carries it.
+func (r *StatusReporter) annotateCorrelation(ctx context.Context) {
+	if r.reportedCorrelation.IsZero() || r.primarySink != nil {
+		return
+	}
+	if err := r.k8sClient.AnnotateConditionTarget(ctx, r.reportedCorrelation.annotations()); err != nil {
+		log.Printf("Warning: failed to annotate the condition target with correlation metadata: %v", err)
+	}
+}
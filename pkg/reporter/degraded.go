@@ -0,0 +1,70 @@
+package reporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+)
+
+// DegradedRule downgrades a success result whose details report failures, for adapters that
+// set a top-level success while listing failed checks in their details
+type DegradedRule struct {
+	// DetailsPointer is a JSON Pointer into the result details (e.g. "/failedChecks"). The rule
+	// matches when the value is a number > 0, a non-empty array, object or string, or true.
+	DetailsPointer string
+
+	// Status and Reason replace the condition status and reason when the rule matches
+	// (ConditionStatusFalse and ReasonPartialFailure when empty)
+	Status string
+	Reason string
+}
+
+// matches reports whether the result details indicate failures
+func (d *DegradedRule) matches(adapterResult *result.AdapterResult) bool {
+	value, found, err := adapterResult.DetailsValue(d.DetailsPointer)
+	if err != nil {
+		log.Printf("Warning: failed to evaluate degraded rule pointer=%s: %v", d.DetailsPointer, err)
+		return false
+	}
+	return found && indicatesFailures(value)
+}
+
+// apply overrides the condition with the degraded state, keeping the adapter's reason in the message
+func (d *DegradedRule) apply(condition k8s.JobCondition) k8s.JobCondition {
+	status := d.Status
+	if status == "" {
+		status = ConditionStatusFalse
+	}
+	reason := d.Reason
+	if reason == "" {
+		reason = ReasonPartialFailure
+	}
+
+	condition.Message = fmt.Sprintf("Adapter reported success (reason: %s) but details report failures at %s: %s",
+		condition.Reason, d.DetailsPointer, condition.Message)
+	condition.Status = status
+	condition.Reason = reason
+	return condition
+}
+
+// indicatesFailures reports whether a details value signals at least one failure
+func indicatesFailures(value any) bool {
+	switch v := value.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return err == nil && f > 0
+	case []any:
+		return len(v) > 0
+	case map[string]any:
+		return len(v) > 0
+	case string:
+		return v != ""
+	case bool:
+		return v
+	default:
+		return false
+	}
+}
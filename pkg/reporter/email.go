@@ -0,0 +1,78 @@
+package reporter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
+)
+
+// DefaultEmailReasons are the failure reasons that trigger the email notification by default
+var DefaultEmailReasons = []string{ReasonAdapterOOMKilled, ReasonAdapterTimeout, ReasonAdapterExitedWithError}
+
+// EmailClient sends a plain-text email to the configured recipients
+type EmailClient interface {
+	Send(ctx context.Context, subject, body string) error
+}
+
+// WithEmailNotification emails a summary of the run when it ends with one of the given reasons,
+// for teams without chat webhooks. At most one email is sent per Job: the sink first claims the
+// Job's EmailNotificationAnnotation, so a retried pod or a second reporter skips the email, and
+// removes the claim again when delivery fails. Delivery is best-effort: failures are logged and
+// ignored.
+func WithEmailNotification(client EmailClient, reasons []string) Option {
+	return func(r *StatusReporter) {
+		r.publishers = append(r.publishers, outcomePublisher{
+			name: "email notification",
+			publish: func(ctx context.Context, outcome Outcome) error {
+				if !slices.Contains(reasons, outcome.Reason) {
+					return nil
+				}
+
+				claimed, err := r.k8sClient.ClaimJobAnnotation(ctx, k8s.EmailNotificationAnnotation, outcome.Timestamp.UTC().Format(time.RFC3339))
+				if err != nil {
+					return err
+				}
+				if !claimed {
+					log.Printf("Failure email for job %s/%s was already sent; skipping", outcome.JobNamespace, outcome.JobName)
+					return nil
+				}
+
+				subject, body := newEmail(outcome)
+				if err := client.Send(ctx, subject, body); err != nil {
+					if removeErr := r.k8sClient.RemoveJobAnnotation(ctx, k8s.EmailNotificationAnnotation); removeErr != nil {
+						log.Printf("Warning: failed to release email notification claim: %v", removeErr)
+					}
+					return err
+				}
+				return nil
+			},
+		})
+	}
+}
+
+// newEmail formats the outcome as the subject and plain-text body of the failure email
+func newEmail(outcome Outcome) (string, string) {
+	subject := fmt.Sprintf("Validation failed: Job %s/%s (%s)", outcome.JobNamespace, outcome.JobName, outcome.Reason)
+
+	var body strings.Builder
+	line := func(name, value string) {
+		if value != "" {
+			fmt.Fprintf(&body, "%-11s %s\n", name+":", value)
+		}
+	}
+	line("Job", outcome.JobName)
+	line("Namespace", outcome.JobNamespace)
+	line("Pod", outcome.PodName)
+	line("Condition", fmt.Sprintf("%s=%s", outcome.ConditionType, outcome.Status))
+	line("Reason", outcome.Reason)
+	line("Message", outcome.Message)
+	line("Error", outcome.Error)
+	line("Correlation", outcome.CorrelationID)
+	line("Time", outcome.Timestamp.UTC().Format(time.RFC3339))
+	return subject, body.String()
+}
@@ -0,0 +1,82 @@
+package reporter
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// resultWatchDebounce is how long the result file must be quiet after a change before it is
+// checked, so a file written in several chunks is parsed once, after the last write
+const resultWatchDebounce = 100 * time.Millisecond
+
+// watchResultFile watches the directories of paths and signals changed (without blocking) once one
+// of them has been created, written or renamed into place and stayed quiet for resultWatchDebounce.
+// Directories are watched rather than files so the watch works before the adapter creates them.
+// The returned stop function closes the watch.
+func watchResultFile(changed chan<- struct{}, paths ...string) (stop func(), err error) {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create file watcher: %w", err)
+	}
+	targets := make([]string, 0, len(paths))
+	for _, path := range paths {
+		dir := filepath.Dir(path)
+		if !slices.Contains(w.WatchList(), dir) {
+			if err := w.Add(dir); err != nil {
+				_ = w.Close()
+				return nil, fmt.Errorf("failed to watch directory path=%s: %w", dir, err)
+			}
+		}
+		targets = append(targets, filepath.Clean(path))
+	}
+
+	go func() {
+		quiet := time.NewTimer(resultWatchDebounce)
+		quiet.Stop()
+		defer quiet.Stop()
+
+		for {
+			select {
+			case event, ok := <-w.Events:
+				if !ok {
+					return
+				}
+				// The kubelet updates a projected volume by swapping its ..data symlink, leaving the
+				// file symlinks themselves untouched
+				name := filepath.Clean(event.Name)
+				changedFile := matchesAny(targets, name) || filepath.Base(name) == projectedDataDir
+				if changedFile && event.Has(fsnotify.Create|fsnotify.Write) {
+					quiet.Reset(resultWatchDebounce)
+				}
+			case err, ok := <-w.Errors:
+				if !ok {
+					return
+				}
+				log.Printf("Warning: result file watch error: %v", err)
+			case <-quiet.C:
+				select {
+				case changed <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+
+	return func() { _ = w.Close() }, nil
+}
+
+// matchesAny reports whether name matches one of targets. A glob result path matches each of
+// its files; a plain path only itself.
+func matchesAny(targets []string, name string) bool {
+	for _, target := range targets {
+		if matched, _ := filepath.Match(target, name); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package reporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/fleet"
+)
+
+// FleetManagerClient reports validation results to the hyperfleet fleet manager
+type FleetManagerClient interface {
+	ReportClusterValidation(ctx context.Context, report fleet.ValidationReport) error
+}
+
+// WithFleetManager reports the run outcome for clusterID to the fleet manager after the Job status
+// is updated, so no separate controller has to copy the condition into the fleet API. When fatal
+// is true a failed call fails the run; otherwise it is logged and ignored.
+func WithFleetManager(client FleetManagerClient, clusterID string, fatal bool) Option {
+	return func(r *StatusReporter) {
+		r.publishers = append(r.publishers, outcomePublisher{
+			name:  "fleet manager",
+			fatal: fatal,
+			publish: func(ctx context.Context, outcome Outcome) error {
+				return client.ReportClusterValidation(ctx, r.newValidationReport(clusterID, outcome))
+			},
+		})
+	}
+}
+
+// newValidationReport builds the fleet manager report from the outcome and the adapter result details
+func (r *StatusReporter) newValidationReport(clusterID string, outcome Outcome) fleet.ValidationReport {
+	report := fleet.ValidationReport{
+		ClusterID:     clusterID,
+		ConditionType: outcome.ConditionType,
+		Status:        outcome.Status,
+		Reason:        outcome.Reason,
+		Message:       outcome.Message,
+		JobNamespace:  outcome.JobNamespace,
+		JobName:       outcome.JobName,
+		ObservedTime:  outcome.Timestamp,
+	}
+	if r.reportedResult != nil && len(r.reportedResult.Details) > 0 {
+		var details bytes.Buffer
+		if err := json.Compact(&details, r.reportedResult.Details); err == nil {
+			report.DetailsJSON = details.String()
+		}
+	}
+	return report
+}
@@ -0,0 +1,62 @@
+package reporter
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+)
+
+// statResultGlob reports whether the result glob matches enough files to aggregate. While
+// polling, the expected count must be reached, and without one the adapter must be done first,
+// since only then is it known that all files were written; once the adapter is done (final), any
+// match will do. Too few matches are reported as os.ErrNotExist, like a missing result file.
+func (r *StatusReporter) statResultGlob(final bool) ([]string, error) {
+	matches, err := filepath.Glob(r.resultsPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid result glob pattern=%s: %w", r.resultsPath, err)
+	}
+
+	switch {
+	case final:
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no result files matching pattern=%s: %w", r.resultsPath, os.ErrNotExist)
+		}
+	case r.resultGlobExpected < 1:
+		return nil, fmt.Errorf("%d result files matching pattern=%s before the adapter exited: %w", len(matches), r.resultsPath, os.ErrNotExist)
+	case len(matches) < r.resultGlobExpected:
+		return nil, fmt.Errorf("%d of %d result files matching pattern=%s: %w", len(matches), r.resultGlobExpected, r.resultsPath, os.ErrNotExist)
+	}
+	return matches, nil
+}
+
+// parseResultGlob parses every file matching the result glob and aggregates them into one result.
+// An intermediate result in any file is returned as is, so the aggregate waits for all of them.
+// Expected files that never appeared are counted as failures.
+func (r *StatusReporter) parseResultGlob(matches []string) (*result.AdapterResult, error) {
+	files := r.parser.ParseFiles(matches, r.aggregateConcurrency)
+	for _, f := range files {
+		if f.Err == nil && r.isIntermediate(f.Result) {
+			return f.Result, nil
+		}
+	}
+
+	if missing := r.resultGlobExpected - len(matches); missing > 0 {
+		log.Printf("Only %d of %d expected result files were written; counting the missing ones as failures",
+			len(matches), r.resultGlobExpected)
+		for i := range missing {
+			files = append(files, result.FileResult{
+				Path: fmt.Sprintf("missing-%d", i+1),
+				Result: &result.AdapterResult{
+					Status:  result.StatusFailure,
+					Reason:  ReasonAdapterMissingResults,
+					Message: "Expected result file was not written",
+				},
+			})
+		}
+	}
+
+	return result.Aggregate(files)
+}
@@ -0,0 +1,104 @@
+package reporter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+)
+
+const (
+	ReasonHealthCheckPassed = "HealthCheckPassed"
+	ReasonHealthCheckFailed = "HealthCheckFailed"
+
+	// DefaultHealthProbeTimeout bounds a single request to the adapter health endpoint
+	DefaultHealthProbeTimeout = 5 * time.Second
+)
+
+// healthDetails is the result Details of a health probe, carrying the HTTP status code
+type healthDetails struct {
+	StatusCode int `json:"statusCode"`
+}
+
+// pollHealthURL probes the adapter health endpoint every poll interval until it answers 2xx.
+// Connection errors mean the adapter is not serving yet; a non-2xx response is kept as the
+// failure to report if the deadline passes before the adapter becomes healthy.
+func (r *StatusReporter) pollHealthURL(ctx context.Context, channels *pollChannels, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	if !r.quietStartup {
+		log.Printf("Probing adapter health at %s (interval: %s)...", r.healthURL, r.pollInterval)
+	}
+
+	var lastErr string
+	for {
+		adapterResult, err := r.probeHealth(ctx)
+		switch {
+		case err == nil && adapterResult.Status == result.StatusSuccess:
+			select {
+			case channels.result <- adapterResult:
+			case <-channels.done:
+			}
+			return
+		case err == nil:
+			r.lastHealthFailure.Store(adapterResult)
+			err = errors.New(adapterResult.Message)
+		}
+		// Log only changes so a slow-starting adapter does not flood the log
+		if err.Error() != lastErr {
+			lastErr = err.Error()
+			log.Printf("Health probe failed, retrying: %v", err)
+		}
+
+		select {
+		case <-channels.done:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// probeHealth sends one request to the health endpoint and maps the response status onto an
+// adapter result: 2xx is a success, anything else a failure. The status code is recorded in the
+// result details. It returns an error when no response was received.
+func (r *StatusReporter) probeHealth(ctx context.Context) (*result.AdapterResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.healthURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build health probe request url=%s: %w", r.healthURL, err)
+	}
+
+	resp, err := r.healthClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	details, err := json.Marshal(healthDetails{StatusCode: resp.StatusCode})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode health probe details: %w", err)
+	}
+	adapterResult := &result.AdapterResult{
+		Status:  result.StatusFailure,
+		Reason:  ReasonHealthCheckFailed,
+		Message: fmt.Sprintf("Adapter health probe returned HTTP %d", resp.StatusCode),
+		Details: details,
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		adapterResult.Status = result.StatusSuccess
+		adapterResult.Reason = ReasonHealthCheckPassed
+	}
+	return adapterResult, nil
+}
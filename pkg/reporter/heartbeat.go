@@ -0,0 +1,70 @@
+package reporter
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// heartbeatReleaseTimeout bounds releasing the heartbeat on exit, so an unreachable API server
+// cannot hold up the reporter after the status was reported
+const heartbeatReleaseTimeout = 10 * time.Second
+
+// HeartbeatClient maintains a liveness record of the reporter, such as a Lease
+type HeartbeatClient interface {
+	Renew(ctx context.Context) error
+	Release(ctx context.Context) error
+}
+
+// WithHeartbeat renews the heartbeat when the run starts and on every poll interval until the run
+// ends, then releases it, so controllers can tell a reporter still waiting for the adapter from
+// one that died. Heartbeat failures are logged and do not affect the run.
+func WithHeartbeat(client HeartbeatClient) Option {
+	return func(r *StatusReporter) {
+		r.heartbeat = client
+	}
+}
+
+// startHeartbeat renews the heartbeat now and then every poll interval in the background. The
+// returned function stops the renewals and releases the heartbeat.
+func (r *StatusReporter) startHeartbeat(ctx context.Context) func() {
+	if r.heartbeat == nil {
+		return func() {}
+	}
+	r.renewHeartbeat(ctx)
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(r.pollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.renewHeartbeat(ctx)
+			}
+		}
+	}()
+
+	return func() {
+		close(stop)
+		<-done
+		releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), heartbeatReleaseTimeout)
+		defer cancel()
+		if err := r.heartbeat.Release(releaseCtx); err != nil {
+			log.Printf("Warning: failed to release heartbeat: %v", err)
+		}
+	}
+}
+
+// renewHeartbeat renews the heartbeat, logging failures
+func (r *StatusReporter) renewHeartbeat(ctx context.Context) {
+	if err := r.heartbeat.Renew(ctx); err != nil {
+		log.Printf("Warning: failed to renew heartbeat: %v", err)
+	}
+}
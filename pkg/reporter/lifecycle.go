@@ -0,0 +1,71 @@
+package reporter
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
+)
+
+const (
+	// ProgressingConditionSuffix is appended to the condition type to name the default progressing
+	// condition, e.g. "AvailableProgressing"
+	ProgressingConditionSuffix = "Progressing"
+
+	// ReasonAdapterFinished is the reason of the progressing condition once the outcome is reported
+	ReasonAdapterFinished = "AdapterFinished"
+)
+
+// WithProgressingCondition sets a progressing condition to True when the run starts and to False
+// together with the terminal condition, so watchers can tell an adapter still running from one
+// that never started. conditionType names the condition; when empty it is the condition type
+// followed by ProgressingConditionSuffix.
+func WithProgressingCondition(enabled bool, conditionType string) Option {
+	return func(r *StatusReporter) {
+		r.progressing = enabled
+		r.progressingType = conditionType
+	}
+}
+
+// progressingConditionType returns the type of the progressing condition
+func (r *StatusReporter) progressingConditionType() string {
+	if r.progressingType != "" {
+		return r.progressingType
+	}
+	return r.conditionType + ProgressingConditionSuffix
+}
+
+// startProgressing sets the progressing condition to True at the start of a run. Failures are
+// logged; the final report does not depend on it.
+func (r *StatusReporter) startProgressing(ctx context.Context) {
+	if !r.progressing {
+		return
+	}
+	condition := k8s.JobCondition{
+		Type:    r.progressingConditionType(),
+		Status:  ConditionStatusTrue,
+		Reason:  ReasonAdapterRunning,
+		Message: fmt.Sprintf("Waiting up to %s for the adapter result", r.maxWaitTime),
+	}
+
+	r.statusMu.Lock()
+	defer r.statusMu.Unlock()
+	if err := r.writeConditions(ctx, []k8s.JobCondition{condition}); err != nil {
+		log.Printf("Warning: failed to set %s condition: %v", condition.Type, err)
+	}
+}
+
+// finishedProgressing returns the progressing condition set to False along with the terminal
+// condition, or nothing when the progressing condition is disabled
+func (r *StatusReporter) finishedProgressing(terminal k8s.JobCondition) []k8s.JobCondition {
+	if !r.progressing {
+		return nil
+	}
+	return []k8s.JobCondition{{
+		Type:    r.progressingConditionType(),
+		Status:  ConditionStatusFalse,
+		Reason:  ReasonAdapterFinished,
+		Message: fmt.Sprintf("Adapter finished: %s=%s (%s)", terminal.Type, terminal.Status, terminal.Reason),
+	}}
+}
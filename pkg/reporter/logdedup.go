@@ -0,0 +1,52 @@
+package reporter
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// dedupLogger collapses repeated identical log messages. The first occurrence is logged, further
+// repeats are counted and summarized at most once per interval, so a persistent failure (e.g. missing
+// RBAC) doesn't flood the logs. A zero interval disables deduplication. Not safe for concurrent use.
+type dedupLogger struct {
+	interval    time.Duration
+	last        string
+	repeats     int
+	windowStart time.Time
+}
+
+// Printf logs the formatted message unless it repeats the previous one within the summary interval
+func (d *dedupLogger) Printf(format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	if d.interval <= 0 {
+		log.Print(msg)
+		return
+	}
+
+	now := time.Now()
+	if msg != d.last {
+		d.Flush()
+		log.Print(msg)
+		d.last = msg
+		d.windowStart = now
+		return
+	}
+
+	d.repeats++
+	if now.Sub(d.windowStart) >= d.interval {
+		log.Printf("%s (repeated %d more times in the last %s)", msg, d.repeats, now.Sub(d.windowStart).Round(time.Second))
+		d.repeats = 0
+		d.windowStart = now
+	}
+}
+
+// Flush logs a summary of unreported repeats and forgets the previous message, so the next
+// occurrence is logged in full (e.g. after the failing operation has recovered)
+func (d *dedupLogger) Flush() {
+	if d.repeats > 0 {
+		log.Printf("Previous message repeated %d more times in the last %s", d.repeats, time.Since(d.windowStart).Round(time.Second))
+	}
+	d.last = ""
+	d.repeats = 0
+}
@@ -0,0 +1,45 @@
+package reporter
+
+import (
+	"context"
+)
+
+const (
+	// Message buses supported by WithMessageBus; Kafka is reached through a Kafka REST Proxy, not
+	// the Kafka wire protocol
+	MessageBusNATS      = "nats"
+	MessageBusKafkaREST = "kafka-rest"
+)
+
+// KafkaRecords is the request body of a Kafka REST Proxy produce request
+type KafkaRecords struct {
+	Records []KafkaRecord `json:"records"`
+}
+
+// KafkaRecord is one record of a Kafka REST Proxy produce request
+type KafkaRecord struct {
+	Key   string `json:"key"`
+	Value any    `json:"value"`
+}
+
+// WithMessageBus publishes the run outcome, with the adapter result when there is one, to a
+// message bus (kind is MessageBusNATS or MessageBusKafkaREST). Kafka records go through a REST Proxy
+// and are keyed by "<namespace>/<job>", so all runs of a Job land on the same partition.
+// Delivery is best-effort: the client retries, and a final failure is logged and ignored.
+func WithMessageBus(client CallbackClient, kind string) Option {
+	return func(r *StatusReporter) {
+		r.publishers = append(r.publishers, outcomePublisher{
+			name: "message bus",
+			publish: func(ctx context.Context, outcome Outcome) error {
+				var payload any = CallbackPayload{Outcome: outcome, Result: r.reportedResult}
+				if kind == MessageBusKafkaREST {
+					payload = KafkaRecords{Records: []KafkaRecord{{
+						Key:   outcome.JobNamespace + "/" + outcome.JobName,
+						Value: payload,
+					}}}
+				}
+				return client.Post(ctx, payload)
+			},
+		})
+	}
+}
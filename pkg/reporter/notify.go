@@ -0,0 +1,83 @@
+package reporter
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	// Incoming-webhook formats of the notification sink
+	NotifyFormatSlack = "slack"
+	NotifyFormatTeams = "teams"
+
+	// Teams message card colors
+	teamsColorFailure = "D13438"
+	teamsColorSuccess = "2EB886"
+)
+
+// SlackMessage is the request body of a Slack incoming webhook
+type SlackMessage struct {
+	Text string `json:"text"`
+}
+
+// TeamsMessageCard is the request body of a Microsoft Teams incoming webhook
+type TeamsMessageCard struct {
+	Type       string `json:"@type"`
+	Context    string `json:"@context"`
+	Summary    string `json:"summary"`
+	ThemeColor string `json:"themeColor"`
+	Title      string `json:"title"`
+	Text       string `json:"text"`
+}
+
+// WithNotification posts a human-readable message with the Job, namespace, status and reason to a
+// Slack or Teams incoming webhook (format is NotifyFormatSlack or NotifyFormatTeams) when the run
+// failed, and also on success when onSuccess is set. Delivery is best-effort: failures are logged
+// and ignored.
+func WithNotification(client CallbackClient, format string, onSuccess bool) Option {
+	return func(r *StatusReporter) {
+		r.publishers = append(r.publishers, outcomePublisher{
+			name: "notification",
+			publish: func(ctx context.Context, outcome Outcome) error {
+				if !outcomeFailed(outcome) && !onSuccess {
+					return nil
+				}
+				return client.Post(ctx, newNotification(outcome, format))
+			},
+		})
+	}
+}
+
+// outcomeFailed reports whether the run did not end with a True condition
+func outcomeFailed(outcome Outcome) bool {
+	return outcome.Error != "" || outcome.Status != ConditionStatusTrue
+}
+
+// newNotification formats the outcome as a message in the webhook format
+func newNotification(outcome Outcome, format string) any {
+	result := "succeeded"
+	if outcomeFailed(outcome) {
+		result = "failed"
+	}
+	title := fmt.Sprintf("Validation %s: Job %s/%s", result, outcome.JobNamespace, outcome.JobName)
+	details := fmt.Sprintf("%s=%s (reason: %s): %s", outcome.ConditionType, outcome.Status, outcome.Reason, outcome.Message)
+	if outcome.Error != "" {
+		details = fmt.Sprintf("%s; error: %s", details, outcome.Error)
+	}
+
+	if format == NotifyFormatTeams {
+		color := teamsColorSuccess
+		if outcomeFailed(outcome) {
+			color = teamsColorFailure
+		}
+		return TeamsMessageCard{
+			Type:       "MessageCard",
+			Context:    "https://schema.org/extensions",
+			Summary:    title,
+			ThemeColor: color,
+			Title:      title,
+			Text:       details,
+		}
+	}
+	return SlackMessage{Text: fmt.Sprintf("*%s*\n%s", title, details)}
+}
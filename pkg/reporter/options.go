@@ -0,0 +1,549 @@
+package reporter
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+)
+
+// Option configures optional StatusReporter behavior
+type Option func(*StatusReporter)
+
+// WithSingleAdapter caches the auto-detected adapter container name after the first
+// successful lookup, for pods that run exactly one adapter container
+func WithSingleAdapter(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.singleAdapter = enabled
+	}
+}
+
+// WithParserOptions configures the result parser used to read the adapter result
+func WithParserOptions(opts ...result.ParserOption) Option {
+	return func(r *StatusReporter) {
+		r.parserOptions = append(r.parserOptions, opts...)
+	}
+}
+
+// WithJobReference records the Job being reported on, used to identify the run in published outcomes
+func WithJobReference(jobName, jobNamespace string) Option {
+	return func(r *StatusReporter) {
+		r.jobName = jobName
+		r.jobNamespace = jobNamespace
+	}
+}
+
+// WithCallback POSTs the run outcome to an HTTP callback after the Job status is updated.
+// When fatal is true a failed callback fails the run; otherwise it is logged and ignored.
+func WithCallback(client CallbackClient, fatal bool) Option {
+	return func(r *StatusReporter) {
+		r.publishers = append(r.publishers, outcomePublisher{
+			name:  "outcome callback",
+			fatal: fatal,
+			publish: func(ctx context.Context, outcome Outcome) error {
+				if r.callbackIncludeResult {
+					return client.Post(ctx, CallbackPayload{Outcome: outcome, Result: r.reportedResult})
+				}
+				return client.Post(ctx, outcome)
+			},
+		})
+	}
+}
+
+// WithCallbackResult adds the full adapter result, including its details, to the callback
+// payload as a CallbackPayload
+func WithCallbackResult(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.callbackIncludeResult = enabled
+	}
+}
+
+// WithCloudEvents POSTs the run outcome as a structured-mode CloudEvent after the Job status is
+// updated. The client should send CloudEventsContentType. When fatal is true a failed delivery
+// fails the run; otherwise it is logged and ignored.
+func WithCloudEvents(client CallbackClient, fatal bool) Option {
+	return func(r *StatusReporter) {
+		r.publishers = append(r.publishers, outcomePublisher{
+			name:  "CloudEvents sink",
+			fatal: fatal,
+			publish: func(ctx context.Context, outcome Outcome) error {
+				event, err := newOutcomeEvent(outcome)
+				if err != nil {
+					return err
+				}
+				return client.Post(ctx, event)
+			},
+		})
+	}
+}
+
+// WithBinaryCloudEvents POSTs the run outcome and adapter result as a binary-mode CloudEvent of
+// type CloudEventResultType after the Job status is updated, for brokers such as Knative and Argo
+// Events. The client must send Message headers. When fatal is true a failed delivery fails the
+// run; otherwise it is logged and ignored.
+func WithBinaryCloudEvents(client CallbackClient, fatal bool) Option {
+	return func(r *StatusReporter) {
+		r.publishers = append(r.publishers, outcomePublisher{
+			name:  "CloudEvents sink",
+			fatal: fatal,
+			publish: func(ctx context.Context, outcome Outcome) error {
+				event, err := newResultEvent(outcome, r.reportedResult)
+				if err != nil {
+					return err
+				}
+				return client.Post(ctx, event)
+			},
+		})
+	}
+}
+
+// WithOTLPLogs exports the run outcome as an OTLP log record (OTLP/HTTP with JSON encoding) after
+// the Job status is updated. Delivery is best-effort: failures are logged and ignored.
+func WithOTLPLogs(client CallbackClient, serviceName string) Option {
+	return func(r *StatusReporter) {
+		r.publishers = append(r.publishers, outcomePublisher{
+			name: "OTLP logs",
+			publish: func(ctx context.Context, outcome Outcome) error {
+				return client.Post(ctx, newOutcomeLogRecord(outcome, serviceName))
+			},
+		})
+	}
+}
+
+// WithCommitStatus POSTs the run outcome as a commit status to a GitHub-compatible statuses API
+// after the Job status is updated. Delivery is best-effort: failures are logged and ignored.
+func WithCommitStatus(client CallbackClient) Option {
+	return func(r *StatusReporter) {
+		r.publishers = append(r.publishers, outcomePublisher{
+			name: "commit status",
+			publish: func(ctx context.Context, outcome Outcome) error {
+				return client.Post(ctx, newCommitStatus(outcome))
+			},
+		})
+	}
+}
+
+// WithAggregator records the run outcome in the status of an aggregator resource, keyed by pod name,
+// after the Job status is updated. Delivery is best-effort: failures are logged and ignored.
+func WithAggregator(client AggregatorClient) Option {
+	return func(r *StatusReporter) {
+		r.publishers = append(r.publishers, outcomePublisher{
+			name:  "aggregator",
+			retry: &r.sinkRetry,
+			publish: func(ctx context.Context, outcome Outcome) error {
+				return client.PatchRun(ctx, outcome.PodName, outcome)
+			},
+		})
+	}
+}
+
+// WithOutcomeHook calls fn with the run outcome after the Job status is updated, for callers that
+// act on the outcome in-process
+func WithOutcomeHook(fn func(Outcome)) Option {
+	return func(r *StatusReporter) {
+		r.publishers = append(r.publishers, outcomePublisher{
+			name: "outcome hook",
+			publish: func(ctx context.Context, outcome Outcome) error {
+				fn(outcome)
+				return nil
+			},
+		})
+	}
+}
+
+// WithOutcomeSocket writes the run outcome as a JSON line to a unix domain socket after the
+// Job status is updated. When strict is true a delivery failure fails the run; otherwise it is logged.
+func WithOutcomeSocket(socketPath string, strict bool) Option {
+	return func(r *StatusReporter) {
+		if socketPath == "" {
+			return
+		}
+		r.publishers = append(r.publishers, outcomePublisher{
+			name:  "outcome socket",
+			retry: &r.sinkRetry,
+			fatal: strict,
+			publish: func(ctx context.Context, outcome Outcome) error {
+				return writeOutcomeToSocket(ctx, socketPath, outcome)
+			},
+		})
+	}
+}
+
+// WithMaxResultAge ignores result files last modified more than maxAge before the reporter started
+func WithMaxResultAge(maxAge time.Duration) Option {
+	return func(r *StatusReporter) {
+		r.maxResultAge = maxAge
+	}
+}
+
+// WithCheckOnContainerChange triggers an immediate result file check whenever the container
+// monitor observes a change in the adapter container status, instead of waiting for the next poll tick
+func WithCheckOnContainerChange(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.checkOnContainerChange = enabled
+	}
+}
+
+// WithK8sClientOptions configures the Kubernetes client created by NewReporter
+func WithK8sClientOptions(opts ...k8s.ClientOption) Option {
+	return func(r *StatusReporter) {
+		r.k8sClientOptions = append(r.k8sClientOptions, opts...)
+	}
+}
+
+// WithQuietStartup suppresses the startup banner and polling/monitoring start messages
+func WithQuietStartup(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.quietStartup = enabled
+	}
+}
+
+// WithExpectFailure inverts adapter results for negative-test Jobs where the adapter should fail
+func WithExpectFailure(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.expectFailure = enabled
+	}
+}
+
+// WithDebounceTermination requires the adapter container to be observed as terminated on two
+// consecutive status checks before acting, filtering out transient states between restarts
+func WithDebounceTermination(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.debounceTermination = enabled
+	}
+}
+
+// WithConfirmSuccessStable holds a success result until the adapter container has exited with code 0
+// and the result file is unchanged, so a flaky adapter that reports success and then fails is not
+// declared successful prematurely
+func WithConfirmSuccessStable(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.confirmSuccessStable = enabled
+	}
+}
+
+// WithInitialStatusRetry bounds the retries of the first container status lookup, which commonly
+// fails while the pod's container statuses are still being populated
+func WithInitialStatusRetry(retries int, delay time.Duration) Option {
+	return func(r *StatusReporter) {
+		r.initialStatusRetries = retries
+		r.initialStatusRetryDelay = delay
+	}
+}
+
+// WithLogDedupInterval collapses repeated identical container monitor warnings into a summary
+// logged at most once per interval; zero logs every occurrence
+func WithLogDedupInterval(interval time.Duration) Option {
+	return func(r *StatusReporter) {
+		r.monitorLog.interval = interval
+	}
+}
+
+// WithNonTerminalReasons treats results with any of the given reasons as intermediate: they are
+// logged but the reporter keeps waiting for a terminal result, container exit or timeout
+func WithNonTerminalReasons(reasons ...string) Option {
+	return func(r *StatusReporter) {
+		if len(reasons) == 0 {
+			return
+		}
+		if r.nonTerminalReasons == nil {
+			r.nonTerminalReasons = make(map[string]bool, len(reasons))
+		}
+		for _, reason := range reasons {
+			r.nonTerminalReasons[reason] = true
+		}
+	}
+}
+
+// WithTimingOutput writes the run's phase timestamps and durations as JSON to path at the end of each run
+func WithTimingOutput(path string) Option {
+	return func(r *StatusReporter) {
+		r.timingOutputPath = path
+	}
+}
+
+// WithActiveDeadlineCheck compares the max wait time with the Job's activeDeadlineSeconds at startup.
+// mode is ActiveDeadlineCheckOff, ActiveDeadlineCheckWarn (log a warning) or ActiveDeadlineCheckStrict
+// (fail the run).
+func WithActiveDeadlineCheck(mode string) Option {
+	return func(r *StatusReporter) {
+		r.activeDeadlineCheck = mode
+	}
+}
+
+// WithDegradedRule downgrades success results whose details match the rule; nil leaves the
+// top-level status authoritative
+func WithDegradedRule(rule *DegradedRule) Option {
+	return func(r *StatusReporter) {
+		r.degradedRule = rule
+	}
+}
+
+// WithExitOnPodTerminating makes the container monitor check the pod's deletion timestamp, read
+// from the same pod GET as the container status, and, once the pod is being deleted, perform one
+// final best-effort status update and exit
+func WithExitOnPodTerminating(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.exitOnPodTerminating = enabled
+	}
+}
+
+// WithResultParseSettleDelay retries the first failed parse of the result file once after delay,
+// since a file that fails to parse when first found is most likely still being written
+func WithResultParseSettleDelay(delay time.Duration) Option {
+	return func(r *StatusReporter) {
+		r.parseSettleDelay = delay
+	}
+}
+
+// WithTimeoutGrowthGrace extends the deadline by up to grace while the result file is still
+// growing when the timeout fires, so a slow write of a large result is parsed rather than
+// reported as AdapterTimeout. Zero disables the check.
+func WithTimeoutGrowthGrace(grace time.Duration) Option {
+	return func(r *StatusReporter) {
+		r.timeoutGrowthGrace = grace
+	}
+}
+
+// WithResultFileWatch checks the result file as soon as a filesystem notification reports it was
+// written, instead of waiting for the next poll. Polling continues as the fallback.
+func WithResultFileWatch(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.watchResultFile = enabled
+	}
+}
+
+// WithResultGlob treats the result path as a glob matching the files of several adapters and
+// reports them as one aggregated result: a success only if all succeeded. Polling waits until
+// expected files match; when expected is below 1, the files present once the adapter container
+// exits are aggregated. Once the adapter container exits or the timeout is reached, the files
+// written so far are aggregated and missing ones count as failures.
+func WithResultGlob(expected int) Option {
+	return func(r *StatusReporter) {
+		r.resultGlob = true
+		r.resultGlobExpected = expected
+	}
+}
+
+// WithAggregateConcurrency bounds how many result files WithResultGlob and WithResultChecks parse
+// at once; the aggregated result does not depend on the order in which parses finish
+func WithAggregateConcurrency(concurrency int) Option {
+	return func(r *StatusReporter) {
+		r.aggregateConcurrency = concurrency
+	}
+}
+
+// WithResultChecks reads one result file per check from the results directory dir, reporting each
+// file on its own condition type. The configured condition gets the aggregate of all checks, as
+// with WithResultGlob; polling waits until every check file is present, and checks without a file
+// when the adapter exits or the timeout is reached are reported as failures. An empty check file
+// counts as not yet written.
+func WithResultChecks(dir string, checks ...ResultCheck) Option {
+	return func(r *StatusReporter) {
+		r.resultChecksDir = dir
+		r.resultChecks = append(r.resultChecks, checks...)
+	}
+}
+
+// WithResultStream reads the result file as newline-delimited JSON records appended by the adapter.
+// Records are progress until one is marked "final": true; with progressUpdates, each new progress
+// record sets the condition to Unknown with its reason and message.
+func WithResultStream(progressUpdates bool) Option {
+	return func(r *StatusReporter) {
+		r.resultStream = true
+		r.progressUpdates = progressUpdates
+		r.parserOptions = append(r.parserOptions, result.WithNDJSON(true))
+	}
+}
+
+// WithInProgressCondition sets the condition to Unknown with ReasonAdapterRunning when the reporter
+// starts waiting for the adapter, until the outcome is reported
+func WithInProgressCondition(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.reportRunning = enabled
+	}
+}
+
+// WithTimeoutStatus sets the condition status reported when the adapter produces no result within
+// the max wait time: ConditionStatusFalse (the default) or ConditionStatusUnknown, for consumers
+// that treat a timeout as an undetermined outcome rather than a failure
+func WithTimeoutStatus(status string) Option {
+	return func(r *StatusReporter) {
+		r.timeoutStatus = status
+	}
+}
+
+// WithRequiredResultChecksum uses the checksum file at the result path plus suffix, which the
+// adapter writes last, as the signal that the result file is complete: the result file is not
+// parsed until the checksum file exists, and then only if its SHA-256 digest matches
+func WithRequiredResultChecksum(suffix string) Option {
+	return func(r *StatusReporter) {
+		r.checksumSuffix = suffix
+		r.parserOptions = append(r.parserOptions, result.WithChecksumVerification(suffix, true))
+	}
+}
+
+// WithDoneFile parses the result only once the adapter has created the marker file at path, so a
+// result file that is written in place rather than renamed is never read half-written
+func WithDoneFile(path string) Option {
+	return func(r *StatusReporter) {
+		r.doneFile = path
+	}
+}
+
+// WithProjectedVolume reads the result from a ConfigMap, downward API or projected volume that is
+// updated after the pod starts: an empty result is treated as not yet written, the watch reacts to
+// the kubelet's atomic swap of the volume content, and the result is read from a single version of it
+func WithProjectedVolume(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.projectedVolume = enabled
+	}
+}
+
+// WithResultHTTP accepts the adapter result as a POST to /result on addr, for adapters that cannot
+// share a volume with the reporter. File polling continues; whichever result arrives first wins.
+func WithResultHTTP(addr string) Option {
+	return func(r *StatusReporter) {
+		r.resultHTTPAddr = addr
+	}
+}
+
+// WithResultHTTPToken requires results posted to the WithResultHTTP address to carry the token in
+// tokenFile as a bearer token. The file is re-read on every request so a rotated Secret applies.
+func WithResultHTTPToken(tokenFile string) Option {
+	return func(r *StatusReporter) {
+		r.resultHTTPTokenFile = tokenFile
+	}
+}
+
+// WithResultSocket accepts the adapter result as an HTTP POST to /result over a unix domain socket
+// at path (e.g. in the shared results volume). The adapter gets an immediate acknowledgement and a
+// result is never read half-written. File polling continues; whichever result arrives first wins.
+func WithResultSocket(path string) Option {
+	return func(r *StatusReporter) {
+		r.resultSocketPath = path
+	}
+}
+
+// WithTerminationMessageResult parses the adapter container's termination message as the result
+// when the adapter exits without a result file, so adapters writing to /dev/termination-log are
+// reported from their own result rather than their exit code
+func WithTerminationMessageResult(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.useTerminationMessage = enabled
+	}
+}
+
+// WithHealthProbe polls the adapter's HTTP health endpoint as a result source: the first 2xx
+// response reports a success, and a non-2xx response still answering at the deadline reports a
+// failure. With replaceFilePolling the result file is not polled; otherwise whichever source
+// signals first wins.
+func WithHealthProbe(url string, replaceFilePolling bool) Option {
+	return func(r *StatusReporter) {
+		r.healthURL = url
+		r.healthOnly = url != "" && replaceFilePolling
+		if url != "" {
+			r.healthClient = &http.Client{Timeout: DefaultHealthProbeTimeout}
+		}
+	}
+}
+
+// WithParseFailureNote notes in the reported message when the fallback to the container exit code
+// was caused by a result file that was present but could not be parsed, including the parse error
+func WithParseFailureNote(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.noteParseFailure = enabled
+	}
+}
+
+// WithCleanupFailurePolicy sets how an adapter that writes a success result and then exits non-zero
+// is reported. CleanupFailurePolicyIgnore keeps the success; CleanupFailurePolicyEscalate holds the
+// success for up to gracePeriod and reports a failure if the container exits non-zero meanwhile.
+func WithCleanupFailurePolicy(policy string, gracePeriod time.Duration) Option {
+	return func(r *StatusReporter) {
+		r.cleanupFailurePolicy = policy
+		r.cleanupGracePeriod = gracePeriod
+	}
+}
+
+// WithMemoryLimitReport includes the adapter container's memory limit from the pod spec in the
+// message reported when the container is OOMKilled
+func WithMemoryLimitReport(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.reportMemoryLimit = enabled
+	}
+}
+
+// WithDeadlinePolling polls for the result file four times as often during the final 10% of the
+// max wait time, so a result written just before the timeout is picked up promptly
+func WithDeadlinePolling(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.deadlinePolling = enabled
+	}
+}
+
+// WithMessageKVSuffix appends a compact " [key=value ...]" segment with the given keys
+// (MessageKVStatus, MessageKVReason, MessageKVElapsed) to every reported message, in order
+func WithMessageKVSuffix(keys ...string) Option {
+	return func(r *StatusReporter) {
+		r.messageKVKeys = keys
+	}
+}
+
+// WithStopPollingOnTermination stops the result file poller as soon as the container monitor sees
+// the adapter terminate without a result file, since the file can no longer appear
+func WithStopPollingOnTermination(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.stopPollingOnTermination = enabled
+	}
+}
+
+// WithMinFailureSeverity reports failures whose severity ranks below the given level (see
+// result.Severities) as Unknown instead of False. Failures without a severity remain False.
+func WithMinFailureSeverity(severity string) Option {
+	return func(r *StatusReporter) {
+		r.minFailureSeverity = severity
+	}
+}
+
+// WithAdapterImageAnnotation records the adapter container's image (by digest when the pod status
+// resolves one) in the k8s.AdapterImageAnnotation Job annotation after the condition is set
+func WithAdapterImageAnnotation(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.recordAdapterImage = enabled
+	}
+}
+
+// WithRestartCountAnnotation records the adapter container's restart count in the
+// k8s.RestartCountAnnotation Job annotation after the condition is set
+func WithRestartCountAnnotation(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.recordRestarts = enabled
+	}
+}
+
+// WithPodCondition also sets the reported condition on the reporter's own pod, so readiness gates
+// and controllers watching pods can react without resolving the Job
+func WithPodCondition(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.publishPodCondition = enabled
+	}
+}
+
+// WithConditionRoutes routes adapter results to a condition type by reason; the first matching
+// route wins and results matching none use the configured condition type
+func WithConditionRoutes(routes ...ConditionRoute) Option {
+	return func(r *StatusReporter) {
+		r.conditionRoutes = append(r.conditionRoutes, routes...)
+	}
+}
+
+// WithPodNamePrefix treats the pod name as a prefix; at the start of each run the single pod in
+// the namespace whose name starts with it is selected, failing the run if none or several match
+func WithPodNamePrefix(enabled bool) Option {
+	return func(r *StatusReporter) {
+		r.podNameIsPrefix = enabled
+	}
+}
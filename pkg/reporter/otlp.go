@@ -0,0 +1,107 @@
+package reporter
+
+import (
+	"strconv"
+)
+
+// OTLP log severity numbers used for the outcome record
+const (
+	otlpSeverityInfo  = 9
+	otlpSeverityWarn  = 13
+	otlpSeverityError = 17
+
+	otlpScopeName = "github.com/openshift-hyperfleet/status-reporter"
+)
+
+// OTLPLogsRequest is the OTLP/HTTP JSON body of a logs export request
+type OTLPLogsRequest struct {
+	ResourceLogs []OTLPResourceLogs `json:"resourceLogs"`
+}
+
+// OTLPResourceLogs groups log records by the resource that emitted them
+type OTLPResourceLogs struct {
+	Resource  OTLPResource    `json:"resource"`
+	ScopeLogs []OTLPScopeLogs `json:"scopeLogs"`
+}
+
+// OTLPResource describes the emitting process
+type OTLPResource struct {
+	Attributes []OTLPAttribute `json:"attributes"`
+}
+
+// OTLPScopeLogs groups log records by instrumentation scope
+type OTLPScopeLogs struct {
+	Scope      OTLPScope       `json:"scope"`
+	LogRecords []OTLPLogRecord `json:"logRecords"`
+}
+
+// OTLPScope names the instrumentation scope
+type OTLPScope struct {
+	Name string `json:"name"`
+}
+
+// OTLPLogRecord is a single log record
+type OTLPLogRecord struct {
+	TimeUnixNano   string          `json:"timeUnixNano"`
+	SeverityNumber int             `json:"severityNumber"`
+	SeverityText   string          `json:"severityText"`
+	Body           OTLPValue       `json:"body"`
+	Attributes     []OTLPAttribute `json:"attributes"`
+}
+
+// OTLPAttribute is a key/value attribute
+type OTLPAttribute struct {
+	Key   string    `json:"key"`
+	Value OTLPValue `json:"value"`
+}
+
+// OTLPValue is a string-typed OTLP AnyValue
+type OTLPValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+// newOutcomeLogRecord wraps the outcome in an OTLP logs export request. The Job and pod are
+// resource attributes; the condition is carried in the record attributes and the message is the body.
+func newOutcomeLogRecord(outcome Outcome, serviceName string) OTLPLogsRequest {
+	severity, severityText := otlpSeverityInfo, "INFO"
+	switch {
+	case outcome.Error != "":
+		severity, severityText = otlpSeverityError, "ERROR"
+	case outcome.Status != ConditionStatusTrue:
+		severity, severityText = otlpSeverityWarn, "WARN"
+	}
+
+	attributes := []OTLPAttribute{
+		otlpAttribute("hyperfleet.condition.type", outcome.ConditionType),
+		otlpAttribute("hyperfleet.condition.status", outcome.Status),
+		otlpAttribute("hyperfleet.condition.reason", outcome.Reason),
+	}
+	if outcome.Error != "" {
+		attributes = append(attributes, otlpAttribute("error.message", outcome.Error))
+	}
+
+	return OTLPLogsRequest{
+		ResourceLogs: []OTLPResourceLogs{{
+			Resource: OTLPResource{Attributes: []OTLPAttribute{
+				otlpAttribute("service.name", serviceName),
+				otlpAttribute("k8s.namespace.name", outcome.JobNamespace),
+				otlpAttribute("k8s.job.name", outcome.JobName),
+				otlpAttribute("k8s.pod.name", outcome.PodName),
+			}},
+			ScopeLogs: []OTLPScopeLogs{{
+				Scope: OTLPScope{Name: otlpScopeName},
+				LogRecords: []OTLPLogRecord{{
+					TimeUnixNano:   strconv.FormatInt(outcome.Timestamp.UnixNano(), 10),
+					SeverityNumber: severity,
+					SeverityText:   severityText,
+					Body:           OTLPValue{StringValue: outcome.Message},
+					Attributes:     attributes,
+				}},
+			}},
+		}},
+	}
+}
+
+func otlpAttribute(key, value string) OTLPAttribute {
+	return OTLPAttribute{Key: key, Value: OTLPValue{StringValue: value}}
+}
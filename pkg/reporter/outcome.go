@@ -0,0 +1,220 @@
+package reporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+)
+
+// outcomeSocketTimeout bounds connecting and writing to the outcome socket
+const outcomeSocketTimeout = 5 * time.Second
+
+// Outcome describes the final condition reported for a run
+type Outcome struct {
+	JobName       string    `json:"jobName"`
+	JobNamespace  string    `json:"jobNamespace"`
+	PodName       string    `json:"podName"`
+	ConditionType string    `json:"conditionType"`
+	Status        string    `json:"status"`
+	Reason        string    `json:"reason"`
+	Message       string    `json:"message"`
+	Error         string    `json:"error,omitempty"`
+	Timestamp     time.Time `json:"timestamp"`
+
+	// CorrelationID and ObservedGeneration identify the reconcile attempt the condition answers,
+	// when set with WithCorrelation or by the adapter result
+	CorrelationID      string `json:"correlationId,omitempty"`
+	ObservedGeneration int64  `json:"observedGeneration,omitempty"`
+
+	// Conditions are the Job conditions written by the primary sink: the reported condition
+	// followed by any additional conditions returned by the adapter
+	Conditions []k8s.JobCondition `json:"-"`
+}
+
+// CallbackPayload is the callback request body with WithCallbackResult: the outcome fields plus the
+// adapter result the condition was reported from, when there is one
+type CallbackPayload struct {
+	Outcome
+	Result *result.AdapterResult `json:"result,omitempty"`
+}
+
+// CallbackClient delivers the run outcome to an external HTTP endpoint
+type CallbackClient interface {
+	Post(ctx context.Context, payload any) error
+}
+
+// AggregatorClient records the run outcome in a fleet-level aggregator resource, keyed per run
+type AggregatorClient interface {
+	PatchRun(ctx context.Context, key string, run any) error
+}
+
+// TerminalConditionWritten reports whether the run has written its terminal condition. It is
+// safe to call from another goroutine, e.g. after recovering from a panic of the run.
+func (r *StatusReporter) TerminalConditionWritten() bool {
+	return r.terminalWritten.Load()
+}
+
+// updateJobStatus sends the condition, along with any additional conditions returned by the
+// adapter, to the Job and remembers it as the run outcome
+func (r *StatusReporter) updateJobStatus(ctx context.Context, condition k8s.JobCondition, additional ...k8s.JobCondition) error {
+	if suffix := r.messageKVSuffix(condition); suffix != "" {
+		condition.Message = result.FitMessage(condition.Message, suffix)
+	}
+	r.reportedCondition = &condition
+	r.reportedResult = nil
+	r.statusMu.Lock()
+	r.finalReported = true
+	conditions := append([]k8s.JobCondition{condition}, additional...)
+	err := r.writeConditions(ctx, append(conditions, r.finishedProgressing(condition)...))
+	r.statusMu.Unlock()
+	if err != nil {
+		return err
+	}
+	r.terminalWritten.Store(true)
+	r.phases.mark(&r.phases.reported)
+	if r.publishPodCondition {
+		r.updatePodCondition(ctx, condition)
+	}
+	if r.recordAdapterImage || r.recordRestarts {
+		r.annotateAdapterContainer(ctx)
+	}
+	r.annotateCorrelation(ctx)
+	return nil
+}
+
+// writeConditions writes the conditions through the primary sink. The first condition is the
+// reported one.
+func (r *StatusReporter) writeConditions(ctx context.Context, conditions []k8s.JobCondition) error {
+	outcome := r.outcome(nil)
+	outcome.ConditionType = conditions[0].Type
+	outcome.Status = conditions[0].Status
+	outcome.Reason = conditions[0].Reason
+	outcome.Message = conditions[0].Message
+	outcome.Conditions = conditions
+	return r.conditionSink().Publish(ctx, outcome)
+}
+
+// updatePodCondition mirrors the Job condition on the reporter's own pod. Failures are logged,
+// since the Job condition is the one the run is judged by.
+func (r *StatusReporter) updatePodCondition(ctx context.Context, condition k8s.JobCondition) {
+	if r.podName == "" {
+		return
+	}
+	if err := r.k8sClient.UpdatePodCondition(ctx, r.podName, condition); err != nil {
+		log.Printf("Warning: failed to set condition %s on pod %s: %v", condition.Type, r.podName, err)
+	}
+}
+
+// annotateAdapterContainer records the enabled adapter container details on the Job: the image,
+// preferring the resolved image ID (which carries the digest) over the pod spec reference, and
+// the restart count. Failures are logged.
+func (r *StatusReporter) annotateAdapterContainer(ctx context.Context) {
+	status, err := r.getAdapterContainerStatus(ctx)
+	if err != nil {
+		log.Printf("Warning: failed to get adapter container status for annotations: %v", err)
+		return
+	}
+	if status == nil {
+		return
+	}
+
+	annotations := map[string]string{}
+	if r.recordAdapterImage {
+		image := status.ImageID
+		if image == "" {
+			image = status.Image
+		}
+		if image != "" {
+			annotations[k8s.AdapterImageAnnotation] = image
+		}
+	}
+	if r.recordRestarts {
+		annotations[k8s.RestartCountAnnotation] = strconv.Itoa(int(status.RestartCount))
+	}
+	if len(annotations) == 0 {
+		return
+	}
+
+	if err := r.k8sClient.AnnotateJob(ctx, annotations); err != nil {
+		log.Printf("Warning: failed to annotate job with adapter container details: %v", err)
+	}
+}
+
+// messageKVSuffix formats the configured keys, followed by the correlation metadata, as a
+// " [key=value ...]" message suffix; it is empty when there is nothing to append
+func (r *StatusReporter) messageKVSuffix(condition k8s.JobCondition) string {
+	pairs := make([]string, 0, len(r.messageKVKeys)+2)
+	for _, key := range r.messageKVKeys {
+		var value string
+		switch key {
+		case MessageKVStatus:
+			value = condition.Status
+		case MessageKVReason:
+			value = condition.Reason
+		case MessageKVElapsed:
+			value = time.Since(r.startTime).Round(time.Second).String()
+		default:
+			continue
+		}
+		pairs = append(pairs, key+"="+value)
+	}
+	pairs = append(pairs, r.reportedCorrelation.pairs()...)
+	if len(pairs) == 0 {
+		return ""
+	}
+	return " [" + strings.Join(pairs, " ") + "]"
+}
+
+// outcome builds the run outcome from the last reported condition
+func (r *StatusReporter) outcome(reportErr error) Outcome {
+	o := Outcome{
+		JobName:      r.jobName,
+		JobNamespace: r.jobNamespace,
+		PodName:      r.podName,
+		Timestamp:    time.Now().UTC(),
+
+		CorrelationID:      r.reportedCorrelation.ID,
+		ObservedGeneration: r.reportedCorrelation.ObservedGeneration,
+	}
+	if r.reportedCondition != nil {
+		o.ConditionType = r.reportedCondition.Type
+		o.Status = r.reportedCondition.Status
+		o.Reason = r.reportedCondition.Reason
+		o.Message = r.reportedCondition.Message
+	}
+	if reportErr != nil {
+		o.Error = reportErr.Error()
+	}
+	return o
+}
+
+// writeOutcomeToSocket writes the outcome as a single JSON line to a unix domain socket
+func writeOutcomeToSocket(ctx context.Context, socketPath string, outcome Outcome) error {
+	data, err := json.Marshal(outcome)
+	if err != nil {
+		return fmt.Errorf("failed to marshal outcome: %w", err)
+	}
+
+	dialer := net.Dialer{Timeout: outcomeSocketTimeout}
+	conn, err := dialer.DialContext(ctx, "unix", socketPath)
+	if err != nil {
+		return fmt.Errorf("failed to connect to outcome socket path=%s: %w", socketPath, err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	if err := conn.SetWriteDeadline(time.Now().Add(outcomeSocketTimeout)); err != nil {
+		return fmt.Errorf("failed to set outcome socket deadline: %w", err)
+	}
+	if _, err := conn.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write outcome to socket path=%s: %w", socketPath, err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package reporter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+)
+
+const (
+	// pipeUnblockInterval is how often a reader blocked on opening the result pipe is nudged during shutdown
+	pipeUnblockInterval = 100 * time.Millisecond
+
+	// pipedResultGrace is how long a result written to the pipe just before the adapter exited is
+	// waited for once the exit is seen
+	pipedResultGrace = 2 * time.Second
+)
+
+// isResultPipe reports whether the result path is a named pipe (FIFO)
+func (r *StatusReporter) isResultPipe() bool {
+	info, err := os.Stat(r.resultsPath)
+	return err == nil && info.Mode()&os.ModeNamedPipe != 0
+}
+
+// readResultPipe reads results from the named pipe at the result path until a terminal result is
+// delivered or the run finishes. Each document written by the adapter (e.g. with
+// `echo '{...}' > pipe`) is read up to the writer closing the pipe and parsed like a result file.
+func (r *StatusReporter) readResultPipe(ctx context.Context, channels *pollChannels) {
+	log.Printf("Result path %s is a named pipe; waiting for the adapter to write the result", r.resultsPath)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go r.unblockResultPipeOnShutdown(ctx, channels, stop)
+
+	for {
+		data, err := readPipe(r.resultsPath)
+		if r.shuttingDown(ctx, channels) {
+			return
+		}
+		if err == nil && len(bytes.TrimSpace(data)) == 0 {
+			// The writer closed the pipe without writing anything; wait for the next one
+			continue
+		}
+
+		var adapterResult *result.AdapterResult
+		if err == nil {
+			r.phases.mark(&r.phases.resultFound)
+			adapterResult, err = r.parser.Parse(data)
+		}
+		if err != nil {
+			select {
+			case channels.error <- err:
+			case <-channels.done:
+			}
+			return
+		}
+
+		if r.isIntermediate(adapterResult) {
+			log.Printf("Intermediate result: status=%s, reason=%s, message=%s; waiting for a terminal result",
+				adapterResult.Status, adapterResult.Reason, adapterResult.Message)
+			continue
+		}
+
+		log.Printf("Result parsed successfully: status=%s, reason=%s", adapterResult.Status, adapterResult.Reason)
+		select {
+		case channels.result <- adapterResult:
+		case <-channels.done:
+		}
+		return
+	}
+}
+
+// shuttingDown reports whether the run has finished and result reading should stop
+func (r *StatusReporter) shuttingDown(ctx context.Context, channels *pollChannels) bool {
+	select {
+	case <-channels.done:
+		return true
+	case <-ctx.Done():
+		return true
+	case <-channels.stopPolling:
+		return true
+	default:
+		return false
+	}
+}
+
+// unblockResultPipeOnShutdown releases a reader blocked opening the pipe once the run finishes, by
+// briefly opening the write end. It retries until stop is closed since the reader may not have
+// reached the open yet.
+func (r *StatusReporter) unblockResultPipeOnShutdown(ctx context.Context, channels *pollChannels, stop <-chan struct{}) {
+	select {
+	case <-stop:
+		return
+	case <-channels.done:
+	case <-ctx.Done():
+	case <-channels.stopPolling:
+	}
+
+	ticker := time.NewTicker(pipeUnblockInterval)
+	defer ticker.Stop()
+	for {
+		// Opening without blocking fails (ENXIO) while no reader is waiting
+		if f, err := os.OpenFile(r.resultsPath, os.O_WRONLY|syscall.O_NONBLOCK, 0); err == nil {
+			_ = f.Close()
+		}
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// readPipe opens the pipe, blocking until a writer opens it, and reads until the writer closes it
+func readPipe(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open result pipe path=%s: %w", path, err)
+	}
+	defer func() { _ = f.Close() }()
+
+	data, err := io.ReadAll(io.LimitReader(f, result.MaxResultSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read result pipe path=%s: %w", path, err)
+	}
+	if len(data) > result.MaxResultSize {
+		return nil, fmt.Errorf("result too large: path=%s max=%d", path, result.MaxResultSize)
+	}
+	return data, nil
+}
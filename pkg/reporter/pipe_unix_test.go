@@ -0,0 +1,110 @@
+//go:build unix
+
+package reporter_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/reporter"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/reporter/testhelpers"
+)
+
+var _ = Describe("StatusReporter with a result pipe", func() {
+	var (
+		mock     *testhelpers.MockK8sClient
+		ctx      context.Context
+		pipePath string
+	)
+
+	BeforeEach(func() {
+		mock = testhelpers.NewMockK8sClient()
+		ctx = context.Background()
+		pipePath = filepath.Join(GinkgoT().TempDir(), "pipe")
+		Expect(syscall.Mkfifo(pipePath, 0o600)).To(Succeed())
+	})
+
+	// write opens the pipe like a shell redirection, blocking until the reporter reads it
+	write := func(content string) {
+		go func() {
+			defer GinkgoRecover()
+			f, err := os.OpenFile(pipePath, os.O_WRONLY, 0)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = f.WriteString(content)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.Close()).To(Succeed())
+		}()
+	}
+
+	It("reports the result written to the pipe", func() {
+		write(`{"status":"success","reason":"Piped","message":"ok"}` + "\n")
+		// The poll interval only bounds how soon the pipe is first opened
+		r := reporter.NewReporterWithClient(pipePath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+			reporter.WithNonTerminalReasons("InProgress"))
+
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusTrue))
+		Expect(mock.LastUpdatedCondition.Reason).To(Equal("Piped"))
+	})
+
+	It("keeps reading after an intermediate result", func() {
+		write(`{"status":"success","reason":"InProgress","message":"working"}`)
+		time.AfterFunc(300*time.Millisecond, func() {
+			write(`{"status":"failure","reason":"Broken","message":"no"}`)
+		})
+		r := reporter.NewReporterWithClient(pipePath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+			reporter.WithNonTerminalReasons("InProgress"))
+
+		Expect(r.Run(ctx)).To(Succeed())
+		Expect(mock.LastUpdatedCondition.Reason).To(Equal("Broken"))
+	})
+
+	Context("when the adapter exits as it writes the result", func() {
+		exitWith := func(exitCode int32) {
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				return &corev1.ContainerStatus{
+					Name:  "adapter",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed", ExitCode: exitCode}},
+				}, nil
+			}
+		}
+
+		It("reports the piped result instead of the exit code", func() {
+			exitWith(0)
+			time.AfterFunc(200*time.Millisecond, func() {
+				write(`{"status":"success","reason":"Piped","message":"ok"}`)
+			})
+			r := reporter.NewReporterWithClient(pipePath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock)
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("Piped"))
+		})
+
+		It("escalates a piped success followed by a failed exit", func() {
+			exitWith(1)
+			time.AfterFunc(200*time.Millisecond, func() {
+				write(`{"status":"success","reason":"Piped","message":"ok"}`)
+			})
+			r := reporter.NewReporterWithClient(pipePath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithCleanupFailurePolicy(reporter.CleanupFailurePolicyEscalate, time.Second))
+
+			Expect(r.Run(ctx)).To(MatchError(ContainSubstring("after writing a success result")))
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
+		})
+	})
+
+	It("reports a timeout and stops reading when nothing is written", func() {
+		r := reporter.NewReporterWithClient(pipePath, 50*time.Millisecond, 500*time.Millisecond, "Available", "test-pod", "adapter", mock)
+
+		Expect(r.Run(ctx)).NotTo(Succeed())
+		Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterTimeout))
+	})
+})
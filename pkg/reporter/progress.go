@@ -0,0 +1,60 @@
+package reporter
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+)
+
+// isIntermediate reports whether a result is not terminal: its reason is configured as
+// non-terminal, or it is a progress record of a result stream not marked final
+func (r *StatusReporter) isIntermediate(adapterResult *result.AdapterResult) bool {
+	return r.nonTerminalReasons[adapterResult.Reason] || (r.resultStream && !adapterResult.Final)
+}
+
+// reportProgress sets the condition to Unknown with the progress record's reason and message,
+// skipping records that did not change. It never overwrites the final status once reported.
+// Failures are logged; the final report does not depend on progress updates.
+func (r *StatusReporter) reportProgress(ctx context.Context, adapterResult *result.AdapterResult) {
+	r.statusMu.Lock()
+	defer r.statusMu.Unlock()
+	if r.finalReported {
+		return
+	}
+	key := adapterResult.Reason + "\x00" + adapterResult.Message
+	if key == r.lastProgress {
+		return
+	}
+	r.lastProgress = key
+
+	condition := k8s.JobCondition{
+		Type:    r.conditionTypeFor(adapterResult.Reason),
+		Status:  ConditionStatusUnknown,
+		Reason:  adapterResult.Reason,
+		Message: adapterResult.Message,
+	}
+	if err := r.writeConditions(ctx, []k8s.JobCondition{condition}); err != nil {
+		log.Printf("Warning: failed to update job status with progress: %v", err)
+	}
+}
+
+// reportAdapterRunning sets the condition to Unknown with ReasonAdapterRunning while the reporter
+// waits for the adapter, so consumers can tell a run in progress from one that never reported.
+// Failures are logged; the final report does not depend on it.
+func (r *StatusReporter) reportAdapterRunning(ctx context.Context) {
+	condition := k8s.JobCondition{
+		Type:    r.conditionTypeFor(ReasonAdapterRunning),
+		Status:  ConditionStatusUnknown,
+		Reason:  ReasonAdapterRunning,
+		Message: fmt.Sprintf("Waiting up to %s for the adapter result", r.maxWaitTime),
+	}
+
+	r.statusMu.Lock()
+	defer r.statusMu.Unlock()
+	if err := r.writeConditions(ctx, []k8s.JobCondition{condition}); err != nil {
+		log.Printf("Warning: failed to update job status with in-progress condition: %v", err)
+	}
+}
@@ -0,0 +1,40 @@
+package reporter
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+)
+
+const (
+	// projectedDataDir is the symlink through which the kubelet atomically swaps the content of
+	// ConfigMap, Secret, downward API and projected volumes on each update
+	projectedDataDir = "..data"
+
+	// projectedReadAttempts bounds the re-reads of a result whose volume version was swapped mid-read
+	projectedReadAttempts = 3
+)
+
+// parseProjectedResult parses the result file of a projected volume through its resolved path, so
+// the result and a companion checksum file are read from the same version of the volume. A read
+// of a version the kubelet removed after swapping in a newer one is retried with the newer one.
+func (r *StatusReporter) parseProjectedResult() (*result.AdapterResult, error) {
+	var err error
+	for range projectedReadAttempts {
+		var resolved string
+		resolved, err = filepath.EvalSymlinks(r.resultsPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve result file path=%s: %w", r.resultsPath, err)
+		}
+
+		var adapterResult *result.AdapterResult
+		adapterResult, err = r.parser.ParseFile(resolved)
+		if !errors.Is(err, os.ErrNotExist) {
+			return adapterResult, err
+		}
+	}
+	return nil, err
+}
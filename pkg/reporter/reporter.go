@@ -5,37 +5,117 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"reflect"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
 	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/retry"
 )
 
 const (
-	ConditionStatusTrue  = "True"
-	ConditionStatusFalse = "False"
+	ConditionStatusTrue    = "True"
+	ConditionStatusFalse   = "False"
+	ConditionStatusUnknown = "Unknown"
 
 	ReasonAdapterCrashed         = "AdapterCrashed"
 	ReasonAdapterOOMKilled       = "AdapterOOMKilled"
 	ReasonAdapterExitedWithError = "AdapterExitedWithError"
 	ReasonAdapterTimeout         = "AdapterTimeout"
 	ReasonInvalidResultFormat    = "InvalidResultFormat"
-	ReasonAdapterMissingResults  = "AdapterMissingResults"
+	ReasonInvalidResultSyntax    = "InvalidResultSyntax"
+	ReasonInitContainerFailed    = "InitContainerFailed"
+	ReasonPartialFailure         = "PartialFailure"
+	ReasonPodTerminating         = "PodTerminating"
+	ReasonStatusReporterError    = "StatusReporterError"
+
+	ReasonAdapterFailedAsExpected      = "AdapterFailedAsExpected"
+	ReasonAdapterSucceededUnexpectedly = "AdapterSucceededUnexpectedly"
+	ReasonAdapterMissingResults        = "AdapterMissingResults"
+
+	// ReasonAdapterRunning is the reason of the Unknown condition set while the adapter runs
+	ReasonAdapterRunning = "AdapterRunning"
 
 	ContainerReasonOOMKilled = "OOMKilled"
 
+	// EventReasonResultReceived is the reason of the Event recorded when an adapter result is reported
+	EventReasonResultReceived = "ResultReceived"
+
+	// Active deadline check modes
+	ActiveDeadlineCheckOff    = "off"
+	ActiveDeadlineCheckWarn   = "warn"
+	ActiveDeadlineCheckStrict = "strict"
+
+	// Keys of the key=value message suffix
+	MessageKVStatus  = "status"
+	MessageKVReason  = "reason"
+	MessageKVElapsed = "elapsed"
+
+	// Cleanup failure policies
+	CleanupFailurePolicyIgnore   = "ignore"
+	CleanupFailurePolicyEscalate = "escalate"
+
+	// DefaultCleanupGracePeriod is how long a success result is held to observe the adapter's exit
+	// under the escalate cleanup failure policy
+	DefaultCleanupGracePeriod = 30 * time.Second
+
+	// DefaultInitialStatusRetries is how many times the first container status lookup is retried
+	DefaultInitialStatusRetries = 3
+
+	// DefaultInitialStatusRetryDelay is the delay between retries of the first container status lookup
+	DefaultInitialStatusRetryDelay = 1 * time.Second
+
+	// DefaultAggregateConcurrency bounds how many result files are parsed at once when aggregating
+	DefaultAggregateConcurrency = 8
+
+	// deadlineWindowFraction and deadlinePollDivisor define deadline polling: during the final
+	// 1/deadlineWindowFraction of the wait window the poll interval is divided by deadlinePollDivisor
+	deadlineWindowFraction = 10
+	deadlinePollDivisor    = 4
+
+	// DefaultLogDedupInterval is how often repeated container monitor warnings are summarized
+	DefaultLogDedupInterval = 60 * time.Second
+
 	// DefaultContainerStatusCheckInterval Default container status check interval - checked less frequently than file polling to reduce a K8s API load
 	DefaultContainerStatusCheckInterval = 10 * time.Second
 )
 
+var (
+	// errNonTerminalResult is returned when the result file holds an intermediate result
+	errNonTerminalResult = errors.New("result is not terminal")
+
+	// errResultParseFailed is returned when the result file exists but cannot be parsed
+	errResultParseFailed = errors.New("parse failed")
+)
+
 // K8sClientInterface defines the k8s operations needed by StatusReporter
 type K8sClientInterface interface {
 	UpdateJobStatus(ctx context.Context, condition k8s.JobCondition) error
+	UpdateJobConditions(ctx context.Context, conditions []k8s.JobCondition) error
 	GetAdapterContainerStatus(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error)
+	GetFailedInitContainerStatus(ctx context.Context, podName string) (*corev1.ContainerStatus, error)
+	GetJobActiveDeadlineSeconds(ctx context.Context) (*int64, error)
+	GetPod(ctx context.Context, podName string) (*corev1.Pod, error)
+	GetContainerMemoryLimit(ctx context.Context, podName, containerName string) (*resource.Quantity, error)
+	FindPodByPrefix(ctx context.Context, prefix string) (string, error)
+	AnnotateJob(ctx context.Context, annotations map[string]string) error
+	AnnotateConditionTarget(ctx context.Context, annotations map[string]string) error
+	ClaimJobAnnotation(ctx context.Context, key, value string) (bool, error)
+	RemoveJobAnnotation(ctx context.Context, key string) error
+	UpdatePodCondition(ctx context.Context, podName string, condition k8s.JobCondition) error
+	RecordEvent(ctx context.Context, eventType, reason, message string)
+	ApplyJobConfigMap(ctx context.Context, name string, data map[string]string) error
+	GetJobUID(ctx context.Context) (string, error)
+	GetContainerLogTail(ctx context.Context, podName, containerName string, lines int64) (string, error)
 }
 
 // pollChannels encapsulates the channels used for communication between polling goroutines and the main Run loop
@@ -43,7 +123,13 @@ type pollChannels struct {
 	result     chan *result.AdapterResult
 	error      chan error
 	terminated chan *corev1.ContainerStateTerminated
-	done       chan struct{}
+	initFailed chan *corev1.ContainerStatus
+	podDeleted chan time.Time
+	checkNow   chan struct{}
+
+	// stopPolling is closed by the container monitor to stop the result file poller early
+	stopPolling chan struct{}
+	done        chan struct{}
 }
 
 // StatusReporter is the main status reporter
@@ -56,31 +142,136 @@ type StatusReporter struct {
 	podName                      string
 	adapterContainerName         string
 	k8sClient                    K8sClientInterface
+	k8sClientOptions             []k8s.ClientOption
 	parser                       *result.Parser
+	parserOptions                []result.ParserOption
+	singleAdapter                bool
+	maxResultAge                 time.Duration
+	startTime                    time.Time
+	staleResultLogged            atomic.Bool
+	checkOnContainerChange       bool
+	quietStartup                 bool
+	expectFailure                bool
+	debounceTermination          bool
+	confirmSuccessStable         bool
+	nonTerminalReasons           map[string]bool
+	timingOutputPath             string
+	activeDeadlineCheck          string
+	degradedRule                 *DegradedRule
+	exitOnPodTerminating         bool
+	parseSettleDelay             time.Duration
+	noteParseFailure             bool
+	cleanupFailurePolicy         string
+	cleanupGracePeriod           time.Duration
+	reportMemoryLimit            bool
+	deadlinePolling              bool
+	messageKVKeys                []string
+	correlation                  Correlation
+	heartbeat                    HeartbeatClient
+	reportedCorrelation          Correlation
+	stopPollingOnTermination     bool
+	podNameIsPrefix              bool
+	podNamePrefix                string
+	minFailureSeverity           string
+	recordAdapterImage           bool
+	recordRestarts               bool
+	publishPodCondition          bool
+	callbackIncludeResult        bool
+	conditionRoutes              []ConditionRoute
+	timeoutGrowthGrace           time.Duration
+	watchResultFile              bool
+	resultHTTPAddr               string
+	resultHTTPTokenFile          string
+	resultGlob                   bool
+	resultStream                 bool
+	progressUpdates              bool
+	reportRunning                bool
+	progressing                  bool
+	progressingType              string
+	timeoutStatus                string
+	resultGlobExpected           int
+	aggregateConcurrency         int
+	sinkRetry                    retry.Policy
+	resultChecks                 []ResultCheck
+	resultChecksDir              string
+	checksumSuffix               string
+	doneFile                     string
+	projectedVolume              bool
+	useTerminationMessage        bool
+	resultSocketPath             string
+	healthURL                    string
+	healthOnly                   bool
+	healthClient                 *http.Client
+	phases                       phaseTimes
+	initialStatusRetries         int
+	initialStatusRetryDelay      time.Duration
+	jobName                      string
+	jobNamespace                 string
+	primarySink                  Sink
+	publishers                   []outcomePublisher
+	sinkFailurePolicy            string
+
+	// statusMu orders progress updates before the final status; finalReported and lastProgress
+	// are guarded by it, since progress may come from the poller and the result endpoint
+	statusMu      sync.Mutex
+	finalReported bool
+	lastProgress  string
+
+	// terminalWritten is set once the terminal condition is written; it is read after a panic
+	terminalWritten atomic.Bool
+
+	// lastHealthFailure holds the latest non-2xx health probe response, reported at the deadline
+	lastHealthFailure atomic.Pointer[result.AdapterResult]
+
+	// fileResult is the result the file poller delivered, so a held success is only checked against
+	// the result file when that is where it came from
+	fileResult atomic.Pointer[result.AdapterResult]
+
+	// lastNonTerminalReason and parseSettled are only accessed by the result file poller goroutine
+	lastNonTerminalReason string
+	parseSettled          bool
+
+	// lastContainerState, terminationObserved and monitorLog are only accessed by the container monitor goroutine
+	lastContainerState  string
+	terminationObserved bool
+	monitorLog          dedupLogger
+
+	// reportedCondition is the last condition sent to the Job, used to publish the run outcome
+	reportedCondition *k8s.JobCondition
+	// reportedResult is the adapter result the reported condition was set from, if any
+	reportedResult *result.AdapterResult
+
+	// containerNameMu guards adapterContainerName, which may be resolved at runtime
+	// when singleAdapter is enabled
+	containerNameMu sync.Mutex
 }
 
 // NewReporter creates a new status reporter
-func NewReporter(resultsPath string, pollInterval, maxWaitTime time.Duration, conditionType, podName, adapterContainerName, jobName, jobNamespace string) (*StatusReporter, error) {
-	k8sClient, err := k8s.NewClient(jobNamespace, jobName)
+func NewReporter(resultsPath string, pollInterval, maxWaitTime time.Duration, conditionType, podName, adapterContainerName, jobName, jobNamespace string, opts ...Option) (*StatusReporter, error) {
+	opts = append([]Option{WithJobReference(jobName, jobNamespace)}, opts...)
+	r := newReporterWithClient(resultsPath, pollInterval, maxWaitTime, DefaultContainerStatusCheckInterval, conditionType, podName, adapterContainerName, nil, opts...)
+
+	k8sClient, err := k8s.NewClient(jobNamespace, jobName, r.k8sClientOptions...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create k8s client: %w", err)
 	}
+	r.k8sClient = k8sClient
 
-	return newReporterWithClient(resultsPath, pollInterval, maxWaitTime, DefaultContainerStatusCheckInterval, conditionType, podName, adapterContainerName, k8sClient), nil
+	return r, nil
 }
 
 // NewReporterWithClient creates a new status reporter with a custom k8s client (for testing)
-func NewReporterWithClient(resultsPath string, pollInterval, maxWaitTime time.Duration, conditionType, podName, adapterContainerName string, k8sClient K8sClientInterface) *StatusReporter {
-	return newReporterWithClient(resultsPath, pollInterval, maxWaitTime, DefaultContainerStatusCheckInterval, conditionType, podName, adapterContainerName, k8sClient)
+func NewReporterWithClient(resultsPath string, pollInterval, maxWaitTime time.Duration, conditionType, podName, adapterContainerName string, k8sClient K8sClientInterface, opts ...Option) *StatusReporter {
+	return newReporterWithClient(resultsPath, pollInterval, maxWaitTime, DefaultContainerStatusCheckInterval, conditionType, podName, adapterContainerName, k8sClient, opts...)
 }
 
 // NewReporterWithClientAndIntervals creates a new status reporter with custom intervals (for testing)
-func NewReporterWithClientAndIntervals(resultsPath string, pollInterval, maxWaitTime, containerStatusCheckInterval time.Duration, conditionType, podName, adapterContainerName string, k8sClient K8sClientInterface) *StatusReporter {
-	return newReporterWithClient(resultsPath, pollInterval, maxWaitTime, containerStatusCheckInterval, conditionType, podName, adapterContainerName, k8sClient)
+func NewReporterWithClientAndIntervals(resultsPath string, pollInterval, maxWaitTime, containerStatusCheckInterval time.Duration, conditionType, podName, adapterContainerName string, k8sClient K8sClientInterface, opts ...Option) *StatusReporter {
+	return newReporterWithClient(resultsPath, pollInterval, maxWaitTime, containerStatusCheckInterval, conditionType, podName, adapterContainerName, k8sClient, opts...)
 }
 
-func newReporterWithClient(resultsPath string, pollInterval, maxWaitTime, containerStatusCheckInterval time.Duration, conditionType, podName, adapterContainerName string, k8sClient K8sClientInterface) *StatusReporter {
-	return &StatusReporter{
+func newReporterWithClient(resultsPath string, pollInterval, maxWaitTime, containerStatusCheckInterval time.Duration, conditionType, podName, adapterContainerName string, k8sClient K8sClientInterface, opts ...Option) *StatusReporter {
+	r := &StatusReporter{
 		resultsPath:                  resultsPath,
 		pollInterval:                 pollInterval,
 		maxWaitTime:                  maxWaitTime,
@@ -89,17 +280,74 @@ func newReporterWithClient(resultsPath string, pollInterval, maxWaitTime, contai
 		podName:                      podName,
 		adapterContainerName:         adapterContainerName,
 		k8sClient:                    k8sClient,
-		parser:                       result.NewParser(),
+		startTime:                    time.Now(),
+		initialStatusRetries:         DefaultInitialStatusRetries,
+		aggregateConcurrency:         DefaultAggregateConcurrency,
+		sinkRetry:                    retry.Policy{MaxRetries: DefaultSinkMaxRetries},
+		initialStatusRetryDelay:      DefaultInitialStatusRetryDelay,
+		cleanupGracePeriod:           DefaultCleanupGracePeriod,
+		monitorLog:                   dedupLogger{interval: DefaultLogDedupInterval},
+	}
+
+	for _, opt := range opts {
+		opt(r)
 	}
+	if r.podNameIsPrefix {
+		r.podNamePrefix = podName
+	}
+
+	r.parser = result.NewParser(r.parserOptions...)
+
+	return r
 }
 
 // Run starts the reporter and blocks until completion
 func (r *StatusReporter) Run(ctx context.Context) error {
-	log.Printf("Status reporter starting...")
-	log.Printf("  Pod: %s", r.podName)
-	log.Printf("  Results path: %s", r.resultsPath)
-	log.Printf("  Poll interval: %s", r.pollInterval)
-	log.Printf("  Max wait time: %s", r.maxWaitTime)
+	r.startTime = time.Now()
+	r.phases.reset(r.startTime)
+	r.lastContainerState = ""
+	r.terminationObserved = false
+	r.lastNonTerminalReason = ""
+	r.lastProgress = ""
+	r.finalReported = false
+	r.terminalWritten.Store(false)
+	r.lastHealthFailure.Store(nil)
+	r.fileResult.Store(nil)
+	r.parseSettled = false
+	r.reportedCorrelation = r.correlation
+
+	if r.podNamePrefix != "" {
+		podName, err := r.k8sClient.FindPodByPrefix(ctx, r.podNamePrefix)
+		if err != nil {
+			return fmt.Errorf("failed to resolve pod name: %w", err)
+		}
+		r.podName = podName
+	}
+
+	if !r.quietStartup {
+		log.Printf("Status reporter starting...")
+		log.Printf("  Pod: %s", r.podName)
+		log.Printf("  Results path: %s", r.resultsPath)
+		log.Printf("  Poll interval: %s", r.pollInterval)
+		log.Printf("  Max wait time: %s", r.maxWaitTime)
+	}
+
+	if err := r.checkActiveDeadline(ctx); err != nil {
+		return err
+	}
+
+	resultListeners, err := r.listenForResults()
+	if err != nil {
+		return err
+	}
+
+	stopHeartbeat := r.startHeartbeat(ctx)
+	defer stopHeartbeat()
+
+	r.startProgressing(ctx)
+	if r.reportRunning {
+		r.reportAdapterRunning(ctx)
+	}
 
 	timeoutCtx, cancel := context.WithTimeout(ctx, r.maxWaitTime)
 	defer cancel()
@@ -107,43 +355,183 @@ func (r *StatusReporter) Run(ctx context.Context) error {
 	// Buffered channels (size 1) prevent goroutine leaks if the main select has already
 	// chosen another case when a sender tries to send
 	channels := &pollChannels{
-		result:     make(chan *result.AdapterResult, 1),
-		error:      make(chan error, 1),
-		terminated: make(chan *corev1.ContainerStateTerminated, 1),
-		done:       make(chan struct{}),
+		result:      make(chan *result.AdapterResult, 1),
+		error:       make(chan error, 1),
+		terminated:  make(chan *corev1.ContainerStateTerminated, 1),
+		initFailed:  make(chan *corev1.ContainerStatus, 1),
+		podDeleted:  make(chan time.Time, 1),
+		stopPolling: make(chan struct{}),
+		checkNow:    make(chan struct{}, 1),
+		done:        make(chan struct{}),
 	}
 
 	var wg sync.WaitGroup
-	wg.Add(2)
-	go r.pollForResultFile(timeoutCtx, channels, &wg)
+	if !r.healthOnly {
+		wg.Add(1)
+		go r.pollForResultFile(timeoutCtx, channels, &wg)
+	}
+	wg.Add(1)
 	go r.monitorContainerStatus(timeoutCtx, channels, &wg)
+	if r.healthURL != "" {
+		wg.Add(1)
+		go r.pollHealthURL(timeoutCtx, channels, &wg)
+	}
+	if len(resultListeners) > 0 {
+		wg.Add(1)
+		go r.serveResults(timeoutCtx, resultListeners, channels, &wg)
+	}
+
+	// A result pushed over the result pipe may still be on its way when the adapter's exit is seen
+	pipedResults := r.isResultPipe()
 
 	var reportErr error
 	select {
 	case adapterResult := <-channels.result:
-		reportErr = r.UpdateFromResult(ctx, adapterResult)
+		reportErr = r.reportResult(ctx, timeoutCtx, adapterResult, channels)
 	case err := <-channels.error:
 		reportErr = r.UpdateFromError(ctx, err)
 	case terminated := <-channels.terminated:
-		reportErr = r.HandleTermination(ctx, terminated)
+		reportErr = r.handleTermination(ctx, terminated, channels, pipedResults)
+	case initStatus := <-channels.initFailed:
+		reportErr = r.UpdateFromInitContainerFailure(ctx, initStatus)
+	case deletedAt := <-channels.podDeleted:
+		reportErr = r.HandlePodTerminating(ctx, deletedAt)
 	case <-timeoutCtx.Done():
 		// Give precedence to results/errors/termination that may have arrived just before timeout
 		select {
 		case adapterResult := <-channels.result:
-			reportErr = r.UpdateFromResult(ctx, adapterResult)
+			reportErr = r.reportResult(ctx, timeoutCtx, adapterResult, channels)
 		case err := <-channels.error:
 			reportErr = r.UpdateFromError(ctx, err)
 		case terminated := <-channels.terminated:
-			reportErr = r.HandleTermination(ctx, terminated)
+			reportErr = r.handleTermination(ctx, terminated, channels, pipedResults)
+		case initStatus := <-channels.initFailed:
+			reportErr = r.UpdateFromInitContainerFailure(ctx, initStatus)
+		case deletedAt := <-channels.podDeleted:
+			reportErr = r.HandlePodTerminating(ctx, deletedAt)
 		default:
-			reportErr = r.UpdateFromTimeout(ctx)
+			if failed := r.lastHealthFailure.Load(); failed != nil {
+				reportErr = r.UpdateFromResult(ctx, failed)
+			} else {
+				reportErr = r.UpdateFromTimeout(ctx)
+			}
 		}
 	}
 
 	close(channels.done)
 	wg.Wait()
 
-	return reportErr
+	if len(r.resultChecks) > 0 {
+		reportErr = errors.Join(reportErr, r.reportResultChecks(ctx))
+	}
+
+	if r.timingOutputPath != "" {
+		if err := r.writeTiming(r.timingOutputPath); err != nil {
+			log.Printf("Warning: failed to write timing data: %v", err)
+		}
+	}
+
+	return r.publishOutcome(ctx, reportErr)
+}
+
+// reportResult reports a parsed adapter result. With success confirmation enabled, a result
+// that would set the condition to True is held until the adapter container exits cleanly.
+func (r *StatusReporter) reportResult(ctx, timeoutCtx context.Context, adapterResult *result.AdapterResult, channels *pollChannels) error {
+	if r.conditionFromResult(adapterResult).Status != ConditionStatusTrue {
+		return r.UpdateFromResult(ctx, adapterResult)
+	}
+	if r.confirmSuccessStable {
+		return r.confirmStableSuccess(ctx, timeoutCtx, adapterResult, channels)
+	}
+	if r.cleanupFailurePolicy == CleanupFailurePolicyEscalate {
+		return r.escalateCleanupFailure(ctx, timeoutCtx, adapterResult, channels)
+	}
+	return r.UpdateFromResult(ctx, adapterResult)
+}
+
+// escalateCleanupFailure holds a success result for the cleanup grace period to observe the adapter's
+// exit. A non-zero exit within that period downgrades the success to a failure; a clean exit, or no
+// exit before the period ends, reports the success.
+func (r *StatusReporter) escalateCleanupFailure(ctx, timeoutCtx context.Context, adapterResult *result.AdapterResult, channels *pollChannels) error {
+	log.Printf("Success result found; watching the adapter container for up to %s for a failed cleanup", r.cleanupGracePeriod)
+
+	timer := time.NewTimer(r.cleanupGracePeriod)
+	defer timer.Stop()
+
+	select {
+	case terminated := <-channels.terminated:
+		if terminated.ExitCode != 0 {
+			log.Printf("Adapter container exited with code %d after writing a success result; escalating to failure", terminated.ExitCode)
+			return r.updateFromTerminatedContainer(ctx, terminated, "after writing a success result")
+		}
+	case <-timer.C:
+		log.Printf("Adapter container did not exit within %s of writing a success result; keeping the result", r.cleanupGracePeriod)
+	case <-timeoutCtx.Done():
+	}
+	return r.UpdateFromResult(ctx, adapterResult)
+}
+
+// confirmStableSuccess waits for the adapter container to terminate before committing a success.
+// Success is only reported if the container exited with code 0 and, for a result read from the
+// result file, the file still holds the same result; otherwise the termination is handled as usual.
+// A pushed result is confirmed as received. If the pod is deleted or the container does not exit
+// before the timeout, the success is never committed.
+func (r *StatusReporter) confirmStableSuccess(ctx, timeoutCtx context.Context, adapterResult *result.AdapterResult, channels *pollChannels) error {
+	log.Printf("Success result found; holding until the adapter container exits cleanly")
+
+	select {
+	case terminated := <-channels.terminated:
+		if terminated.ExitCode != 0 {
+			log.Printf("Adapter container exited with code %d after reporting success; not confirming success", terminated.ExitCode)
+			return r.UpdateFromTerminatedContainer(ctx, terminated)
+		}
+
+		if r.fileResult.Load() == adapterResult {
+			current, err := r.tryParseResultFile()
+			if err != nil || !reflect.DeepEqual(current, adapterResult) {
+				log.Printf("Result file changed after reporting success; using the final result file")
+				return r.HandleTermination(ctx, terminated)
+			}
+		}
+
+		log.Printf("Success confirmed: adapter container exited cleanly after reporting success")
+		return r.UpdateFromResult(ctx, adapterResult)
+	case deletedAt := <-channels.podDeleted:
+		log.Printf("Pod is terminating before the success result was confirmed")
+		return r.updateFromPodTerminating(ctx, deletedAt, "the success result was confirmed")
+	case <-timeoutCtx.Done():
+		log.Printf("Success result was not confirmed before timeout")
+		return r.UpdateFromTimeout(ctx)
+	}
+}
+
+// checkActiveDeadline verifies that the reporter times out before the Job's activeDeadlineSeconds.
+// Otherwise the Job controller kills the pod first and the reporter's timeout handling never runs.
+// In warn mode problems are logged; in strict mode they fail the run before anything is reported.
+func (r *StatusReporter) checkActiveDeadline(ctx context.Context) error {
+	if r.activeDeadlineCheck == "" || r.activeDeadlineCheck == ActiveDeadlineCheckOff {
+		return nil
+	}
+
+	var problem error
+	deadlineSeconds, err := r.k8sClient.GetJobActiveDeadlineSeconds(ctx)
+	switch {
+	case err != nil:
+		problem = fmt.Errorf("failed to check the Job's activeDeadlineSeconds: %w", err)
+	case deadlineSeconds == nil:
+		return nil
+	case r.maxWaitTime >= time.Duration(*deadlineSeconds)*time.Second:
+		problem = fmt.Errorf("max wait time %s is not shorter than the Job's activeDeadlineSeconds (%ds); "+
+			"the Job controller may kill the pod before the reporter times out", r.maxWaitTime, *deadlineSeconds)
+	default:
+		return nil
+	}
+
+	if r.activeDeadlineCheck == ActiveDeadlineCheckStrict {
+		return problem
+	}
+	log.Printf("Warning: %v", problem)
+	return nil
 }
 
 // pollForResultFile polls for the result file at regular intervals.
@@ -155,7 +543,44 @@ func (r *StatusReporter) pollForResultFile(ctx context.Context, channels *pollCh
 	ticker := time.NewTicker(r.pollInterval)
 	defer ticker.Stop()
 
-	log.Printf("Polling for result file at %s (interval: %s)...", r.resultsPath, r.pollInterval)
+	if !r.quietStartup {
+		log.Printf("Polling for result file at %s (interval: %s)...", r.resultsPath, r.pollInterval)
+	}
+
+	// With file watching, a change to the result file is checked immediately; the ticker stays as
+	// the fallback for filesystems that do not deliver notifications (e.g. NFS)
+	var fileChanged chan struct{}
+	if r.watchResultFile {
+		fileChanged = make(chan struct{}, 1)
+		paths := []string{r.resultsPath}
+		if len(r.resultChecks) > 0 {
+			paths = r.checkPaths()
+		}
+		if r.checksumSuffix != "" {
+			paths = append(paths, r.resultsPath+r.checksumSuffix)
+		}
+		if r.doneFile != "" {
+			paths = append(paths, r.doneFile)
+		}
+		stop, err := watchResultFile(fileChanged, paths...)
+		if err != nil {
+			log.Printf("Warning: result file watching unavailable, relying on polling: %v", err)
+			fileChanged = nil
+		} else {
+			defer stop()
+		}
+	}
+
+	// With deadline polling, switch to a shorter interval for the final part of the wait window
+	// so a result written just before the timeout is still picked up
+	var finalWindow <-chan time.Time
+	finalInterval := r.pollInterval / deadlinePollDivisor
+	if r.deadlinePolling && finalInterval > 0 {
+		windowStart := r.startTime.Add(r.maxWaitTime - r.maxWaitTime/deadlineWindowFraction)
+		timer := time.NewTimer(time.Until(windowStart))
+		defer timer.Stop()
+		finalWindow = timer.C
+	}
 
 	for {
 		select {
@@ -165,65 +590,280 @@ func (r *StatusReporter) pollForResultFile(ctx context.Context, channels *pollCh
 		case <-ctx.Done():
 			log.Printf("Result file polling cancelled: %v", ctx.Err())
 			return
+		case <-channels.stopPolling:
+			return
+		case <-finalWindow:
+			log.Printf("Approaching max wait time; polling for result file every %s", finalInterval)
+			ticker.Reset(finalInterval)
+			finalWindow = nil
 		case <-ticker.C:
-			// Check for result file (fast local filesystem operation)
-			if _, err := os.Stat(r.resultsPath); err != nil {
-				if os.IsNotExist(err) {
-					continue
-				}
-				// Unexpected stat error (e.g., permission denied)
-				select {
-				case channels.error <- fmt.Errorf("failed to stat result file path=%s: %w", r.resultsPath, err):
-				case <-channels.done:
-					return
-				}
+			if r.checkResultFile(ctx, channels) {
 				return
 			}
-
-			log.Printf("Result file found, parsing...")
-			adapterResult, err := r.parser.ParseFile(r.resultsPath)
-			if err != nil {
-				select {
-				case channels.error <- err:
-				case <-channels.done:
-					return
-				}
+		case <-channels.checkNow:
+			log.Printf("Adapter container status changed, checking result file immediately")
+			if r.checkResultFile(ctx, channels) {
 				return
 			}
-
-			log.Printf("Result parsed successfully: status=%s, reason=%s", adapterResult.Status, adapterResult.Reason)
-			select {
-			case channels.result <- adapterResult:
-			case <-channels.done:
+		case <-fileChanged:
+			if r.checkResultFile(ctx, channels) {
 				return
 			}
-			return
 		}
 	}
 }
 
+// checkResultFile checks for the result file and parses it when present.
+// Returns true once a result or error has been delivered (or shutdown began), false to keep polling.
+func (r *StatusReporter) checkResultFile(ctx context.Context, channels *pollChannels) bool {
+	// A named pipe is read instead of polled; reading blocks until the adapter writes
+	if r.isResultPipe() {
+		r.readResultPipe(ctx, channels)
+		return true
+	}
+
+	// Check for result file (fast local filesystem operation)
+	if _, err := r.statResultFile(); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return false
+		}
+		// Unexpected stat error (e.g., permission denied)
+		select {
+		case channels.error <- fmt.Errorf("failed to stat result file path=%s: %w", r.resultsPath, err):
+		case <-channels.done:
+		}
+		return true
+	}
+
+	log.Printf("Result file found, parsing...")
+	r.phases.mark(&r.phases.resultFound)
+	adapterResult, err := r.parseResultFile()
+	if err != nil && r.parseSettleDelay > 0 && !r.parseSettled {
+		// The first parse error most likely means the adapter is still writing the file
+		r.parseSettled = true
+		log.Printf("Result file could not be parsed (%v); retrying once in %s in case it is still being written",
+			err, r.parseSettleDelay)
+		timer := time.NewTimer(r.parseSettleDelay)
+		select {
+		case <-channels.done:
+			timer.Stop()
+			return true
+		case <-timer.C:
+		}
+		adapterResult, err = r.parseResultFile()
+	}
+	if r.projectedVolume && errors.Is(err, os.ErrNotExist) {
+		// The key was removed from the volume between the check and the read
+		return false
+	}
+	if err != nil {
+		select {
+		case channels.error <- err:
+		case <-channels.done:
+		}
+		return true
+	}
+
+	if r.isIntermediate(adapterResult) {
+		if adapterResult.Reason != r.lastNonTerminalReason {
+			r.lastNonTerminalReason = adapterResult.Reason
+			log.Printf("Intermediate result: status=%s, reason=%s, message=%s; waiting for a terminal result",
+				adapterResult.Status, adapterResult.Reason, adapterResult.Message)
+		}
+		if r.progressUpdates {
+			r.reportProgress(ctx, adapterResult)
+		}
+		return false
+	}
+
+	log.Printf("Result parsed successfully: status=%s, reason=%s", adapterResult.Status, adapterResult.Reason)
+	r.fileResult.Store(adapterResult)
+	select {
+	case channels.result <- adapterResult:
+	case <-channels.done:
+	}
+	return true
+}
+
 // checkContainerStatus checks if the adapter container has terminated.
 // Returns true if terminated (and sends notification), false otherwise.
+// With termination debouncing enabled, the terminated state must be seen on two consecutive checks.
 func (r *StatusReporter) checkContainerStatus(ctx context.Context, channels *pollChannels) bool {
-	containerStatus, err := r.k8sClient.GetAdapterContainerStatus(ctx, r.podName, r.adapterContainerName)
+	deletionTimestamp, containerStatus, err := r.observePod(ctx)
 	if err != nil {
-		log.Printf("Warning: failed to get container status pod=%s container=%s: %v",
-			r.podName, r.adapterContainerName, err)
+		r.monitorLog.Printf("Warning: failed to get container status pod=%s container=%s: %v",
+			r.podName, r.containerName(), err)
 		return false
 	}
+	r.monitorLog.Flush()
+
+	if deletionTimestamp != nil {
+		return r.notifyPodTerminating(deletionTimestamp, channels)
+	}
+	return r.handleContainerStatus(ctx, containerStatus, channels)
+}
+
+// observePod returns the adapter container status and, when pod termination handling is enabled,
+// the pod's deletion timestamp, both from a single pod GET. A pod being deleted is returned without
+// its container status.
+func (r *StatusReporter) observePod(ctx context.Context) (*metav1.Time, *corev1.ContainerStatus, error) {
+	if !r.exitOnPodTerminating {
+		containerStatus, err := r.getAdapterContainerStatus(ctx)
+		return nil, containerStatus, err
+	}
+
+	pod, err := r.k8sClient.GetPod(ctx, r.podName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if pod.DeletionTimestamp != nil {
+		return pod.DeletionTimestamp, nil, nil
+	}
+	name := r.containerName()
+	containerStatus, err := k8s.AdapterContainerStatus(pod, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	r.rememberAdapterContainer(name, containerStatus)
+	return nil, containerStatus, nil
+}
 
-	if containerStatus != nil && containerStatus.State.Terminated != nil {
-		log.Printf("Container terminated: pod=%s container=%s reason=%s exitCode=%d",
-			r.podName, r.adapterContainerName,
-			containerStatus.State.Terminated.Reason,
-			containerStatus.State.Terminated.ExitCode)
+// checkInitialContainerStatus performs the first container status check, retrying failed lookups
+// a bounded number of times. Container statuses populate asynchronously after pod start, so an
+// early "not found" is expected and only logged once the retries are exhausted.
+func (r *StatusReporter) checkInitialContainerStatus(ctx context.Context, channels *pollChannels) bool {
+	for attempt := 0; ; attempt++ {
+		deletionTimestamp, containerStatus, err := r.observePod(ctx)
+		if err == nil {
+			if deletionTimestamp != nil {
+				return r.notifyPodTerminating(deletionTimestamp, channels)
+			}
+			return r.handleContainerStatus(ctx, containerStatus, channels)
+		}
+
+		if attempt >= r.initialStatusRetries {
+			r.monitorLog.Printf("Warning: failed to get container status pod=%s container=%s after %d attempt(s): %v",
+				r.podName, r.containerName(), attempt+1, err)
+			return false
+		}
+
+		timer := time.NewTimer(r.initialStatusRetryDelay)
 		select {
-		case channels.terminated <- containerStatus.State.Terminated:
 		case <-channels.done:
+			timer.Stop()
+			return false
+		case <-ctx.Done():
+			timer.Stop()
+			return false
+		case <-timer.C:
 		}
-		return true
 	}
-	return false
+}
+
+// handleContainerStatus acts on an observed container status.
+// Returns true if terminated (and sends notification), false otherwise.
+func (r *StatusReporter) handleContainerStatus(ctx context.Context, containerStatus *corev1.ContainerStatus, channels *pollChannels) bool {
+	r.notifyContainerStateChange(containerStatus, channels)
+
+	if containerStatus != nil && containerStatus.State.Running != nil {
+		r.phases.mark(&r.phases.containerRunning)
+	}
+
+	if containerStatus == nil || containerStatus.State.Terminated == nil {
+		r.terminationObserved = false
+		if containerStatus == nil || containerStatus.State.Running == nil {
+			// The adapter has not started; a failed init container would keep it from ever running
+			return r.checkInitContainers(ctx, channels)
+		}
+		return false
+	}
+
+	if r.debounceTermination && !r.terminationObserved {
+		r.terminationObserved = true
+		log.Printf("Container reported terminated: pod=%s container=%s; waiting for the next check to confirm",
+			r.podName, r.containerName())
+		return false
+	}
+
+	log.Printf("Container terminated: pod=%s container=%s reason=%s exitCode=%d",
+		r.podName, r.containerName(),
+		containerStatus.State.Terminated.Reason,
+		containerStatus.State.Terminated.ExitCode)
+	if r.stopPollingOnTermination {
+		// The result file can no longer appear once the adapter has exited without writing it
+		if _, err := r.statResultFile(); errors.Is(err, os.ErrNotExist) {
+			log.Printf("Adapter container terminated without a result file; stopping result file polling")
+			close(channels.stopPolling)
+		}
+	}
+	select {
+	case channels.terminated <- containerStatus.State.Terminated:
+	case <-channels.done:
+	}
+	return true
+}
+
+// notifyPodTerminating notifies Run that the pod is being deleted. Always returns true.
+func (r *StatusReporter) notifyPodTerminating(deletionTimestamp *metav1.Time, channels *pollChannels) bool {
+	log.Printf("Pod is terminating: pod=%s deletionTimestamp=%s", r.podName, deletionTimestamp.Format(time.RFC3339))
+	select {
+	case channels.podDeleted <- deletionTimestamp.Time:
+	case <-channels.done:
+	}
+	return true
+}
+
+// checkInitContainers checks whether an init container has failed.
+// Returns true if one has (and sends notification), false otherwise.
+func (r *StatusReporter) checkInitContainers(ctx context.Context, channels *pollChannels) bool {
+	initStatus, err := r.k8sClient.GetFailedInitContainerStatus(ctx, r.podName)
+	if err != nil {
+		r.monitorLog.Printf("Warning: failed to get init container status pod=%s: %v", r.podName, err)
+		return false
+	}
+	if initStatus == nil {
+		return false
+	}
+
+	log.Printf("Init container failed: pod=%s container=%s", r.podName, initStatus.Name)
+	select {
+	case channels.initFailed <- initStatus:
+	case <-channels.done:
+	}
+	return true
+}
+
+// notifyContainerStateChange asks the file poller for an immediate check when the observed
+// container state differs from the previous observation, so a result written while the
+// container is still running is picked up without waiting for the next poll tick.
+func (r *StatusReporter) notifyContainerStateChange(containerStatus *corev1.ContainerStatus, channels *pollChannels) {
+	if !r.checkOnContainerChange || containerStatus == nil {
+		return
+	}
+
+	state := containerStateSummary(containerStatus)
+	if state == r.lastContainerState {
+		return
+	}
+	r.lastContainerState = state
+
+	select {
+	case channels.checkNow <- struct{}{}:
+	default:
+		// A check is already pending
+	}
+}
+
+// containerStateSummary condenses the parts of a container status that signal adapter progress
+func containerStateSummary(cs *corev1.ContainerStatus) string {
+	state := "waiting"
+	switch {
+	case cs.State.Terminated != nil:
+		state = "terminated"
+	case cs.State.Running != nil:
+		state = "running"
+	}
+	return fmt.Sprintf("%s/ready=%t/restarts=%d", state, cs.Ready, cs.RestartCount)
 }
 
 // monitorContainerStatus monitors the adapter container status at regular intervals.
@@ -232,11 +872,15 @@ func (r *StatusReporter) checkContainerStatus(ctx context.Context, channels *pol
 func (r *StatusReporter) monitorContainerStatus(ctx context.Context, channels *pollChannels, wg *sync.WaitGroup) {
 	defer wg.Done()
 
-	log.Printf("Monitoring container status for pod=%s container=%s (interval: %s)...",
-		r.podName, r.adapterContainerName, r.containerStatusCheckInterval)
+	if !r.quietStartup {
+		log.Printf("Monitoring container status for pod=%s container=%s (interval: %s)...",
+			r.podName, r.containerName(), r.containerStatusCheckInterval)
+	}
+
+	defer r.monitorLog.Flush()
 
 	// Perform immediate check before starting ticker
-	if r.checkContainerStatus(ctx, channels) {
+	if r.checkInitialContainerStatus(ctx, channels) {
 		return
 	}
 
@@ -259,6 +903,75 @@ func (r *StatusReporter) monitorContainerStatus(ctx context.Context, channels *p
 	}
 }
 
+// containerName returns the configured or resolved adapter container name (empty means auto-detect)
+func (r *StatusReporter) containerName() string {
+	r.containerNameMu.Lock()
+	defer r.containerNameMu.Unlock()
+	return r.adapterContainerName
+}
+
+// getAdapterContainerStatus fetches the adapter container status. In single-adapter mode
+// the auto-detected container name is cached after the first successful lookup so later
+// checks resolve the container directly instead of re-scanning the pod.
+func (r *StatusReporter) getAdapterContainerStatus(ctx context.Context) (*corev1.ContainerStatus, error) {
+	name := r.containerName()
+
+	containerStatus, err := r.k8sClient.GetAdapterContainerStatus(ctx, r.podName, name)
+	if err != nil {
+		return nil, err
+	}
+	r.rememberAdapterContainer(name, containerStatus)
+
+	return containerStatus, nil
+}
+
+// rememberAdapterContainer caches the auto-detected adapter container name in single-adapter mode
+func (r *StatusReporter) rememberAdapterContainer(name string, containerStatus *corev1.ContainerStatus) {
+	if r.singleAdapter && name == "" && containerStatus != nil && containerStatus.Name != "" {
+		r.containerNameMu.Lock()
+		r.adapterContainerName = containerStatus.Name
+		r.containerNameMu.Unlock()
+		log.Printf("Resolved adapter container: pod=%s container=%s", r.podName, containerStatus.Name)
+	}
+}
+
+// handleTermination handles the adapter's exit seen by Run. A result delivered around the exit,
+// by the poller, the result endpoint or the result pipe, takes precedence over the result file and
+// exit code, and a success result is checked against the exit as it would have been had it
+// arrived first.
+func (r *StatusReporter) handleTermination(ctx context.Context, terminated *corev1.ContainerStateTerminated, channels *pollChannels, pipedResults bool) error {
+	var adapterResult *result.AdapterResult
+	select {
+	case adapterResult = <-channels.result:
+	default:
+		if pipedResults {
+			timer := time.NewTimer(pipedResultGrace)
+			select {
+			case adapterResult = <-channels.result:
+			case <-timer.C:
+			case <-ctx.Done():
+			}
+			timer.Stop()
+		}
+	}
+	if adapterResult == nil {
+		return r.HandleTermination(ctx, terminated)
+	}
+
+	log.Printf("Adapter container terminated: reason=%s, exitCode=%d; using the result delivered with it", terminated.Reason, terminated.ExitCode)
+	if terminated.ExitCode != 0 && r.conditionFromResult(adapterResult).Status == ConditionStatusTrue {
+		if r.confirmSuccessStable {
+			log.Printf("Adapter container exited with code %d after reporting success; not confirming success", terminated.ExitCode)
+			return r.UpdateFromTerminatedContainer(ctx, terminated)
+		}
+		if r.cleanupFailurePolicy == CleanupFailurePolicyEscalate {
+			log.Printf("Adapter container exited with code %d after writing a success result; escalating to failure", terminated.ExitCode)
+			return r.updateFromTerminatedContainer(ctx, terminated, "after writing a success result")
+		}
+	}
+	return r.UpdateFromResult(ctx, adapterResult)
+}
+
 // HandleTermination handles container termination by checking for result file first.
 // Priority order:
 // 1. If valid result file exists -> use it (adapter's intended status)
@@ -275,29 +988,168 @@ func (r *StatusReporter) HandleTermination(ctx context.Context, terminated *core
 
 	case errors.Is(err, os.ErrNotExist):
 		// Expected: adapter terminated without producing result file
+		if adapterResult := r.terminationMessageResult(terminated); adapterResult != nil {
+			log.Printf("Using termination message: status=%s, reason=%s", adapterResult.Status, adapterResult.Reason)
+			return r.UpdateFromResult(ctx, adapterResult)
+		}
 		log.Printf("No result file found, using container exit code")
 
+	case errors.Is(err, errNonTerminalResult):
+		// Adapter exited without replacing its intermediate result
+		log.Printf("Result file still holds an intermediate result (%v), using container exit code", err)
+
 	case err != nil:
 		// Unexpected: file exists but can't read/parse it
 		log.Printf("Warning: result file error: %v. Falling back to container exit code", err)
+		if r.noteParseFailure && errors.Is(err, errResultParseFailed) {
+			return r.updateFromTerminatedContainer(ctx, terminated, fmt.Sprintf("a result file was present but unusable: %v", err))
+		}
 	}
 
 	// No valid result file, update based on container termination state
 	return r.UpdateFromTerminatedContainer(ctx, terminated)
 }
 
+// terminationMessageResult parses the adapter container's termination message (what it wrote to
+// its terminationMessagePath) as a result, when that is enabled. It returns nil when there is no
+// message or it is not a terminal result, so the exit code is used instead.
+func (r *StatusReporter) terminationMessageResult(terminated *corev1.ContainerStateTerminated) *result.AdapterResult {
+	if !r.useTerminationMessage || strings.TrimSpace(terminated.Message) == "" {
+		return nil
+	}
+
+	adapterResult, err := r.parser.Parse([]byte(terminated.Message))
+	if err != nil {
+		log.Printf("Termination message is not a valid result (%v), using container exit code", err)
+		return nil
+	}
+	if r.isIntermediate(adapterResult) {
+		log.Printf("Termination message holds an intermediate result (reason=%s), using container exit code", adapterResult.Reason)
+		return nil
+	}
+	return adapterResult
+}
+
+// statResultFile stats the result file. When a maximum result age is configured, a file
+// last modified before the reporter's start time minus that age is reported as not existing
+// so leftovers from a previous run on a reused volume are ignored. When a checksum file is
+// required, the result file is reported as not existing until the checksum file does, and
+// likewise for the done marker file.
+func (r *StatusReporter) statResultFile() (os.FileInfo, error) {
+	if err := r.statDoneFile(); err != nil {
+		return nil, err
+	}
+
+	if r.resultGlob {
+		_, err := r.statResultGlob(false)
+		return nil, err
+	}
+	if len(r.resultChecks) > 0 {
+		_, err := r.statResultChecks(false)
+		return nil, err
+	}
+
+	info, err := os.Stat(r.resultsPath)
+	if err == nil && info.Mode()&os.ModeNamedPipe != 0 {
+		// A pipe holds no result to parse; its content is consumed by readResultPipe
+		return nil, fmt.Errorf("result path=%s is a named pipe: %w", r.resultsPath, os.ErrNotExist)
+	}
+	if err != nil {
+		return info, err
+	}
+
+	// A ConfigMap key may be published empty before the adapter sets the result
+	if r.projectedVolume && info.Size() == 0 {
+		return nil, fmt.Errorf("empty result file path=%s: %w", r.resultsPath, os.ErrNotExist)
+	}
+
+	if r.maxResultAge > 0 {
+		cutoff := r.startTime.Add(-r.maxResultAge)
+		if info.ModTime().Before(cutoff) {
+			if r.staleResultLogged.CompareAndSwap(false, true) {
+				log.Printf("Ignoring stale result file path=%s modified=%s (older than %s before start)",
+					r.resultsPath, info.ModTime().Format(time.RFC3339), r.maxResultAge)
+			}
+			return nil, fmt.Errorf("stale result file path=%s: %w", r.resultsPath, os.ErrNotExist)
+		}
+	}
+
+	// A required checksum file is written last, so until it exists the result may be incomplete
+	if r.checksumSuffix != "" {
+		if _, err := os.Stat(r.resultsPath + r.checksumSuffix); err != nil {
+			return nil, fmt.Errorf("checksum file path=%s: %w", r.resultsPath+r.checksumSuffix, err)
+		}
+	}
+
+	return info, nil
+}
+
+// statDoneFile checks for the done marker file, when one is required, reporting a missing
+// marker as os.ErrNotExist
+func (r *StatusReporter) statDoneFile() error {
+	if r.doneFile == "" {
+		return nil
+	}
+	if _, err := os.Stat(r.doneFile); err != nil {
+		return fmt.Errorf("done file path=%s: %w", r.doneFile, err)
+	}
+	return nil
+}
+
+// parseResultFile parses the result file, or aggregates the files matching the result glob or
+// the check files of the results directory
+func (r *StatusReporter) parseResultFile() (*result.AdapterResult, error) {
+	if len(r.resultChecks) > 0 {
+		present, err := r.statResultChecks(true)
+		if err != nil {
+			return nil, err
+		}
+		return r.parseResultChecks(present)
+	}
+	if r.projectedVolume && !r.resultGlob {
+		return r.parseProjectedResult()
+	}
+	if !r.resultGlob {
+		return r.parser.ParseFile(r.resultsPath)
+	}
+	matches, err := r.statResultGlob(true)
+	if err != nil {
+		return nil, err
+	}
+	return r.parseResultGlob(matches)
+}
+
 // tryParseResultFile attempts to read and parse the result file.
 // Returns (nil, os.ErrNotExist) if file doesn't exist, or (nil, err) for other errors.
 func (r *StatusReporter) tryParseResultFile() (*result.AdapterResult, error) {
-	if _, err := os.Stat(r.resultsPath); err != nil {
-		return nil, err // Could be ErrNotExist or permission error
+	// Without the done marker, whatever was written may be incomplete
+	if err := r.statDoneFile(); err != nil {
+		return nil, err
 	}
 
-	adapterResult, err := r.parser.ParseFile(r.resultsPath)
+	if r.resultGlob {
+		// The adapters are done; aggregate whatever results were written
+		if _, err := r.statResultGlob(true); err != nil {
+			return nil, err
+		}
+	} else if len(r.resultChecks) > 0 {
+		if _, err := r.statResultChecks(true); err != nil {
+			return nil, err
+		}
+	} else if _, err := r.statResultFile(); err != nil {
+		return nil, err // Could be ErrNotExist (including stale files) or permission error
+	}
+
+	adapterResult, err := r.parseResultFile()
 	if err != nil {
-		return nil, fmt.Errorf("parse failed: %w", err)
+		return nil, fmt.Errorf("%w: %w", errResultParseFailed, err)
+	}
+
+	if r.isIntermediate(adapterResult) {
+		return nil, fmt.Errorf("%w: reason=%s", errNonTerminalResult, adapterResult.Reason)
 	}
 
+	r.phases.mark(&r.phases.resultFound)
 	return adapterResult, nil
 }
 
@@ -305,81 +1157,321 @@ func (r *StatusReporter) tryParseResultFile() (*result.AdapterResult, error) {
 func (r *StatusReporter) UpdateFromResult(ctx context.Context, adapterResult *result.AdapterResult) error {
 	log.Printf("Updating Job status from adapter result...")
 
+	condition := r.conditionFromResult(adapterResult)
+	eventType := corev1.EventTypeNormal
+	if condition.Status != ConditionStatusTrue {
+		eventType = corev1.EventTypeWarning
+	}
+	r.k8sClient.RecordEvent(ctx, eventType, EventReasonResultReceived,
+		fmt.Sprintf("Adapter result received: status=%s reason=%s: %s", adapterResult.Status, adapterResult.Reason, adapterResult.Message))
+
+	r.reportedCorrelation = r.correlation.withResult(adapterResult)
+	err := r.updateJobStatus(ctx, condition, additionalConditions(condition.Type, adapterResult)...)
+	r.reportedResult = adapterResult
+	if err != nil {
+		return fmt.Errorf("failed to update job status: pod=%s condition=%s: %w", r.podName, condition.Type, err)
+	}
+
+	log.Printf("Job status updated successfully: %s=%s (reason: %s)", condition.Type, condition.Status, condition.Reason)
+	return nil
+}
+
+// conditionFromResult maps an adapter result onto the Job condition
+func (r *StatusReporter) conditionFromResult(adapterResult *result.AdapterResult) k8s.JobCondition {
 	conditionStatus := ConditionStatusTrue
-	if !adapterResult.IsSuccess() {
+	if adapterResult.IsUnknown() {
+		conditionStatus = ConditionStatusUnknown
+	} else if !adapterResult.IsSuccess() {
 		conditionStatus = ConditionStatusFalse
+		if !r.expectFailure && r.belowMinFailureSeverity(adapterResult) {
+			conditionStatus = ConditionStatusUnknown
+		}
 	}
 
 	condition := k8s.JobCondition{
-		Type:    r.conditionType,
+		Type:    r.conditionTypeFor(adapterResult.Reason),
 		Status:  conditionStatus,
 		Reason:  adapterResult.Reason,
 		Message: adapterResult.Message,
 	}
 
-	if err := r.k8sClient.UpdateJobStatus(ctx, condition); err != nil {
-		return fmt.Errorf("failed to update job status: pod=%s condition=%s: %w", r.podName, r.conditionType, err)
+	if r.degradedRule != nil && adapterResult.IsSuccess() && r.degradedRule.matches(adapterResult) {
+		condition = r.degradedRule.apply(condition)
 	}
 
-	log.Printf("Job status updated successfully: %s=%s (reason: %s)", r.conditionType, conditionStatus, adapterResult.Reason)
-	return nil
+	if r.expectFailure {
+		condition = invertCondition(condition)
+	}
+
+	return condition
+}
+
+// additionalConditions returns the conditions the adapter returned besides its status. A condition
+// of the reported condition type is dropped, since the status decides that one.
+func additionalConditions(conditionType string, adapterResult *result.AdapterResult) []k8s.JobCondition {
+	var conditions []k8s.JobCondition
+	for _, c := range adapterResult.Conditions {
+		if c.Type == conditionType {
+			log.Printf("Warning: ignoring adapter condition %s, which is set from the result status", c.Type)
+			continue
+		}
+		conditions = append(conditions, k8s.JobCondition{Type: c.Type, Status: c.Status, Reason: c.Reason, Message: c.Message})
+	}
+	return conditions
+}
+
+// belowMinFailureSeverity reports whether a failure's severity ranks below the configured
+// minimum. Failures without a recognized severity are never below it.
+func (r *StatusReporter) belowMinFailureSeverity(adapterResult *result.AdapterResult) bool {
+	if r.minFailureSeverity == "" {
+		return false
+	}
+	threshold, _ := result.SeverityRank(r.minFailureSeverity)
+	rank, ok := result.SeverityRank(adapterResult.EffectiveSeverity())
+	return ok && rank < threshold
+}
+
+// invertCondition flips the condition status for negative-test adapters that are expected to fail.
+// The reason records that inversion was applied; the adapter's own reason is kept in the message.
+// An Unknown condition is kept, since neither outcome was observed.
+func invertCondition(condition k8s.JobCondition) k8s.JobCondition {
+	if condition.Status == ConditionStatusUnknown {
+		return condition
+	}
+	if condition.Status == ConditionStatusFalse {
+		condition.Status = ConditionStatusTrue
+		condition.Message = fmt.Sprintf("Adapter failed as expected (reason: %s): %s", condition.Reason, condition.Message)
+		condition.Reason = ReasonAdapterFailedAsExpected
+	} else {
+		condition.Status = ConditionStatusFalse
+		condition.Message = fmt.Sprintf("Adapter succeeded but failure was expected (reason: %s): %s", condition.Reason, condition.Message)
+		condition.Reason = ReasonAdapterSucceededUnexpectedly
+	}
+	return condition
 }
 
-// UpdateFromError updates Job status when parsing fails
+// UpdateFromError updates Job status when parsing fails.
+// Malformed JSON is reported as InvalidResultSyntax; any other failure (including
+// contract violations in well-formed JSON) is reported as InvalidResultFormat.
 func (r *StatusReporter) UpdateFromError(ctx context.Context, err error) error {
 	log.Printf("Failed to parse result file: %v", err)
 
+	reason := ReasonInvalidResultFormat
+	var syntaxErr *result.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		reason = ReasonInvalidResultSyntax
+	}
+
 	condition := k8s.JobCondition{
-		Type:    r.conditionType,
+		Type:    r.conditionTypeFor(reason),
 		Status:  ConditionStatusFalse,
-		Reason:  ReasonInvalidResultFormat,
+		Reason:  reason,
 		Message: fmt.Sprintf("Failed to parse adapter result: %v", err),
 	}
+	r.k8sClient.RecordEvent(ctx, corev1.EventTypeWarning, reason, condition.Message)
 
-	if updateErr := r.k8sClient.UpdateJobStatus(ctx, condition); updateErr != nil {
+	if updateErr := r.updateJobStatus(ctx, condition); updateErr != nil {
 		return fmt.Errorf("failed to update job status: %w", updateErr)
 	}
 
-	log.Printf("Job status updated: %s=False (reason: %s)", r.conditionType, ReasonInvalidResultFormat)
+	log.Printf("Job status updated: %s=False (reason: %s)", condition.Type, reason)
 	return err
 }
 
 // UpdateFromTimeout updates Job status when timeout occurs.
-// As a last attempt, checks if container has terminated to provide more specific error info.
+// As a last attempt, checks if container has terminated to provide more specific error info,
+// preferring a valid result file over the container's termination state.
 func (r *StatusReporter) UpdateFromTimeout(ctx context.Context) error {
 	log.Printf("Timeout waiting for adapter results (max wait: %s)", r.maxWaitTime)
-	log.Printf("Checking adapter container status: pod=%s container=%s", r.podName, r.adapterContainerName)
 
-	containerStatus, err := r.k8sClient.GetAdapterContainerStatus(ctx, r.podName, r.adapterContainerName)
+	if r.timeoutGrowthGrace > 0 && r.waitForGrowingResult(ctx) {
+		adapterResult, err := r.parseResultFile()
+		if err != nil {
+			return r.UpdateFromError(ctx, err)
+		}
+		if !r.isIntermediate(adapterResult) {
+			return r.UpdateFromResult(ctx, adapterResult)
+		}
+	}
+
+	log.Printf("Checking adapter container status: pod=%s container=%s", r.podName, r.containerName())
+
+	containerStatus, err := r.getAdapterContainerStatus(ctx)
 	if err != nil {
 		log.Printf("Warning: failed to get container status pod=%s container=%s: %v",
-			r.podName, r.adapterContainerName, err)
+			r.podName, r.containerName(), err)
 	} else if containerStatus != nil && containerStatus.State.Terminated != nil {
-		return r.UpdateFromTerminatedContainer(ctx, containerStatus.State.Terminated)
+		// Same precedence as normal termination: a valid result file wins over the
+		// container state, including an OOMKilled reason
+		return r.HandleTermination(ctx, containerStatus.State.Terminated)
+	} else if containerStatus == nil || containerStatus.State.Running == nil {
+		// The adapter never started; report a failed init container instead of a bare timeout
+		if initStatus, initErr := r.k8sClient.GetFailedInitContainerStatus(ctx, r.podName); initErr != nil {
+			log.Printf("Warning: failed to get init container status pod=%s: %v", r.podName, initErr)
+		} else if initStatus != nil {
+			return r.UpdateFromInitContainerFailure(ctx, initStatus)
+		}
 	}
 
+	return r.updateFromTimeoutCondition(ctx)
+}
+
+// updateFromTimeoutCondition reports that the adapter did not finish within the maximum wait time
+func (r *StatusReporter) updateFromTimeoutCondition(ctx context.Context) error {
+	status := ConditionStatusFalse
+	if r.timeoutStatus != "" {
+		status = r.timeoutStatus
+	}
 	condition := k8s.JobCondition{
-		Type:    r.conditionType,
-		Status:  ConditionStatusFalse,
+		Type:    r.conditionTypeFor(ReasonAdapterTimeout),
+		Status:  status,
 		Reason:  ReasonAdapterTimeout,
 		Message: fmt.Sprintf("Adapter did not produce results within %s", r.maxWaitTime),
 	}
+	r.k8sClient.RecordEvent(ctx, corev1.EventTypeWarning, ReasonAdapterTimeout, condition.Message)
 
-	if err := r.k8sClient.UpdateJobStatus(ctx, condition); err != nil {
+	if err := r.updateJobStatus(ctx, condition); err != nil {
 		return fmt.Errorf("failed to update job status: %w", err)
 	}
 
-	log.Printf("Job status updated: %s=False (reason: %s)", r.conditionType, ReasonAdapterTimeout)
+	log.Printf("Job status updated: %s=%s (reason: %s)", condition.Type, status, ReasonAdapterTimeout)
 	return errors.New("timeout waiting for adapter results")
 }
 
+// waitForGrowingResult checks whether the result file is still being written at the deadline and,
+// if so, waits for its size to stop changing, for at most the timeout growth grace. It returns
+// true when the file grew, meaning it should be parsed rather than reported as a timeout.
+func (r *StatusReporter) waitForGrowingResult(ctx context.Context) bool {
+	size, ok := r.resultFileSize()
+	if !ok {
+		return false
+	}
+
+	interval := min(r.pollInterval, r.timeoutGrowthGrace)
+	graceEnd := time.Now().Add(r.timeoutGrowthGrace)
+	grew := false
+	for {
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return false
+		case <-timer.C:
+		}
+
+		next, ok := r.resultFileSize()
+		if !ok {
+			return false
+		}
+		if next <= size {
+			return grew
+		}
+		if !grew {
+			log.Printf("Result file is still growing at the deadline; waiting up to %s for the write to complete", r.timeoutGrowthGrace)
+		}
+		grew = true
+		size = next
+		if !time.Now().Before(graceEnd) {
+			log.Printf("Result file still growing after %s; parsing it as is", r.timeoutGrowthGrace)
+			return true
+		}
+	}
+}
+
+// resultFileSize returns the size of the result file, or false if it cannot be stat'ed
+func (r *StatusReporter) resultFileSize() (int64, bool) {
+	info, err := os.Stat(r.resultsPath)
+	if err != nil {
+		return 0, false
+	}
+	return info.Size(), true
+}
+
+// HandlePodTerminating makes one final best-effort status update when the pod is being deleted,
+// using a valid result file if one exists, so the reporter exits with the pod instead of waiting out the timeout
+func (r *StatusReporter) HandlePodTerminating(ctx context.Context, deletedAt time.Time) error {
+	if adapterResult, err := r.tryParseResultFile(); err == nil {
+		log.Printf("Pod is terminating; using result file: status=%s, reason=%s", adapterResult.Status, adapterResult.Reason)
+		return r.UpdateFromResult(ctx, adapterResult)
+	}
+	return r.updateFromPodTerminating(ctx, deletedAt, "the adapter produced results")
+}
+
+// updateFromPodTerminating reports the pod's deletion as a failure; before says what the deletion
+// came before, e.g. "the adapter produced results"
+func (r *StatusReporter) updateFromPodTerminating(ctx context.Context, deletedAt time.Time, before string) error {
+	condition := k8s.JobCondition{
+		Type:    r.conditionTypeFor(ReasonPodTerminating),
+		Status:  ConditionStatusFalse,
+		Reason:  ReasonPodTerminating,
+		Message: fmt.Sprintf("Pod was deleted at %s before %s", deletedAt.UTC().Format(time.RFC3339), before),
+	}
+
+	if err := r.updateJobStatus(ctx, condition); err != nil {
+		return fmt.Errorf("failed to update job status: %w", err)
+	}
+
+	log.Printf("Job status updated: %s=False (reason: %s)", condition.Type, ReasonPodTerminating)
+	return fmt.Errorf("pod terminating before %s", before)
+}
+
+// memoryLimit returns the adapter container's memory limit for OOM reports, or "" when reporting
+// it is disabled, no limit is set or it cannot be read
+func (r *StatusReporter) memoryLimit(ctx context.Context) string {
+	if !r.reportMemoryLimit {
+		return ""
+	}
+
+	limit, err := r.k8sClient.GetContainerMemoryLimit(ctx, r.podName, r.containerName())
+	if err != nil {
+		log.Printf("Warning: failed to get adapter container memory limit pod=%s: %v", r.podName, err)
+		return ""
+	}
+	if limit == nil {
+		return ""
+	}
+	return limit.String()
+}
+
+// UpdateFromInitContainerFailure updates Job status when an init container failed, which
+// prevents the adapter container from ever running
+func (r *StatusReporter) UpdateFromInitContainerFailure(ctx context.Context, initStatus *corev1.ContainerStatus) error {
+	message := fmt.Sprintf("Init container %s failed", initStatus.Name)
+	if terminated := k8s.InitContainerTermination(initStatus); terminated != nil {
+		message = fmt.Sprintf("Init container %s exited with code %d: %s", initStatus.Name, terminated.ExitCode, terminated.Reason)
+	}
+
+	condition := k8s.JobCondition{
+		Type:    r.conditionTypeFor(ReasonInitContainerFailed),
+		Status:  ConditionStatusFalse,
+		Reason:  ReasonInitContainerFailed,
+		Message: message,
+	}
+
+	if err := r.updateJobStatus(ctx, condition); err != nil {
+		return fmt.Errorf("failed to update job status: %w", err)
+	}
+
+	log.Printf("Job status updated: %s=False (reason: %s)", condition.Type, ReasonInitContainerFailed)
+	return errors.New(message)
+}
+
 // UpdateFromTerminatedContainer updates Job status from container termination state
 func (r *StatusReporter) UpdateFromTerminatedContainer(ctx context.Context, terminated *corev1.ContainerStateTerminated) error {
+	return r.updateFromTerminatedContainer(ctx, terminated, "")
+}
+
+// updateFromTerminatedContainer reports the container termination state, appending note to the
+// message when set
+func (r *StatusReporter) updateFromTerminatedContainer(ctx context.Context, terminated *corev1.ContainerStateTerminated, note string) error {
 	var reason, message string
 
 	if terminated.Reason == ContainerReasonOOMKilled {
 		reason = ReasonAdapterOOMKilled
 		message = "Adapter container was killed due to out of memory (OOMKilled)"
+		if limit := r.memoryLimit(ctx); limit != "" {
+			message = fmt.Sprintf("Adapter container was killed due to out of memory (OOMKilled, limit: %s)", limit)
+		}
 	} else if terminated.ExitCode != 0 {
 		reason = ReasonAdapterExitedWithError
 		message = fmt.Sprintf("Adapter container exited with code %d: %s", terminated.ExitCode, terminated.Reason)
@@ -387,20 +1479,26 @@ func (r *StatusReporter) UpdateFromTerminatedContainer(ctx context.Context, term
 		reason = ReasonAdapterMissingResults
 		message = fmt.Sprintf("Adapter container exited successfully (code 0) but did not produce a valid result file: %s", terminated.Reason)
 	}
+	if note != "" {
+		message = fmt.Sprintf("%s (%s)", message, note)
+	}
 
 	log.Printf("Adapter container terminated: reason=%s, exitCode=%d", terminated.Reason, terminated.ExitCode)
 
 	condition := k8s.JobCondition{
-		Type:    r.conditionType,
+		Type:    r.conditionTypeFor(reason),
 		Status:  ConditionStatusFalse,
 		Reason:  reason,
 		Message: message,
 	}
+	if reason == ReasonAdapterOOMKilled {
+		r.k8sClient.RecordEvent(ctx, corev1.EventTypeWarning, reason, message)
+	}
 
-	if err := r.k8sClient.UpdateJobStatus(ctx, condition); err != nil {
+	if err := r.updateJobStatus(ctx, condition); err != nil {
 		return fmt.Errorf("failed to update job status: %w", err)
 	}
 
-	log.Printf("Job status updated: %s=False (reason: %s)", r.conditionType, reason)
+	log.Printf("Job status updated: %s=False (reason: %s)", condition.Type, reason)
 	return fmt.Errorf("adapter container terminated: %s", message)
 }
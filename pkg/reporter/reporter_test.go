@@ -1,20 +1,38 @@
 package reporter_test
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/openshift-hyperfleet/status-reporter/pkg/fleet"
 	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
 	"github.com/openshift-hyperfleet/status-reporter/pkg/reporter"
 	"github.com/openshift-hyperfleet/status-reporter/pkg/reporter/testhelpers"
 	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/retry"
 )
 
 var _ = Describe("Reporter", func() {
@@ -47,6 +65,7 @@ var _ = Describe("Reporter", func() {
 			Expect(reporter.ReasonAdapterExitedWithError).To(Equal("AdapterExitedWithError"))
 			Expect(reporter.ReasonAdapterTimeout).To(Equal("AdapterTimeout"))
 			Expect(reporter.ReasonInvalidResultFormat).To(Equal("InvalidResultFormat"))
+			Expect(reporter.ReasonInvalidResultSyntax).To(Equal("InvalidResultSyntax"))
 		})
 	})
 
@@ -165,6 +184,303 @@ var _ = Describe("Reporter", func() {
 		})
 	})
 
+	Describe("expect failure mode", func() {
+		BeforeEach(func() {
+			r = reporter.NewReporterWithClient("/results/test.json", 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithExpectFailure(true))
+		})
+
+		It("reports an adapter failure as success", func() {
+			err := r.UpdateFromResult(ctx, &result.AdapterResult{
+				Status:  result.StatusFailure,
+				Reason:  "ValidationFailed",
+				Message: "Quota exceeded",
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal("True"))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterFailedAsExpected))
+			Expect(mock.LastUpdatedCondition.Message).To(ContainSubstring("ValidationFailed"))
+			Expect(mock.LastUpdatedCondition.Message).To(ContainSubstring("Quota exceeded"))
+		})
+
+		It("reports an adapter success as failure", func() {
+			err := r.UpdateFromResult(ctx, &result.AdapterResult{
+				Status:  result.StatusSuccess,
+				Reason:  "ValidationPassed",
+				Message: "All validations passed",
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal("False"))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterSucceededUnexpectedly))
+			Expect(mock.LastUpdatedCondition.Message).To(ContainSubstring("ValidationPassed"))
+		})
+
+		It("does not invert container failures", func() {
+			err := r.UpdateFromTerminatedContainer(ctx, &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1})
+
+			Expect(err).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal("False"))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
+		})
+	})
+
+	Describe("unknown outcomes", func() {
+		unknownResult := &result.AdapterResult{
+			Status:  result.StatusUnknown,
+			Reason:  "UpstreamUnreachable",
+			Message: "Could not reach the validation endpoint",
+		}
+
+		It("reports an unknown adapter result as Unknown", func() {
+			Expect(r.UpdateFromResult(ctx, unknownResult)).To(Succeed())
+
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusUnknown))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("UpstreamUnreachable"))
+		})
+
+		It("does not invert an unknown result in expect failure mode", func() {
+			r = reporter.NewReporterWithClient("/results/test.json", 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithExpectFailure(true))
+
+			Expect(r.UpdateFromResult(ctx, unknownResult)).To(Succeed())
+
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusUnknown))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("UpstreamUnreachable"))
+		})
+
+		It("reports a timeout as False by default", func() {
+			Expect(r.UpdateFromTimeout(ctx)).NotTo(Succeed())
+
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterTimeout))
+		})
+
+		It("reports a timeout with the configured status", func() {
+			r = reporter.NewReporterWithClient("/results/test.json", 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithTimeoutStatus(reporter.ConditionStatusUnknown))
+
+			Expect(r.UpdateFromTimeout(ctx)).NotTo(Succeed())
+
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusUnknown))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterTimeout))
+		})
+
+		It("sets an in-progress condition before the outcome", func() {
+			var conditions []k8s.JobCondition
+			mock.UpdateJobStatusFunc = func(ctx context.Context, condition k8s.JobCondition) error {
+				conditions = append(conditions, condition)
+				return nil
+			}
+			resultsPath := filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`), 0o644)).To(Succeed())
+			r = reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithInProgressCondition(true))
+
+			Expect(r.Run(ctx)).To(Succeed())
+
+			Expect(conditions).To(HaveLen(2))
+			Expect(conditions[0].Status).To(Equal(reporter.ConditionStatusUnknown))
+			Expect(conditions[0].Reason).To(Equal(reporter.ReasonAdapterRunning))
+			Expect(conditions[1].Status).To(Equal(reporter.ConditionStatusTrue))
+		})
+	})
+
+	Describe("progressing condition", func() {
+		var writes [][]k8s.JobCondition
+
+		BeforeEach(func() {
+			writes = nil
+			mock.UpdateJobStatusFunc = func(ctx context.Context, condition k8s.JobCondition) error {
+				writes = append(writes, []k8s.JobCondition{condition})
+				return nil
+			}
+			mock.UpdateJobConditionsFunc = func(ctx context.Context, conditions []k8s.JobCondition) error {
+				writes = append(writes, conditions)
+				return nil
+			}
+		})
+
+		It("sets Progressing=True at the start and False with the terminal condition", func() {
+			resultsPath := filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"failure","reason":"DNSFailed","message":"no records"}`), 0o644)).To(Succeed())
+			r = reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithProgressingCondition(true, ""))
+
+			Expect(r.Run(ctx)).To(Succeed())
+
+			Expect(writes).To(HaveLen(2))
+			Expect(writes[0]).To(HaveLen(1))
+			Expect(writes[0][0].Type).To(Equal("AvailableProgressing"))
+			Expect(writes[0][0].Status).To(Equal(reporter.ConditionStatusTrue))
+			Expect(writes[0][0].Reason).To(Equal(reporter.ReasonAdapterRunning))
+
+			Expect(writes[1]).To(HaveLen(2))
+			Expect(writes[1][0].Type).To(Equal("Available"))
+			Expect(writes[1][0].Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(writes[1][1].Type).To(Equal("AvailableProgressing"))
+			Expect(writes[1][1].Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(writes[1][1].Reason).To(Equal(reporter.ReasonAdapterFinished))
+			Expect(writes[1][1].Message).To(ContainSubstring("Available=False (DNSFailed)"))
+		})
+
+		It("uses the configured type and flips it on a timeout", func() {
+			r = reporter.NewReporterWithClient("/results/test.json", 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithProgressingCondition(true, "ValidationRunning"))
+
+			Expect(r.UpdateFromTimeout(ctx)).NotTo(Succeed())
+
+			Expect(writes).To(HaveLen(1))
+			Expect(writes[0][0].Reason).To(Equal(reporter.ReasonAdapterTimeout))
+			Expect(writes[0][1].Type).To(Equal("ValidationRunning"))
+			Expect(writes[0][1].Status).To(Equal(reporter.ConditionStatusFalse))
+		})
+
+		It("sets the progressing condition before reading a result from a reader", func() {
+			r = reporter.NewReporterWithClient("/results/test.json", 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithProgressingCondition(true, ""))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`))).To(Succeed())
+
+			Expect(writes).To(HaveLen(2))
+			Expect(writes[0][0].Status).To(Equal(reporter.ConditionStatusTrue))
+			Expect(writes[1][1].Status).To(Equal(reporter.ConditionStatusFalse))
+		})
+
+		It("is not set by default", func() {
+			Expect(r.UpdateFromTimeout(ctx)).NotTo(Succeed())
+
+			Expect(writes).To(HaveLen(1))
+			Expect(writes[0]).To(HaveLen(1))
+		})
+	})
+
+	Describe("correlation metadata", func() {
+		BeforeEach(func() {
+			r = reporter.NewReporterWithClient("/results/test.json", 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithCorrelation(reporter.Correlation{ID: "reconcile-7f3a", ObservedGeneration: 4}))
+		})
+
+		It("appends the configured metadata to the message and annotates the condition target", func() {
+			Expect(r.UpdateFromTimeout(ctx)).NotTo(Succeed())
+
+			Expect(mock.LastUpdatedCondition.Message).To(HaveSuffix(" [correlationId=reconcile-7f3a observedGeneration=4]"))
+			Expect(mock.TargetAnnotations).To(HaveKeyWithValue(k8s.CorrelationIDAnnotation, "reconcile-7f3a"))
+			Expect(mock.TargetAnnotations).To(HaveKeyWithValue(k8s.ObservedGenerationAnnotation, "4"))
+		})
+
+		It("prefers the values in the adapter result", func() {
+			adapterResult := &result.AdapterResult{
+				Status: result.StatusSuccess, Reason: "AllChecksPassed", Message: "ok",
+				CorrelationID: "reconcile-9b1c",
+			}
+			Expect(r.UpdateFromResult(ctx, adapterResult)).To(Succeed())
+
+			Expect(mock.LastUpdatedCondition.Message).To(Equal("ok [correlationId=reconcile-9b1c observedGeneration=4]"))
+			Expect(mock.TargetAnnotations).To(HaveKeyWithValue(k8s.CorrelationIDAnnotation, "reconcile-9b1c"))
+		})
+
+		It("keeps the metadata when the message is truncated", func() {
+			adapterResult := &result.AdapterResult{
+				Status: result.StatusFailure, Reason: "ChecksFailed", Message: strings.Repeat("x", 1024),
+			}
+			Expect(r.UpdateFromResult(ctx, adapterResult)).To(Succeed())
+
+			Expect(mock.LastUpdatedCondition.Message).To(HaveLen(1024))
+			Expect(mock.LastUpdatedCondition.Message).To(HaveSuffix(" [correlationId=reconcile-7f3a observedGeneration=4]"))
+		})
+
+		It("follows the message key=value suffix", func() {
+			r = reporter.NewReporterWithClient("/results/test.json", 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithMessageKVSuffix(reporter.MessageKVStatus),
+				reporter.WithCorrelation(reporter.Correlation{ID: "reconcile-7f3a"}))
+			Expect(r.UpdateFromTimeout(ctx)).NotTo(Succeed())
+
+			Expect(mock.LastUpdatedCondition.Message).To(HaveSuffix(" [status=False correlationId=reconcile-7f3a]"))
+		})
+
+		It("keeps the metadata only in the message of an Argo node primary sink", func() {
+			node := &fakeWorkflowNodeClient{}
+			r = reporter.NewReporterWithClient("/results/test.json", 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithArgoNodeStatus(node, "provision-123", true),
+				reporter.WithCorrelation(reporter.Correlation{ID: "reconcile-7f3a"}))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`))).To(Succeed())
+
+			Expect(node.messages).To(HaveKeyWithValue("provision-123", "Available=True AllChecksPassed: ok [correlationId=reconcile-7f3a]"))
+			Expect(mock.TargetAnnotations).To(BeEmpty())
+		})
+	})
+
+	Describe("TerminalConditionWritten", func() {
+		It("is set only once the terminal condition was written", func() {
+			r = reporter.NewReporterWithClient("/results/test.json", 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock)
+			Expect(r.TerminalConditionWritten()).To(BeFalse())
+
+			mock.UpdateJobStatusFunc = func(ctx context.Context, condition k8s.JobCondition) error {
+				return errors.New("api server unavailable")
+			}
+			adapterResult := &result.AdapterResult{Status: result.StatusSuccess, Reason: "AllChecksPassed", Message: "ok"}
+			Expect(r.UpdateFromResult(ctx, adapterResult)).NotTo(Succeed())
+			Expect(r.TerminalConditionWritten()).To(BeFalse())
+
+			mock.UpdateJobStatusFunc = nil
+			Expect(r.UpdateFromResult(ctx, adapterResult)).To(Succeed())
+			Expect(r.TerminalConditionWritten()).To(BeTrue())
+		})
+	})
+
+	Describe("heartbeat", func() {
+		It("renews the heartbeat every poll interval while waiting and releases it on exit", func() {
+			heartbeat := &fakeHeartbeat{}
+			resultsPath := filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			// Write then rename so the poller never sees a partially written file
+			timer := time.AfterFunc(300*time.Millisecond, func() {
+				tmp := resultsPath + ".tmp"
+				if os.WriteFile(tmp, []byte(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`), 0o644) == nil {
+					_ = os.Rename(tmp, resultsPath)
+				}
+			})
+			DeferCleanup(timer.Stop)
+			r = reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithHeartbeat(heartbeat))
+
+			Expect(r.Run(ctx)).To(Succeed())
+
+			renewals, released := heartbeat.state()
+			Expect(renewals).To(BeNumerically(">=", 3))
+			Expect(released).To(BeTrue())
+			time.Sleep(100 * time.Millisecond)
+			renewalsAfterExit, _ := heartbeat.state()
+			Expect(renewalsAfterExit).To(Equal(renewals))
+		})
+
+		It("does not fail the run when the heartbeat cannot be renewed", func() {
+			heartbeat := &fakeHeartbeat{err: errors.New("leases.coordination.k8s.io is forbidden")}
+			r = reporter.NewReporterWithClient("/results/test.json", 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithHeartbeat(heartbeat))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`))).To(Succeed())
+
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("AllChecksPassed"))
+			_, released := heartbeat.state()
+			Expect(released).To(BeTrue())
+		})
+
+		It("bounds the release with a deadline", func() {
+			heartbeat := &fakeHeartbeat{}
+			r = reporter.NewReporterWithClient("/results/test.json", 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithHeartbeat(heartbeat))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`))).To(Succeed())
+
+			heartbeat.mu.Lock()
+			defer heartbeat.mu.Unlock()
+			Expect(heartbeat.releaseDeadline).To(BeTrue())
+		})
+	})
+
 	Describe("updateFromError", func() {
 		It("updates job status with InvalidResultFormat reason", func() {
 			parseErr := errors.New("JSON parsing failed")
@@ -179,6 +495,26 @@ var _ = Describe("Reporter", func() {
 			Expect(mock.LastUpdatedCondition.Message).To(ContainSubstring("JSON parsing failed"))
 		})
 
+		It("updates job status with InvalidResultSyntax reason for malformed JSON", func() {
+			_, parseErr := result.NewParser().Parse([]byte(`{bad json`))
+			Expect(parseErr).To(HaveOccurred())
+
+			err := r.UpdateFromError(ctx, fmt.Errorf("wrapped: %w", parseErr))
+
+			Expect(err).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal("False"))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonInvalidResultSyntax))
+			Expect(mock.LastUpdatedCondition.Message).To(ContainSubstring("failed to parse JSON"))
+		})
+
+		It("keeps InvalidResultFormat for schema violations", func() {
+			_, parseErr := result.NewParser().Parse([]byte(`{"status":"pending"}`))
+			Expect(parseErr).To(HaveOccurred())
+
+			Expect(r.UpdateFromError(ctx, parseErr)).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonInvalidResultFormat))
+		})
+
 		It("returns error when k8s client fails", func() {
 			mock.UpdateJobStatusFunc = func(ctx context.Context, condition k8s.JobCondition) error {
 				return errors.New("k8s update failed")
@@ -256,6 +592,20 @@ var _ = Describe("Reporter", func() {
 				Expect(mock.LastUpdatedCondition.Status).To(Equal("False"))
 				Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
 				Expect(mock.LastUpdatedCondition.Message).To(ContainSubstring("Adapter container exited with code 1"))
+				Expect(mock.LastUpdatedCondition.Message).NotTo(ContainSubstring("result file was present"))
+			})
+
+			It("notes the parse error in the message when enabled", func() {
+				Expect(os.WriteFile(resultsPath, []byte(`{invalid json`), 0644)).To(Succeed())
+				r = reporter.NewReporterWithClient(resultsPath, 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+					reporter.WithParseFailureNote(true))
+
+				err := r.HandleTermination(ctx, &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1})
+
+				Expect(err).To(HaveOccurred())
+				Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
+				Expect(mock.LastUpdatedCondition.Message).To(HavePrefix("Adapter container exited with code 1"))
+				Expect(mock.LastUpdatedCondition.Message).To(ContainSubstring("a result file was present but unusable: parse failed"))
 			})
 		})
 
@@ -275,6 +625,49 @@ var _ = Describe("Reporter", func() {
 				Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
 				Expect(mock.LastUpdatedCondition.Message).To(ContainSubstring("Adapter container exited with code 1"))
 			})
+
+			It("does not add a parse note when enabled", func() {
+				r = reporter.NewReporterWithClient(resultsPath, 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+					reporter.WithParseFailureNote(true))
+
+				Expect(r.HandleTermination(ctx, &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1})).NotTo(Succeed())
+				Expect(mock.LastUpdatedCondition.Message).To(Equal("Adapter container exited with code 1: Error"))
+			})
+
+			It("uses a result in the termination message when enabled", func() {
+				r = reporter.NewReporterWithClient(resultsPath, 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+					reporter.WithTerminationMessageResult(true))
+
+				Expect(r.HandleTermination(ctx, &corev1.ContainerStateTerminated{
+					Reason:   "Error",
+					ExitCode: 1,
+					Message:  `{"status":"failure","reason":"QuotaExceeded","message":"CPU quota exhausted"}`,
+				})).To(Succeed())
+				Expect(mock.LastUpdatedCondition.Status).To(Equal("False"))
+				Expect(mock.LastUpdatedCondition.Reason).To(Equal("QuotaExceeded"))
+				Expect(mock.LastUpdatedCondition.Message).To(Equal("CPU quota exhausted"))
+			})
+
+			It("falls back to the exit code when the termination message is not a result", func() {
+				r = reporter.NewReporterWithClient(resultsPath, 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+					reporter.WithTerminationMessageResult(true))
+
+				Expect(r.HandleTermination(ctx, &corev1.ContainerStateTerminated{
+					Reason:   "Error",
+					ExitCode: 1,
+					Message:  "panic: runtime error: index out of range",
+				})).NotTo(Succeed())
+				Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
+			})
+
+			It("ignores the termination message when disabled", func() {
+				Expect(r.HandleTermination(ctx, &corev1.ContainerStateTerminated{
+					Reason:   "Error",
+					ExitCode: 1,
+					Message:  `{"status":"success","reason":"AllChecksPassed","message":"ok"}`,
+				})).NotTo(Succeed())
+				Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
+			})
 		})
 
 		Context("when container was OOMKilled", func() {
@@ -293,6 +686,74 @@ var _ = Describe("Reporter", func() {
 				Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterOOMKilled))
 				Expect(mock.LastUpdatedCondition.Message).To(Equal("Adapter container was killed due to out of memory (OOMKilled)"))
 			})
+
+			It("includes the memory limit when enabled", func() {
+				mock.GetContainerMemoryLimitFunc = func(ctx context.Context, podName, containerName string) (*resource.Quantity, error) {
+					limit := resource.MustParse("512Mi")
+					return &limit, nil
+				}
+				r = reporter.NewReporterWithClient(resultsPath, 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+					reporter.WithMemoryLimitReport(true))
+
+				err := r.HandleTermination(ctx, &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137})
+
+				Expect(err).To(HaveOccurred())
+				Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterOOMKilled))
+				Expect(mock.LastUpdatedCondition.Message).To(Equal("Adapter container was killed due to out of memory (OOMKilled, limit: 512Mi)"))
+			})
+
+			It("omits the memory limit when it cannot be read", func() {
+				mock.GetContainerMemoryLimitFunc = func(ctx context.Context, podName, containerName string) (*resource.Quantity, error) {
+					return nil, errors.New("forbidden")
+				}
+				r = reporter.NewReporterWithClient(resultsPath, 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+					reporter.WithMemoryLimitReport(true))
+
+				Expect(r.HandleTermination(ctx, &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137})).NotTo(Succeed())
+				Expect(mock.LastUpdatedCondition.Message).To(Equal("Adapter container was killed due to out of memory (OOMKilled)"))
+			})
+		})
+	})
+
+	Describe("maximum result age", func() {
+		var resultsPath string
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"success","reason":"AllChecksPassed","message":"All validations passed"}`), 0644)).To(Succeed())
+		})
+
+		It("ignores a result file older than the configured age", func() {
+			old := time.Now().Add(-time.Hour)
+			Expect(os.Chtimes(resultsPath, old, old)).To(Succeed())
+
+			r := reporter.NewReporterWithClient(resultsPath, 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithMaxResultAge(time.Minute))
+
+			err := r.HandleTermination(ctx, &corev1.ContainerStateTerminated{Reason: "Completed", ExitCode: 0})
+
+			Expect(err).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterMissingResults))
+		})
+
+		It("uses a result file within the configured age", func() {
+			r := reporter.NewReporterWithClient(resultsPath, 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithMaxResultAge(time.Minute))
+
+			err := r.HandleTermination(ctx, &corev1.ContainerStateTerminated{Reason: "Completed", ExitCode: 0})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("AllChecksPassed"))
+		})
+
+		It("uses old result files when disabled", func() {
+			old := time.Now().Add(-time.Hour)
+			Expect(os.Chtimes(resultsPath, old, old)).To(Succeed())
+
+			r := reporter.NewReporterWithClient(resultsPath, 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock)
+
+			Expect(r.HandleTermination(ctx, &corev1.ContainerStateTerminated{Reason: "Completed", ExitCode: 0})).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("AllChecksPassed"))
 		})
 	})
 
@@ -406,6 +867,31 @@ var _ = Describe("Reporter", func() {
 			})
 		})
 
+		Context("when adapter container was OOMKilled after writing a valid result file", func() {
+			It("uses the result file instead of the OOM reason", func() {
+				resultsPath := filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+				Expect(os.WriteFile(resultsPath, []byte(`{"status":"failure","reason":"MemoryPressure","message":"Adapter handled memory pressure"}`), 0644)).To(Succeed())
+				r = reporter.NewReporterWithClient(resultsPath, 2*time.Second, 300*time.Second, "Available", "test-pod", "adapter", mock)
+
+				mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+					return &corev1.ContainerStatus{
+						Name: "adapter",
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{
+								Reason:   "OOMKilled",
+								ExitCode: 137,
+							},
+						},
+					}, nil
+				}
+
+				err := r.UpdateFromTimeout(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mock.LastUpdatedCondition.Reason).To(Equal("MemoryPressure"))
+			})
+		})
+
 		Context("when adapter container is terminated with error", func() {
 			It("updates with AdapterExitedWithError reason", func() {
 				mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
@@ -584,7 +1070,7 @@ var _ = Describe("Reporter", func() {
 		})
 
 		Context("when result file has invalid JSON", func() {
-			It("reports parse error", func() {
+			It("reports syntax error", func() {
 				err := os.WriteFile(resultsPath, []byte(`{invalid json`), 0644)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -601,7 +1087,7 @@ var _ = Describe("Reporter", func() {
 				err = r.Run(ctx)
 
 				Expect(err).To(HaveOccurred())
-				Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonInvalidResultFormat))
+				Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonInvalidResultSyntax))
 			})
 		})
 
@@ -841,4 +1327,2639 @@ var _ = Describe("Reporter", func() {
 			})
 		})
 	})
-})
+
+	Describe("single adapter mode", func() {
+		var resultsPath string
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+		})
+
+		It("caches the auto-detected container name after the first lookup", func() {
+			var mu sync.Mutex
+			var requestedNames []string
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				mu.Lock()
+				requestedNames = append(requestedNames, containerName)
+				mu.Unlock()
+				return &corev1.ContainerStatus{
+					Name: "detected-adapter",
+					State: corev1.ContainerState{
+						Running: &corev1.ContainerStateRunning{},
+					},
+				}, nil
+			}
+
+			r := reporter.NewReporterWithClientAndIntervals(
+				resultsPath,
+				50*time.Millisecond,
+				300*time.Millisecond,
+				50*time.Millisecond,
+				"Available",
+				"test-pod",
+				"",
+				mock,
+				reporter.WithSingleAdapter(true),
+			)
+
+			err := r.Run(ctx)
+
+			Expect(err).To(HaveOccurred())
+			mu.Lock()
+			defer mu.Unlock()
+			Expect(len(requestedNames)).To(BeNumerically(">", 1))
+			Expect(requestedNames[0]).To(Equal(""))
+			for _, name := range requestedNames[1:] {
+				Expect(name).To(Equal("detected-adapter"))
+			}
+		})
+
+		It("keeps auto-detecting when disabled", func() {
+			var mu sync.Mutex
+			var requestedNames []string
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				mu.Lock()
+				requestedNames = append(requestedNames, containerName)
+				mu.Unlock()
+				return &corev1.ContainerStatus{
+					Name: "detected-adapter",
+					State: corev1.ContainerState{
+						Running: &corev1.ContainerStateRunning{},
+					},
+				}, nil
+			}
+
+			r := reporter.NewReporterWithClientAndIntervals(
+				resultsPath,
+				50*time.Millisecond,
+				300*time.Millisecond,
+				50*time.Millisecond,
+				"Available",
+				"test-pod",
+				"",
+				mock,
+			)
+
+			err := r.Run(ctx)
+
+			Expect(err).To(HaveOccurred())
+			mu.Lock()
+			defer mu.Unlock()
+			for _, name := range requestedNames {
+				Expect(name).To(Equal(""))
+			}
+		})
+	})
+
+	Describe("outcome callback", func() {
+		var (
+			resultsPath string
+			callback    *fakeCallbackClient
+		)
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"success","reason":"AllChecksPassed","message":"All validations passed"}`), 0644)).To(Succeed())
+			callback = &fakeCallbackClient{}
+		})
+
+		It("posts the reported condition", func() {
+			r := reporter.NewReporterWithClient(
+				resultsPath,
+				50*time.Millisecond,
+				5*time.Second,
+				"Available",
+				"test-pod",
+				"adapter",
+				mock,
+				reporter.WithJobReference("test-job", "test-ns"),
+				reporter.WithCallback(callback, false),
+			)
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(callback.outcomes).To(HaveLen(1))
+			outcome := callback.outcomes[0].(reporter.Outcome)
+			Expect(outcome.JobName).To(Equal("test-job"))
+			Expect(outcome.JobNamespace).To(Equal("test-ns"))
+			Expect(outcome.PodName).To(Equal("test-pod"))
+			Expect(outcome.ConditionType).To(Equal("Available"))
+			Expect(outcome.Status).To(Equal("True"))
+			Expect(outcome.Reason).To(Equal("AllChecksPassed"))
+			Expect(outcome.Error).To(BeEmpty())
+		})
+
+		It("posts the adapter result along with the outcome when enabled", func() {
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"success","reason":"AllChecksPassed","message":"ok","details":{"checks":3}}`), 0644)).To(Succeed())
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithJobReference("test-job", "test-ns"),
+				reporter.WithCallback(callback, false),
+				reporter.WithCallbackResult(true),
+			)
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(callback.outcomes).To(HaveLen(1))
+			body, err := json.Marshal(callback.outcomes[0])
+			Expect(err).NotTo(HaveOccurred())
+			var payload map[string]any
+			Expect(json.Unmarshal(body, &payload)).To(Succeed())
+			Expect(payload).To(HaveKeyWithValue("jobName", "test-job"))
+			Expect(payload).To(HaveKeyWithValue("podName", "test-pod"))
+			Expect(payload).To(HaveKeyWithValue("result", HaveKeyWithValue("details", map[string]any{"checks": float64(3)})))
+		})
+
+		It("ignores callback failures when best-effort", func() {
+			callback.err = errors.New("connection refused")
+			r := reporter.NewReporterWithClient(
+				resultsPath,
+				50*time.Millisecond,
+				5*time.Second,
+				"Available",
+				"test-pod",
+				"adapter",
+				mock,
+				reporter.WithCallback(callback, false),
+			)
+
+			Expect(r.Run(ctx)).To(Succeed())
+		})
+
+		It("fails the run on callback failure when fatal", func() {
+			callback.err = errors.New("connection refused")
+			r := reporter.NewReporterWithClient(
+				resultsPath,
+				50*time.Millisecond,
+				5*time.Second,
+				"Available",
+				"test-pod",
+				"adapter",
+				mock,
+				reporter.WithCallback(callback, true),
+			)
+
+			err := r.Run(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("outcome callback failed"))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("AllChecksPassed"))
+		})
+
+		It("posts the outcome as a CloudEvent keyed to the Job", func() {
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithJobReference("test-job", "test-ns"),
+				reporter.WithCloudEvents(callback, false),
+			)
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(callback.outcomes).To(HaveLen(1))
+			event := callback.outcomes[0].(reporter.CloudEvent)
+			Expect(event.SpecVersion).To(Equal("1.0"))
+			Expect(event.ID).NotTo(BeEmpty())
+			Expect(event.Type).To(Equal(reporter.CloudEventOutcomeType))
+			Expect(event.Source).To(Equal("/status-reporter/namespaces/test-ns/pods/test-pod"))
+			Expect(event.Subject).To(Equal("/apis/batch/v1/namespaces/test-ns/jobs/test-job"))
+			Expect(event.Data.Reason).To(Equal("AllChecksPassed"))
+		})
+
+		It("posts the outcome and result as a binary-mode CloudEvent", func() {
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithJobReference("test-job", "test-ns"),
+				reporter.WithBinaryCloudEvents(callback, false),
+			)
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(callback.outcomes).To(HaveLen(1))
+			event := callback.outcomes[0].(reporter.BinaryCloudEvent)
+			headers := event.Headers()
+			Expect(headers).To(HaveKeyWithValue("ce-specversion", "1.0"))
+			Expect(headers).To(HaveKeyWithValue("ce-type", reporter.CloudEventResultType))
+			Expect(headers).To(HaveKeyWithValue("ce-source", "/status-reporter/namespaces/test-ns/pods/test-pod"))
+			Expect(headers).To(HaveKeyWithValue("ce-subject", "/apis/batch/v1/namespaces/test-ns/jobs/test-job"))
+			Expect(headers).To(HaveKeyWithValue("ce-id", Not(BeEmpty())))
+			Expect(headers).To(HaveKeyWithValue("Content-Type", "application/json"))
+			data := event.Body().(reporter.CallbackPayload)
+			Expect(data.Reason).To(Equal("AllChecksPassed"))
+			Expect(data.Result).NotTo(BeNil())
+		})
+
+		It("passes the outcome to an in-process hook", func() {
+			var got []reporter.Outcome
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithOutcomeHook(func(outcome reporter.Outcome) { got = append(got, outcome) }),
+			)
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(got).To(HaveLen(1))
+			Expect(got[0].Reason).To(Equal("AllChecksPassed"))
+		})
+
+		It("exports the outcome as an OTLP log record", func() {
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithJobReference("test-job", "test-ns"),
+				reporter.WithOTLPLogs(callback, "status-reporter"),
+			)
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(callback.outcomes).To(HaveLen(1))
+			request := callback.outcomes[0].(reporter.OTLPLogsRequest)
+			Expect(request.ResourceLogs).To(HaveLen(1))
+			Expect(request.ResourceLogs[0].Resource.Attributes).To(ContainElements(
+				reporter.OTLPAttribute{Key: "service.name", Value: reporter.OTLPValue{StringValue: "status-reporter"}},
+				reporter.OTLPAttribute{Key: "k8s.job.name", Value: reporter.OTLPValue{StringValue: "test-job"}},
+				reporter.OTLPAttribute{Key: "k8s.pod.name", Value: reporter.OTLPValue{StringValue: "test-pod"}},
+			))
+			record := request.ResourceLogs[0].ScopeLogs[0].LogRecords[0]
+			Expect(record.SeverityText).To(Equal("INFO"))
+			Expect(record.Body.StringValue).To(Equal("All validations passed"))
+			Expect(record.Attributes).To(ContainElement(
+				reporter.OTLPAttribute{Key: "hyperfleet.condition.reason", Value: reporter.OTLPValue{StringValue: "AllChecksPassed"}},
+			))
+		})
+
+		It("posts a successful commit status with the reason as context", func() {
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithCommitStatus(callback),
+			)
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(callback.outcomes).To(ConsistOf(reporter.CommitStatus{
+				State:       reporter.CommitStatusSuccess,
+				Context:     "AllChecksPassed",
+				Description: "All validations passed",
+			}))
+		})
+
+		It("posts a failed commit status and truncates the description", func() {
+			message := strings.Repeat("x", 200)
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"failure","reason":"ValidationFailed","message":"`+message+`"}`), 0644)).To(Succeed())
+			callback.err = errors.New("connection refused")
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithCommitStatus(callback),
+			)
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(callback.outcomes).To(HaveLen(1))
+			status := callback.outcomes[0].(reporter.CommitStatus)
+			Expect(status.State).To(Equal(reporter.CommitStatusFailure))
+			Expect(status.Context).To(Equal("ValidationFailed"))
+			Expect(status.Description).To(HaveLen(140))
+			Expect(status.Description).To(HaveSuffix("..."))
+		})
+
+		It("records the outcome in the aggregator keyed by pod name", func() {
+			aggregator := &fakeAggregatorClient{err: errors.New("adapterruns.hyperfleet.io \"fleet-run\" not found")}
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithAggregator(aggregator),
+				reporter.WithSinkRetry(retry.Policy{Interval: time.Millisecond}),
+			)
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(aggregator.runs).To(HaveKey("test-pod"))
+			run := aggregator.runs["test-pod"].(reporter.Outcome)
+			Expect(run.Status).To(Equal(reporter.ConditionStatusTrue))
+			Expect(run.Reason).To(Equal("AllChecksPassed"))
+		})
+	})
+
+	Describe("check on container change", func() {
+		It("checks the result file as soon as the container status changes", func() {
+			resultsPath := filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"success","reason":"AllChecksPassed","message":"All validations passed"}`), 0644)).To(Succeed())
+
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				return &corev1.ContainerStatus{
+					Name:  "adapter",
+					State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				}, nil
+			}
+
+			r := reporter.NewReporterWithClientAndIntervals(
+				resultsPath,
+				10*time.Second,
+				20*time.Second,
+				50*time.Millisecond,
+				"Available",
+				"test-pod",
+				"adapter",
+				mock,
+				reporter.WithCheckOnContainerChange(true),
+			)
+
+			start := time.Now()
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("AllChecksPassed"))
+		})
+	})
+
+
+	Describe("quiet startup", func() {
+		var (
+			resultsPath string
+			logOutput   *bytes.Buffer
+		)
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"success","reason":"AllChecksPassed","message":"All validations passed"}`), 0644)).To(Succeed())
+			logOutput = &bytes.Buffer{}
+			log.SetOutput(logOutput)
+			DeferCleanup(func() { log.SetOutput(os.Stderr) })
+		})
+
+		It("logs the startup banner by default", func() {
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock)
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(logOutput.String()).To(ContainSubstring("Status reporter starting"))
+		})
+
+		It("suppresses the startup banner but keeps the outcome", func() {
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithQuietStartup(true))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(logOutput.String()).NotTo(ContainSubstring("Status reporter starting"))
+			Expect(logOutput.String()).NotTo(ContainSubstring("Polling for result file"))
+			Expect(logOutput.String()).To(ContainSubstring("Job status updated successfully"))
+		})
+	})
+
+
+	Describe("termination debouncing", func() {
+		var (
+			resultsPath string
+			mu          sync.Mutex
+			states      []corev1.ContainerState
+			calls       int
+		)
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			calls = 0
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				state := states[len(states)-1]
+				if calls < len(states) {
+					state = states[calls]
+				}
+				calls++
+				return &corev1.ContainerStatus{Name: "adapter", State: state}, nil
+			}
+		})
+
+		It("ignores a terminated state that is not confirmed by the next check", func() {
+			// Crash-loop: old instance terminated, then a new instance is running until timeout
+			states = []corev1.ContainerState{
+				{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}},
+				{Running: &corev1.ContainerStateRunning{}},
+			}
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 50*time.Millisecond, 400*time.Millisecond, 50*time.Millisecond,
+				"Available", "test-pod", "adapter", mock, reporter.WithDebounceTermination(true))
+
+			err := r.Run(ctx)
+
+			Expect(err).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterTimeout))
+		})
+
+		It("acts on a terminated state confirmed by the next check", func() {
+			states = []corev1.ContainerState{
+				{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}},
+			}
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 50*time.Millisecond, 5*time.Second, 50*time.Millisecond,
+				"Available", "test-pod", "adapter", mock, reporter.WithDebounceTermination(true))
+
+			err := r.Run(ctx)
+
+			Expect(err).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
+			mu.Lock()
+			defer mu.Unlock()
+			Expect(calls).To(BeNumerically(">=", 2))
+		})
+	})
+
+
+	Describe("outcome socket", func() {
+		var (
+			resultsPath string
+			socketDir   string
+		)
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"failure","reason":"ValidationFailed","message":"Some checks failed"}`), 0644)).To(Succeed())
+			// Unix socket paths are length-limited, so avoid the long Ginkgo temp dir
+			var err error
+			socketDir, err = os.MkdirTemp("", "sock")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(os.RemoveAll, socketDir)
+		})
+
+		It("writes the outcome as a JSON line", func() {
+			socketPath := filepath.Join(socketDir, "outcome.sock")
+			listener, err := net.Listen("unix", socketPath)
+			Expect(err).NotTo(HaveOccurred())
+			defer func() { _ = listener.Close() }()
+
+			received := make(chan string, 1)
+			go func() {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+				defer func() { _ = conn.Close() }()
+				line, _ := bufio.NewReader(conn).ReadString('\n')
+				received <- line
+			}()
+
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithOutcomeSocket(socketPath, false))
+			Expect(r.Run(ctx)).To(Succeed())
+
+			var line string
+			Eventually(received).Should(Receive(&line))
+			var outcome reporter.Outcome
+			Expect(json.Unmarshal([]byte(line), &outcome)).To(Succeed())
+			Expect(outcome.Status).To(Equal("False"))
+			Expect(outcome.Reason).To(Equal("ValidationFailed"))
+		})
+
+		It("ignores an unreachable socket by default", func() {
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithOutcomeSocket(filepath.Join(socketDir, "missing.sock"), false),
+				reporter.WithSinkRetry(retry.Policy{MaxRetries: 1, Interval: time.Millisecond}))
+			Expect(r.Run(ctx)).To(Succeed())
+		})
+
+		It("fails the run for an unreachable socket in strict mode", func() {
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithOutcomeSocket(filepath.Join(socketDir, "missing.sock"), true),
+				reporter.WithSinkRetry(retry.Policy{MaxRetries: 1, Interval: time.Millisecond}))
+			err := r.Run(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("outcome socket failed after 2 attempt(s)"))
+		})
+	})
+
+
+	Describe("initial container status retry", func() {
+		var (
+			resultsPath string
+			mu          sync.Mutex
+			calls       int
+			logBuf      *bytes.Buffer
+		)
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			calls = 0
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				calls++
+				if calls <= 2 {
+					return nil, fmt.Errorf("container adapter not found in pod test-pod")
+				}
+				return &corev1.ContainerStatus{
+					Name:  "adapter",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}},
+				}, nil
+			}
+
+			logBuf = &bytes.Buffer{}
+			log.SetOutput(logBuf)
+			DeferCleanup(func() { log.SetOutput(os.Stderr) })
+		})
+
+		It("retries the first lookup without logging a warning", func() {
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 50*time.Millisecond, 5*time.Second, 10*time.Second,
+				"Available", "test-pod", "adapter", mock, reporter.WithInitialStatusRetry(3, 10*time.Millisecond))
+
+			err := r.Run(ctx)
+
+			Expect(err).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
+			Expect(logBuf.String()).NotTo(ContainSubstring("failed to get container status"))
+		})
+
+		It("logs a warning once the retries are exhausted", func() {
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 50*time.Millisecond, 300*time.Millisecond, 10*time.Second,
+				"Available", "test-pod", "adapter", mock, reporter.WithInitialStatusRetry(1, 10*time.Millisecond))
+
+			_ = r.Run(ctx)
+
+			Expect(logBuf.String()).To(ContainSubstring("after 2 attempt(s)"))
+		})
+	})
+
+	Describe("init container failures", func() {
+		var (
+			resultsPath string
+			initStatus  *corev1.ContainerStatus
+		)
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			initStatus = &corev1.ContainerStatus{
+				Name:  "data-prep",
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 3}},
+			}
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				return &corev1.ContainerStatus{
+					Name:  "adapter",
+					State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "PodInitializing"}},
+				}, nil
+			}
+		})
+
+		It("reports InitContainerFailed with the container name and exit code", func() {
+			mock.GetFailedInitContainerStatusFunc = func(ctx context.Context, podName string) (*corev1.ContainerStatus, error) {
+				return initStatus, nil
+			}
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 50*time.Millisecond, 5*time.Second, 50*time.Millisecond,
+				"Available", "test-pod", "adapter", mock)
+
+			err := r.Run(ctx)
+
+			Expect(err).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonInitContainerFailed))
+			Expect(mock.LastUpdatedCondition.Message).To(ContainSubstring("data-prep"))
+			Expect(mock.LastUpdatedCondition.Message).To(ContainSubstring("code 3"))
+		})
+
+		It("does not inspect init containers once the adapter is running", func() {
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				return &corev1.ContainerStatus{
+					Name:  "adapter",
+					State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+				}, nil
+			}
+			mock.GetFailedInitContainerStatusFunc = func(ctx context.Context, podName string) (*corev1.ContainerStatus, error) {
+				return initStatus, nil
+			}
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 50*time.Millisecond, 300*time.Millisecond, 50*time.Millisecond,
+				"Available", "test-pod", "adapter", mock)
+
+			err := r.Run(ctx)
+
+			Expect(err).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterTimeout))
+		})
+	})
+
+	Describe("success confirmation", func() {
+		var (
+			resultsPath string
+			mu          sync.Mutex
+			terminated  *corev1.ContainerStateTerminated
+		)
+
+		const successResult = `{"status":"success","reason":"AllChecksPassed","message":"All checks passed"}`
+
+		terminate := func(exitCode int32) {
+			mu.Lock()
+			defer mu.Unlock()
+			terminated = &corev1.ContainerStateTerminated{Reason: "Completed", ExitCode: exitCode}
+		}
+
+		newReporter := func() *reporter.StatusReporter {
+			return reporter.NewReporterWithClientAndIntervals(resultsPath, 20*time.Millisecond, 5*time.Second, 50*time.Millisecond,
+				"Available", "test-pod", "adapter", mock, reporter.WithConfirmSuccessStable(true))
+		}
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			Expect(os.WriteFile(resultsPath, []byte(successResult), 0644)).To(Succeed())
+			terminated = nil
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				if terminated == nil {
+					return &corev1.ContainerStatus{Name: "adapter", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}, nil
+				}
+				return &corev1.ContainerStatus{Name: "adapter", State: corev1.ContainerState{Terminated: terminated}}, nil
+			}
+		})
+
+		It("commits success once the container exits cleanly with the result unchanged", func() {
+			time.AfterFunc(200*time.Millisecond, func() { terminate(0) })
+
+			Expect(newReporter().Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusTrue))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("AllChecksPassed"))
+		})
+
+		It("reports the final result when the file changes before the container exits", func() {
+			time.AfterFunc(200*time.Millisecond, func() {
+				defer GinkgoRecover()
+				Expect(os.WriteFile(resultsPath, []byte(`{"status":"failure","reason":"LateFailure","message":"Check failed after success"}`), 0644)).To(Succeed())
+				terminate(0)
+			})
+
+			Expect(newReporter().Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("LateFailure"))
+		})
+
+		It("does not commit success when the container exits with an error", func() {
+			time.AfterFunc(200*time.Millisecond, func() { terminate(1) })
+
+			Expect(newReporter().Run(ctx)).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
+		})
+
+		It("confirms a success posted over HTTP against the posted result", func() {
+			Expect(os.Remove(resultsPath)).To(Succeed())
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			addr := listener.Addr().String()
+			Expect(listener.Close()).To(Succeed())
+
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 20*time.Millisecond, 5*time.Second, 50*time.Millisecond,
+				"Available", "test-pod", "adapter", mock, reporter.WithConfirmSuccessStable(true), reporter.WithResultHTTP(addr))
+			runErr := make(chan error, 1)
+			go func() { runErr <- r.Run(ctx) }()
+
+			Eventually(func() error {
+				resp, err := http.Post("http://"+addr+reporter.ResultEndpointPath, "application/json",
+					strings.NewReader(`{"status":"success","reason":"Pushed","message":"ok"}`))
+				if err != nil {
+					return err
+				}
+				return resp.Body.Close()
+			}, 2*time.Second, 20*time.Millisecond).Should(Succeed())
+			Consistently(runErr, 200*time.Millisecond).ShouldNot(Receive())
+			terminate(0)
+
+			Eventually(runErr, 5*time.Second).Should(Receive(BeNil()))
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusTrue))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("Pushed"))
+		})
+
+		It("stops holding the success when the pod is deleted", func() {
+			var deleted atomic.Bool
+			mock.GetPodFunc = func(ctx context.Context, podName string) (*corev1.Pod, error) {
+				pod := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: podName},
+					Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+						{Name: "adapter", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+					}},
+				}
+				if deleted.Load() {
+					deletedAt := metav1.Now()
+					pod.DeletionTimestamp = &deletedAt
+				}
+				return pod, nil
+			}
+			time.AfterFunc(200*time.Millisecond, func() { deleted.Store(true) })
+
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 20*time.Millisecond, 5*time.Second, 50*time.Millisecond,
+				"Available", "test-pod", "adapter", mock, reporter.WithConfirmSuccessStable(true), reporter.WithExitOnPodTerminating(true))
+
+			start := time.Now()
+			Expect(r.Run(ctx)).To(HaveOccurred())
+			Expect(time.Since(start)).To(BeNumerically("<", 4*time.Second))
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonPodTerminating))
+		})
+
+		It("reports failures without waiting for the container", func() {
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"failure","reason":"ChecksFailed","message":"failed"}`), 0644)).To(Succeed())
+
+			Expect(newReporter().Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("ChecksFailed"))
+		})
+	})
+
+	Describe("repeated monitor warnings", func() {
+		var (
+			resultsPath string
+			logBuf      *bytes.Buffer
+		)
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				return nil, fmt.Errorf("pods \"test-pod\" is forbidden")
+			}
+
+			logBuf = &bytes.Buffer{}
+			log.SetOutput(logBuf)
+			DeferCleanup(func() { log.SetOutput(os.Stderr) })
+		})
+
+		run := func(opts ...reporter.Option) string {
+			opts = append(opts, reporter.WithInitialStatusRetry(0, 0))
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 50*time.Millisecond, 300*time.Millisecond, 20*time.Millisecond,
+				"Available", "test-pod", "adapter", mock, opts...)
+			_ = r.Run(ctx)
+			return logBuf.String()
+		}
+
+		It("logs a repeated warning once and summarizes the repeats", func() {
+			output := run(reporter.WithLogDedupInterval(time.Hour))
+
+			// One each from the initial check, the monitor loop and the final timeout check
+			Expect(strings.Count(output, "Warning: failed to get container status")).To(BeNumerically("<=", 3))
+			Expect(output).To(MatchRegexp(`Previous message repeated \d+ more times`))
+		})
+
+		It("logs every occurrence when deduplication is disabled", func() {
+			output := run(reporter.WithLogDedupInterval(0))
+
+			Expect(strings.Count(output, "Warning: failed to get container status")).To(BeNumerically(">", 5))
+		})
+	})
+
+	Describe("non-terminal reasons", func() {
+		var resultsPath string
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"failure","reason":"InProgress","message":"2 of 5 checks done"}`), 0644)).To(Succeed())
+		})
+
+		It("keeps waiting until a terminal result is written", func() {
+			path := resultsPath
+			timer := time.AfterFunc(200*time.Millisecond, func() {
+				defer GinkgoRecover()
+				// Replace atomically so the poller never observes a truncated file
+				tmpPath := path + ".tmp"
+				Expect(os.WriteFile(tmpPath, []byte(`{"status":"success","reason":"AllChecksPassed","message":"5 of 5 checks done"}`), 0644)).To(Succeed())
+				Expect(os.Rename(tmpPath, path)).To(Succeed())
+			})
+			DeferCleanup(timer.Stop)
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 20*time.Millisecond, 5*time.Second, time.Second,
+				"Available", "test-pod", "adapter", mock, reporter.WithNonTerminalReasons("InProgress"))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("AllChecksPassed"))
+		})
+
+		It("uses the container exit code when the adapter exits with an intermediate result", func() {
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				return &corev1.ContainerStatus{
+					Name:  "adapter",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 2}},
+				}, nil
+			}
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 20*time.Millisecond, 5*time.Second, 50*time.Millisecond,
+				"Available", "test-pod", "adapter", mock, reporter.WithNonTerminalReasons("InProgress"))
+
+			Expect(r.Run(ctx)).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
+		})
+	})
+
+	Describe("timing output", func() {
+		It("writes the phase timestamps and durations at the end of the run", func() {
+			dir := GinkgoT().TempDir()
+			resultsPath := filepath.Join(dir, "adapter-result.json")
+			timingPath := filepath.Join(dir, "timing.json")
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`), 0644)).To(Succeed())
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				return &corev1.ContainerStatus{Name: "adapter", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}, nil
+			}
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 50*time.Millisecond, 5*time.Second, time.Second,
+				"Available", "test-pod", "adapter", mock, reporter.WithTimingOutput(timingPath))
+
+			Expect(r.Run(ctx)).To(Succeed())
+
+			data, err := os.ReadFile(timingPath)
+			Expect(err).NotTo(HaveOccurred())
+			var timing reporter.Timing
+			Expect(json.Unmarshal(data, &timing)).To(Succeed())
+			Expect(timing.Start).NotTo(BeZero())
+			Expect(timing.ResultFound).NotTo(BeNil())
+			Expect(timing.Reported).NotTo(BeNil())
+			Expect(*timing.ReportedMs).To(BeNumerically(">=", *timing.ResultFoundMs))
+			Expect(timing.TotalMs).To(BeNumerically(">=", *timing.ReportedMs))
+		})
+	})
+
+	Describe("active deadline check", func() {
+		var resultsPath string
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`), 0644)).To(Succeed())
+			mock.GetJobActiveDeadlineSecondsFunc = func(ctx context.Context) (*int64, error) {
+				deadline := int64(60)
+				return &deadline, nil
+			}
+		})
+
+		newReporter := func(maxWait time.Duration, mode string) *reporter.StatusReporter {
+			return reporter.NewReporterWithClientAndIntervals(resultsPath, 50*time.Millisecond, maxWait, time.Second,
+				"Available", "test-pod", "adapter", mock, reporter.WithActiveDeadlineCheck(mode))
+		}
+
+		It("fails before reporting in strict mode when the max wait exceeds the deadline", func() {
+			err := newReporter(5*time.Minute, reporter.ActiveDeadlineCheckStrict).Run(ctx)
+
+			Expect(err).To(MatchError(ContainSubstring("activeDeadlineSeconds (60s)")))
+			Expect(mock.LastUpdatedCondition.Reason).To(BeEmpty())
+		})
+
+		It("only warns in warn mode", func() {
+			Expect(newReporter(5*time.Minute, reporter.ActiveDeadlineCheckWarn).Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("AllChecksPassed"))
+		})
+
+		It("passes in strict mode when the max wait is shorter than the deadline", func() {
+			Expect(newReporter(30*time.Second, reporter.ActiveDeadlineCheckStrict).Run(ctx)).To(Succeed())
+		})
+
+		It("passes in strict mode when the Job has no deadline", func() {
+			mock.GetJobActiveDeadlineSecondsFunc = nil
+			Expect(newReporter(5*time.Minute, reporter.ActiveDeadlineCheckStrict).Run(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("degraded rule", func() {
+		var r *reporter.StatusReporter
+
+		BeforeEach(func() {
+			r = reporter.NewReporterWithClient("/results/adapter-result.json", time.Second, time.Minute,
+				"Available", "test-pod", "adapter", mock, reporter.WithDegradedRule(&reporter.DegradedRule{DetailsPointer: "/failedChecks"}))
+		})
+
+		It("downgrades a success result whose details report failures", func() {
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{
+				Status:  result.StatusSuccess,
+				Reason:  "MostlyPassed",
+				Message: "9 of 10 checks passed",
+				Details: json.RawMessage(`{"failedChecks":["dns"]}`),
+			})).To(Succeed())
+
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonPartialFailure))
+			Expect(mock.LastUpdatedCondition.Message).To(ContainSubstring("MostlyPassed"))
+		})
+
+		It("keeps the success when the details report no failures", func() {
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{
+				Status:  result.StatusSuccess,
+				Reason:  "AllChecksPassed",
+				Message: "ok",
+				Details: json.RawMessage(`{"failedChecks":0}`),
+			})).To(Succeed())
+
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusTrue))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("AllChecksPassed"))
+		})
+	})
+
+	Describe("pod terminating", func() {
+		var (
+			resultsPath string
+			podGets     atomic.Int32
+		)
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			podGets.Store(0)
+			running := corev1.ContainerStatus{
+				Name:  "adapter",
+				State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				return &running, nil
+			}
+			mock.GetPodFunc = func(ctx context.Context, podName string) (*corev1.Pod, error) {
+				pod := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: podName},
+					Status:     corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{running}},
+				}
+				if podGets.Add(1) > 2 {
+					deletedAt := metav1.Now()
+					pod.DeletionTimestamp = &deletedAt
+				}
+				return pod, nil
+			}
+		})
+
+		It("reports PodTerminating and exits without waiting for the timeout", func() {
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 50*time.Millisecond, 10*time.Second, 50*time.Millisecond,
+				"Available", "test-pod", "adapter", mock, reporter.WithExitOnPodTerminating(true))
+
+			start := time.Now()
+			err := r.Run(ctx)
+
+			Expect(err).To(HaveOccurred())
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonPodTerminating))
+			Expect(podGets.Load()).To(BeEquivalentTo(3))
+		})
+
+		It("ignores the deletion timestamp when disabled", func() {
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 50*time.Millisecond, 300*time.Millisecond, 50*time.Millisecond,
+				"Available", "test-pod", "adapter", mock)
+
+			err := r.Run(ctx)
+
+			Expect(err).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterTimeout))
+		})
+	})
+
+	Describe("result parse settle delay", func() {
+		var resultsPath string
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"success","reas`), 0o644)).To(Succeed())
+			// Completes the write after the first parse; errors are ignored because the temp dir may
+			// already be gone when the spec finished without waiting
+			go func(path string) {
+				time.Sleep(100 * time.Millisecond)
+				tmp := path + ".tmp"
+				if os.WriteFile(tmp, []byte(`{"status":"success","reason":"AllChecksPassed","message":"done"}`), 0o644) == nil {
+					_ = os.Rename(tmp, path)
+				}
+			}(resultsPath)
+		})
+
+		It("retries the first parse error after the settle delay", func() {
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 20*time.Millisecond, 5*time.Second, time.Second,
+				"Available", "test-pod", "adapter", mock, reporter.WithResultParseSettleDelay(500*time.Millisecond))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusTrue))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("AllChecksPassed"))
+		})
+
+		It("reports the parse error immediately when disabled", func() {
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 20*time.Millisecond, 5*time.Second, time.Second,
+				"Available", "test-pod", "adapter", mock)
+
+			Expect(r.Run(ctx)).NotTo(Succeed())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonInvalidResultSyntax))
+		})
+	})
+
+	Describe("timeout growth grace", func() {
+		var resultsPath string
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			content := []byte(`{"status":"success","reason":"SlowWrite","message":"` + strings.Repeat("x", 600) + `"}`)
+			// Appends the result in chunks across the 1s deadline; the long poll interval keeps the
+			// poller from parsing the partial file first. Errors are ignored because the temp dir may
+			// already be gone when the spec finished without waiting.
+			go func(path string) {
+				time.Sleep(700 * time.Millisecond)
+				f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+				if err != nil {
+					return
+				}
+				defer func() { _ = f.Close() }()
+				for chunk := range slices.Chunk(content, 100) {
+					if _, err := f.Write(chunk); err != nil {
+						return
+					}
+					time.Sleep(100 * time.Millisecond)
+				}
+			}(resultsPath)
+		})
+
+		It("parses a result that is still being written at the deadline", func() {
+			r := reporter.NewReporterWithClient(resultsPath, 5*time.Second, time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithTimeoutGrowthGrace(time.Second))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusTrue))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("SlowWrite"))
+		})
+
+		It("reports a timeout when disabled", func() {
+			r := reporter.NewReporterWithClient(resultsPath, 5*time.Second, time.Second, "Available", "test-pod", "adapter", mock)
+
+			Expect(r.Run(ctx)).NotTo(Succeed())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterTimeout))
+		})
+	})
+
+	Describe("result stream", func() {
+		var (
+			resultsPath string
+			mu          sync.Mutex
+			conditions  []k8s.JobCondition
+		)
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.ndjson")
+			conditions = nil
+			mock.UpdateJobStatusFunc = func(ctx context.Context, condition k8s.JobCondition) error {
+				mu.Lock()
+				defer mu.Unlock()
+				conditions = append(conditions, condition)
+				return nil
+			}
+		})
+
+		appendRecord := func(record string) {
+			f, err := os.OpenFile(resultsPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+			if err != nil {
+				return
+			}
+			defer func() { _ = f.Close() }()
+			_, _ = f.WriteString(record + "\n")
+		}
+
+		It("reports progress records and finishes on the final record", func() {
+			appendRecord(`{"status":"success","reason":"Validating","message":"1 of 2 checks done"}`)
+			time.AfterFunc(300*time.Millisecond, func() {
+				appendRecord(`{"status":"failure","reason":"QuotaExceeded","message":"no CPU","final":true}`)
+			})
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithResultStream(true))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			mu.Lock()
+			defer mu.Unlock()
+			Expect(conditions).To(HaveLen(2))
+			Expect(conditions[0].Status).To(Equal(reporter.ConditionStatusUnknown))
+			Expect(conditions[0].Message).To(Equal("1 of 2 checks done"))
+			Expect(conditions[1].Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(conditions[1].Reason).To(Equal("QuotaExceeded"))
+		})
+
+		It("falls back to the exit code when the adapter exits without a final record", func() {
+			appendRecord(`{"status":"success","reason":"Validating","message":"still going"}`)
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithResultStream(false))
+
+			Expect(r.HandleTermination(ctx, &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 2})).NotTo(Succeed())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
+		})
+	})
+
+	Describe("result checks", func() {
+		var dir string
+		var checks []reporter.ResultCheck
+		var conditions map[string]k8s.JobCondition
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+			checks = []reporter.ResultCheck{
+				{File: "dns.json", ConditionType: "DNSReady"},
+				{File: "quota.json", ConditionType: "QuotaReady"},
+			}
+			conditions = map[string]k8s.JobCondition{}
+			mock.UpdateJobStatusFunc = func(ctx context.Context, condition k8s.JobCondition) error {
+				conditions[condition.Type] = condition
+				return nil
+			}
+		})
+
+		writeResult := func(name, content string) {
+			Expect(os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)).To(Succeed())
+		}
+
+		It("reports each check file on its own condition and the aggregate on the main one", func() {
+			writeResult("dns.json", `{"status":"success","reason":"DNSConfigured","message":"ok"}`)
+			quotaPath := filepath.Join(dir, "quota.json")
+			timer := time.AfterFunc(200*time.Millisecond, func() {
+				// Write and rename so the reporter never sees a partially written file
+				tmp := quotaPath + ".tmp"
+				if err := os.WriteFile(tmp, []byte(`{"status":"failure","reason":"QuotaExceeded","message":"no CPU"}`), 0644); err == nil {
+					_ = os.Rename(tmp, quotaPath)
+				}
+			})
+			DeferCleanup(timer.Stop)
+			r := reporter.NewReporterWithClient("", 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithResultChecks(dir, checks...))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(conditions).To(HaveLen(3))
+			Expect(conditions["Available"].Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(conditions["Available"].Reason).To(Equal("QuotaExceeded"))
+			Expect(conditions["Available"].Message).To(Equal("1 of 2 results failed: quota.json: QuotaExceeded: no CPU"))
+			Expect(conditions["DNSReady"].Status).To(Equal(reporter.ConditionStatusTrue))
+			Expect(conditions["DNSReady"].Reason).To(Equal("DNSConfigured"))
+			Expect(conditions["QuotaReady"].Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(conditions["QuotaReady"].Reason).To(Equal("QuotaExceeded"))
+		})
+
+		It("reports checks without a file as missing once the adapter exits", func() {
+			writeResult("dns.json", `{"status":"success","reason":"DNSConfigured","message":"ok"}`)
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				return &corev1.ContainerStatus{
+					Name:  "adapter",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"}},
+				}, nil
+			}
+			r := reporter.NewReporterWithClient("", 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithResultChecks(dir, checks...))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(conditions["Available"].Reason).To(Equal(reporter.ReasonAdapterMissingResults))
+			Expect(conditions["DNSReady"].Status).To(Equal(reporter.ConditionStatusTrue))
+			Expect(conditions["QuotaReady"].Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(conditions["QuotaReady"].Reason).To(Equal(reporter.ReasonAdapterMissingResults))
+		})
+	})
+
+	Describe("result glob", func() {
+		var dir string
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+		})
+
+		writeResult := func(name, content string) {
+			Expect(os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)).To(Succeed())
+		}
+
+		It("waits for the expected files and reports the aggregated failures", func() {
+			writeResult("dns.json", `{"status":"success","reason":"DNSReady","message":"ok"}`)
+			quotaPath := filepath.Join(dir, "quota.json")
+			timer := time.AfterFunc(200*time.Millisecond, func() {
+				// Written outside the glob and renamed so the reporter never sees a partially written file
+				tmp := quotaPath + ".tmp"
+				if err := os.WriteFile(tmp, []byte(`{"status":"failure","reason":"QuotaExceeded","message":"no CPU"}`), 0644); err == nil {
+					_ = os.Rename(tmp, quotaPath)
+				}
+			})
+			DeferCleanup(timer.Stop)
+			r := reporter.NewReporterWithClient(filepath.Join(dir, "*.json"), 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithResultGlob(2))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("QuotaExceeded"))
+			Expect(mock.LastUpdatedCondition.Message).To(Equal("1 of 2 results failed: quota.json: QuotaExceeded: no CPU"))
+		})
+
+		It("reports success only when all results succeeded", func() {
+			writeResult("dns.json", `{"status":"success","reason":"DNSReady","message":"ok"}`)
+			writeResult("quota.json", `{"status":"success","reason":"QuotaOK","message":"ok"}`)
+			r := reporter.NewReporterWithClient(filepath.Join(dir, "*.json"), 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithResultGlob(2))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusTrue))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(result.ReasonAllResultsSucceeded))
+		})
+
+		It("counts expected files that were not written as failures on termination", func() {
+			writeResult("dns.json", `{"status":"success","reason":"DNSReady","message":"ok"}`)
+			r := reporter.NewReporterWithClient(filepath.Join(dir, "*.json"), 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithResultGlob(2))
+
+			Expect(r.HandleTermination(ctx, &corev1.ContainerStateTerminated{Reason: "Completed"})).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterMissingResults))
+			Expect(mock.LastUpdatedCondition.Message).To(HavePrefix("1 of 2 results failed: missing-1"))
+		})
+
+		It("aggregates all files present when the adapter exits without an expected count", func() {
+			writeResult("dns.json", `{"status":"success","reason":"DNSReady","message":"ok"}`)
+			writeResult("quota.json", `{"status":"success","reason":"QuotaOK","message":"ok"}`)
+			statusChecks := 0
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				statusChecks++
+				if statusChecks < 3 {
+					return &corev1.ContainerStatus{Name: "adapter", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}, nil
+				}
+				writeResult("zone.json", `{"status":"failure","reason":"ZoneFull","message":"no capacity"}`)
+				return &corev1.ContainerStatus{
+					Name:  "adapter",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"}},
+				}, nil
+			}
+			r := reporter.NewReporterWithClientAndIntervals(filepath.Join(dir, "*.json"), 20*time.Millisecond, 5*time.Second, 50*time.Millisecond,
+				"Available", "test-pod", "adapter", mock, reporter.WithResultGlob(0))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(mock.LastUpdatedCondition.Message).To(Equal("1 of 3 results failed: zone.json: ZoneFull: no capacity"))
+		})
+	})
+
+	Describe("projected volume", func() {
+		var dir string
+
+		// publish mimics the kubelet's atomic writer: the content goes into a new version directory
+		// that replaces the previous one by renaming a symlink over ..data
+		publish := func(dir, version, content string) {
+			versionDir := filepath.Join(dir, version)
+			Expect(os.Mkdir(versionDir, 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(versionDir, "result.json"), []byte(content), 0644)).To(Succeed())
+			previous, _ := os.Readlink(filepath.Join(dir, "..data"))
+			Expect(os.Symlink(version, filepath.Join(dir, "..data_tmp"))).To(Succeed())
+			Expect(os.Rename(filepath.Join(dir, "..data_tmp"), filepath.Join(dir, "..data"))).To(Succeed())
+			if previous != "" {
+				Expect(os.RemoveAll(filepath.Join(dir, previous))).To(Succeed())
+			}
+		}
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+			publish(dir, "..v1", "")
+			Expect(os.Symlink(filepath.Join("..data", "result.json"), filepath.Join(dir, "result.json"))).To(Succeed())
+		})
+
+		It("waits for the published result and follows the ..data swap", func() {
+			volumeDir := dir
+			timer := time.AfterFunc(200*time.Millisecond, func() {
+				defer GinkgoRecover()
+				publish(volumeDir, "..v2", `{"status":"success","reason":"AllChecksPassed","message":"ok"}`)
+			})
+			DeferCleanup(timer.Stop)
+			// The poll interval is far longer than the spec's deadline, so only the watch can see the swap
+			r := reporter.NewReporterWithClient(filepath.Join(dir, "result.json"), 30*time.Second, time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithProjectedVolume(true), reporter.WithResultFileWatch(true))
+
+			start := time.Now()
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusTrue))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("AllChecksPassed"))
+		})
+
+		It("reads the result and its checksum from the same version", func() {
+			content := `{"status":"failure","reason":"QuotaExceeded","message":"no CPU"}`
+			sum := sha256.Sum256([]byte(content))
+			publish(dir, "..v2", content)
+			Expect(os.WriteFile(filepath.Join(dir, "..v2", "result.json.sha256"), []byte(hex.EncodeToString(sum[:])), 0644)).To(Succeed())
+			Expect(os.Symlink(filepath.Join("..data", "result.json.sha256"), filepath.Join(dir, "result.json.sha256"))).To(Succeed())
+			r := reporter.NewReporterWithClient(filepath.Join(dir, "result.json"), 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithProjectedVolume(true), reporter.WithRequiredResultChecksum(".sha256"))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("QuotaExceeded"))
+		})
+	})
+
+	Describe("done file", func() {
+		var resultsPath string
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			// A partial write that would fail to parse
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"succ`), 0644)).To(Succeed())
+		})
+
+		It("parses the result only after the done file appears", func() {
+			time.AfterFunc(200*time.Millisecond, func() {
+				_ = os.WriteFile(resultsPath, []byte(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`), 0644)
+				_ = os.WriteFile(resultsPath+".done", nil, 0644)
+			})
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithDoneFile(resultsPath+".done"))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusTrue))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("AllChecksPassed"))
+		})
+
+		It("falls back to the exit code when the adapter exits without the done file", func() {
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithDoneFile(resultsPath+".done"))
+
+			Expect(r.HandleTermination(ctx, &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"})).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
+		})
+	})
+
+	Describe("required result checksum", func() {
+		const content = `{"status":"success","reason":"AllChecksPassed","message":"ok"}`
+		var resultsPath string
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			Expect(os.WriteFile(resultsPath, []byte(content), 0644)).To(Succeed())
+		})
+
+		It("waits for the checksum file before parsing the result", func() {
+			sum := sha256.Sum256([]byte(content))
+			time.AfterFunc(200*time.Millisecond, func() {
+				_ = os.WriteFile(resultsPath+".sha256", []byte(hex.EncodeToString(sum[:])+"  adapter-result.json\n"), 0644)
+			})
+			r := reporter.NewReporterWithClient(resultsPath, 30*time.Second, time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithRequiredResultChecksum(".sha256"), reporter.WithResultFileWatch(true))
+
+			start := time.Now()
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(time.Since(start)).To(BeNumerically(">=", 200*time.Millisecond))
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusTrue))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("AllChecksPassed"))
+		})
+
+		It("reports a checksum mismatch as an invalid result", func() {
+			Expect(os.WriteFile(resultsPath+".sha256", []byte(strings.Repeat("0", 64)), 0644)).To(Succeed())
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithRequiredResultChecksum(".sha256"))
+
+			Expect(r.Run(ctx)).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonInvalidResultFormat))
+			Expect(mock.LastUpdatedCondition.Message).To(ContainSubstring("checksum mismatch"))
+		})
+
+		It("falls back to the exit code when the adapter exits before writing the checksum", func() {
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 5*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithRequiredResultChecksum(".sha256"))
+
+			Expect(r.HandleTermination(ctx, &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"})).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
+		})
+	})
+
+	Describe("RunFromReader", func() {
+		var r *reporter.StatusReporter
+
+		BeforeEach(func() {
+			r = reporter.NewReporterWithClient("", time.Second, time.Minute, "Available", "", "adapter", mock,
+				reporter.WithNonTerminalReasons("InProgress"))
+		})
+
+		It("reports the result read from the reader", func() {
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"failure","reason":"QuotaExceeded","message":"no CPU"}`))).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("QuotaExceeded"))
+			Expect(mock.LastUpdatedCondition.Message).To(Equal("no CPU"))
+		})
+
+		It("reports an invalid result", func() {
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":`))).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonInvalidResultSyntax))
+		})
+
+		It("reports empty input and intermediate results as invalid", func() {
+			Expect(r.RunFromReader(ctx, strings.NewReader("  \n"))).To(MatchError(ContainSubstring("result is empty")))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonInvalidResultFormat))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"success","reason":"InProgress","message":"working"}`))).
+				To(MatchError(ContainSubstring("result is not terminal")))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonInvalidResultFormat))
+		})
+	})
+
+	Describe("result file watch", func() {
+		It("picks up the result file as soon as it is written", func() {
+			resultsPath := filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			time.AfterFunc(200*time.Millisecond, func() {
+				_ = os.WriteFile(resultsPath, []byte(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`), 0644)
+			})
+			// The poll interval is far longer than the spec's deadline, so only the watch can find the file
+			r := reporter.NewReporterWithClient(resultsPath, 30*time.Second, time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithResultFileWatch(true))
+
+			start := time.Now()
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("AllChecksPassed"))
+		})
+	})
+
+	Describe("result HTTP endpoint", func() {
+		var (
+			resultsPath string
+			addr        string
+		)
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			addr = listener.Addr().String()
+			Expect(listener.Close()).To(Succeed())
+		})
+
+		// post retries until the endpoint is listening and returns the response status
+		post := func(body string) int {
+			var code int
+			Eventually(func() error {
+				resp, err := http.Post("http://"+addr+reporter.ResultEndpointPath, "application/json", strings.NewReader(body))
+				if err != nil {
+					return err
+				}
+				_ = resp.Body.Close()
+				code = resp.StatusCode
+				return nil
+			}, 2*time.Second, 20*time.Millisecond).Should(Succeed())
+			return code
+		}
+
+		run := func(opts ...reporter.Option) chan error {
+			r := reporter.NewReporterWithClient(resultsPath, 30*time.Second, time.Minute, "Available", "test-pod", "adapter", mock,
+				append([]reporter.Option{reporter.WithResultHTTP(addr)}, opts...)...)
+			runErr := make(chan error, 1)
+			go func() { runErr <- r.Run(ctx) }()
+			return runErr
+		}
+
+		It("reports a posted result like a result file", func() {
+			runErr := run()
+
+			Expect(post(`{"status":"success","reason":"Pushed","message":"ok"}`)).To(Equal(http.StatusOK))
+			Eventually(runErr, 5*time.Second).Should(Receive(BeNil()))
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusTrue))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("Pushed"))
+		})
+
+		It("reports an invalid payload as a failure", func() {
+			runErr := run()
+
+			Expect(post(`{"status":`)).To(Equal(http.StatusUnprocessableEntity))
+			Eventually(runErr, 5*time.Second).Should(Receive())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonInvalidResultSyntax))
+		})
+
+		It("keeps waiting after an intermediate result", func() {
+			runErr := run(reporter.WithNonTerminalReasons("InProgress"))
+
+			Expect(post(`{"status":"success","reason":"InProgress","message":"working"}`)).To(Equal(http.StatusAccepted))
+			Consistently(runErr, 200*time.Millisecond).ShouldNot(Receive())
+			Expect(post(`{"status":"failure","reason":"Broken","message":"no"}`)).To(Equal(http.StatusOK))
+			Eventually(runErr, 5*time.Second).Should(Receive(BeNil()))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("Broken"))
+		})
+
+		It("reports a posted intermediate result as progress with progress updates", func() {
+			var mu sync.Mutex
+			var conditions []k8s.JobCondition
+			mock.UpdateJobStatusFunc = func(ctx context.Context, condition k8s.JobCondition) error {
+				mu.Lock()
+				defer mu.Unlock()
+				conditions = append(conditions, condition)
+				return nil
+			}
+			runErr := run(reporter.WithResultStream(true), reporter.WithNonTerminalReasons("InProgress"))
+
+			Expect(post(`{"status":"success","reason":"InProgress","message":"1 of 2 checks done"}`)).To(Equal(http.StatusAccepted))
+			Expect(post(`{"status":"success","reason":"Done","message":"ok","final":true}`)).To(Equal(http.StatusOK))
+			Eventually(runErr, 5*time.Second).Should(Receive(BeNil()))
+
+			mu.Lock()
+			defer mu.Unlock()
+			Expect(conditions).To(HaveLen(2))
+			Expect(conditions[0].Status).To(Equal(reporter.ConditionStatusUnknown))
+			Expect(conditions[0].Message).To(Equal("1 of 2 checks done"))
+			Expect(conditions[1].Reason).To(Equal("Done"))
+		})
+
+		It("requires the bearer token from the token file", func() {
+			tokenFile := filepath.Join(GinkgoT().TempDir(), "token")
+			Expect(os.WriteFile(tokenFile, []byte("s3cret\n"), 0600)).To(Succeed())
+			runErr := run(reporter.WithResultHTTPToken(tokenFile))
+
+			Expect(post(`{"status":"success","reason":"Pushed","message":"ok"}`)).To(Equal(http.StatusUnauthorized))
+
+			req, err := http.NewRequest(http.MethodPost, "http://"+addr+reporter.ResultEndpointPath,
+				strings.NewReader(`{"status":"success","reason":"Pushed","message":"ok"}`))
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Set("Authorization", "Bearer s3cret")
+			resp, err := http.DefaultClient.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.Body.Close()).To(Succeed())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+			Eventually(runErr, 5*time.Second).Should(Receive(BeNil()))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("Pushed"))
+		})
+	})
+
+	Describe("result socket", func() {
+		It("reports a result posted over the unix socket and acknowledges it", func() {
+			// A short directory keeps the socket path within the unix socket path limit
+			dir, err := os.MkdirTemp("", "sr")
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(os.RemoveAll, dir)
+			socketPath := filepath.Join(dir, "reporter.sock")
+			// A socket left by a previous container run is replaced
+			stale, err := net.Listen("unix", socketPath)
+			Expect(err).NotTo(HaveOccurred())
+			stale.(*net.UnixListener).SetUnlinkOnClose(false)
+			Expect(stale.Close()).To(Succeed())
+
+			r := reporter.NewReporterWithClient(filepath.Join(dir, "adapter-result.json"), 30*time.Second, time.Minute,
+				"Available", "test-pod", "adapter", mock, reporter.WithResultSocket(socketPath))
+			runErr := make(chan error, 1)
+			go func() { runErr <- r.Run(ctx) }()
+
+			client := &http.Client{Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					var d net.Dialer
+					return d.DialContext(ctx, "unix", socketPath)
+				},
+			}}
+			var code int
+			Eventually(func() error {
+				resp, err := client.Post("http://reporter"+reporter.ResultEndpointPath, "application/json",
+					strings.NewReader(`{"status":"success","reason":"Pushed","message":"ok"}`))
+				if err != nil {
+					return err
+				}
+				_ = resp.Body.Close()
+				code = resp.StatusCode
+				return nil
+			}, 2*time.Second, 20*time.Millisecond).Should(Succeed())
+
+			Expect(code).To(Equal(http.StatusOK))
+			Eventually(runErr, 5*time.Second).Should(Receive(BeNil()))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("Pushed"))
+			Expect(socketPath).NotTo(BeAnExistingFile())
+		})
+	})
+
+	Describe("adapter health probe", func() {
+		var resultsPath string
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+		})
+
+		healthServer := func(code int) *httptest.Server {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(code)
+			}))
+			DeferCleanup(server.Close)
+			return server
+		}
+
+		It("reports success when the endpoint answers 2xx", func() {
+			server := healthServer(http.StatusOK)
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 2*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithHealthProbe(server.URL, true))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusTrue))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonHealthCheckPassed))
+		})
+
+		It("reports failure when the endpoint still answers with an error status at the deadline", func() {
+			server := healthServer(http.StatusServiceUnavailable)
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 300*time.Millisecond, "Available", "test-pod", "adapter", mock,
+				reporter.WithHealthProbe(server.URL, true),
+				reporter.WithResultAnnotation(k8s.ResultAnnotation, true))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonHealthCheckFailed))
+			Expect(mock.LastUpdatedCondition.Message).To(ContainSubstring("HTTP 503"))
+
+			var annotation reporter.ResultAnnotation
+			Expect(json.Unmarshal([]byte(mock.Annotations[k8s.ResultAnnotation]), &annotation)).To(Succeed())
+			sum := sha256.Sum256([]byte(`{"statusCode":503}`))
+			Expect(annotation.DetailsDigest).To(Equal("sha256:" + hex.EncodeToString(sum[:])))
+		})
+
+		It("keeps probing until the endpoint answers 2xx", func() {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if requests.Add(1) < 3 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			DeferCleanup(server.Close)
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 2*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithHealthProbe(server.URL, true))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonHealthCheckPassed))
+			Expect(requests.Load()).To(BeNumerically(">=", 3))
+		})
+
+		It("keeps polling the result file alongside the probe", func() {
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"success","reason":"FromFile","message":"ok"}`), 0o644)).To(Succeed())
+			r := reporter.NewReporterWithClient(resultsPath, 50*time.Millisecond, 2*time.Second, "Available", "test-pod", "adapter", mock,
+				reporter.WithHealthProbe("http://127.0.0.1:1/healthz", false))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("FromFile"))
+		})
+	})
+
+	Describe("cleanup failure policy", func() {
+		// containerState is per spec so a termination scheduled by an earlier spec cannot leak into the next
+		type containerState struct {
+			mu         sync.Mutex
+			terminated *corev1.ContainerStateTerminated
+		}
+
+		var (
+			resultsPath string
+			state       *containerState
+		)
+
+		terminate := func(exitCode int32) {
+			s := state
+			time.AfterFunc(100*time.Millisecond, func() {
+				s.mu.Lock()
+				defer s.mu.Unlock()
+				s.terminated = &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: exitCode}
+			})
+		}
+
+		newReporter := func(policy string) *reporter.StatusReporter {
+			return reporter.NewReporterWithClientAndIntervals(resultsPath, 20*time.Millisecond, 5*time.Second, 50*time.Millisecond,
+				"Available", "test-pod", "adapter", mock, reporter.WithCleanupFailurePolicy(policy, time.Second))
+		}
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`), 0644)).To(Succeed())
+			s := &containerState{}
+			state = s
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				s.mu.Lock()
+				defer s.mu.Unlock()
+				if s.terminated == nil {
+					return &corev1.ContainerStatus{Name: "adapter", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}, nil
+				}
+				return &corev1.ContainerStatus{Name: "adapter", State: corev1.ContainerState{Terminated: s.terminated}}, nil
+			}
+		})
+
+		It("keeps the success result under the ignore policy", func() {
+			terminate(1)
+
+			Expect(newReporter(reporter.CleanupFailurePolicyIgnore).Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusTrue))
+		})
+
+		It("escalates to failure when the container exits non-zero within the grace period", func() {
+			terminate(1)
+
+			Expect(newReporter(reporter.CleanupFailurePolicyEscalate).Run(ctx)).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
+			Expect(mock.LastUpdatedCondition.Message).To(ContainSubstring("after writing a success result"))
+		})
+
+		It("keeps the success result when the container does not exit within the grace period", func() {
+			Expect(newReporter(reporter.CleanupFailurePolicyEscalate).Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusTrue))
+		})
+	})
+
+	Describe("deadline polling", func() {
+		It("picks up a result written between regular polls just before the timeout", func() {
+			resultsPath := filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				return &corev1.ContainerStatus{Name: "adapter", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}, nil
+			}
+			// Regular polls run every 700ms, so the next one after 3.55s would come after the 4s timeout;
+			// the final 10% of the window (from 3.6s) is polled every 175ms
+			time.AfterFunc(3550*time.Millisecond, func() {
+				_ = os.WriteFile(resultsPath, []byte(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`), 0644)
+			})
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 700*time.Millisecond, 4*time.Second, 10*time.Second,
+				"Available", "test-pod", "adapter", mock, reporter.WithDeadlinePolling(true))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("AllChecksPassed"))
+		})
+	})
+
+	Describe("message key=value suffix", func() {
+		It("appends the configured keys to the reported message", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithMessageKVSuffix(reporter.MessageKVStatus, reporter.MessageKVReason, reporter.MessageKVElapsed))
+
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusSuccess, Reason: "AllChecksPassed", Message: "All checks passed"})).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Message).To(MatchRegexp(`^All checks passed \[status=True reason=AllChecksPassed elapsed=\d+s\]$`))
+		})
+
+		It("leaves the message unchanged by default", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock)
+
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusSuccess, Reason: "AllChecksPassed", Message: "All checks passed"})).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Message).To(Equal("All checks passed"))
+		})
+	})
+
+	Describe("minimum failure severity", func() {
+		newReporter := func(opts ...reporter.Option) *reporter.StatusReporter {
+			return reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock, opts...)
+		}
+
+		It("reports a failure below the threshold as Unknown", func() {
+			r := newReporter(reporter.WithMinFailureSeverity(result.SeverityHigh))
+
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusFailure, Reason: "LintWarnings", Message: "Minor issues", Severity: result.SeverityLow})).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusUnknown))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("LintWarnings"))
+		})
+
+		It("reports a failure at the threshold as False", func() {
+			r := newReporter(reporter.WithMinFailureSeverity(result.SeverityHigh))
+
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusFailure, Reason: "DNSFailed", Message: "DNS broken", Severity: result.SeverityHigh})).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+		})
+
+		It("reports a failure without severity as False", func() {
+			r := newReporter(reporter.WithMinFailureSeverity(result.SeverityHigh))
+
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusFailure, Reason: "DNSFailed", Message: "DNS broken"})).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+		})
+
+		It("ignores severity by default", func() {
+			r := newReporter()
+
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusFailure, Reason: "LintWarnings", Message: "Minor issues", Severity: result.SeverityInfo})).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+		})
+	})
+
+	Describe("condition routes", func() {
+		var r *reporter.StatusReporter
+
+		BeforeEach(func() {
+			r = reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithConditionRoutes(
+					reporter.ConditionRoute{Pattern: "DNS*", ConditionType: "DNSReady"},
+					reporter.ConditionRoute{Pattern: "Cert*", ConditionType: "CertificatesReady"},
+				))
+		})
+
+		It("sets the condition type of the first matching route", func() {
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusFailure, Reason: "DNSZoneMissing", Message: "zone missing"})).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Type).To(Equal("DNSReady"))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("DNSZoneMissing"))
+		})
+
+		It("uses the configured condition type when no route matches", func() {
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusSuccess, Reason: "AllChecksPassed", Message: "ok"})).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Type).To(Equal("Available"))
+		})
+
+		It("routes the reporter's own reasons", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithConditionRoutes(reporter.ConditionRoute{Pattern: "Adapter*", ConditionType: "AdapterReady"}))
+
+			Expect(r.UpdateFromTimeout(ctx)).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Type).To(Equal("AdapterReady"))
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterTimeout))
+		})
+	})
+
+	Describe("adapter container annotations", func() {
+		BeforeEach(func() {
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				return &corev1.ContainerStatus{
+					Name:    "adapter",
+					Image:   "quay.io/hyperfleet/adapter:v1",
+					ImageID: "quay.io/hyperfleet/adapter@sha256:0123",
+				}, nil
+			}
+		})
+
+		It("records the adapter image digest after setting the condition", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithAdapterImageAnnotation(true))
+
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusSuccess, Reason: "AllChecksPassed", Message: "ok"})).To(Succeed())
+			Expect(mock.Annotations).To(HaveKeyWithValue(k8s.AdapterImageAnnotation, "quay.io/hyperfleet/adapter@sha256:0123"))
+		})
+
+		It("does not fail the update when the annotation cannot be written", func() {
+			mock.AnnotateJobFunc = func(ctx context.Context, annotations map[string]string) error {
+				return errors.New("forbidden")
+			}
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithAdapterImageAnnotation(true))
+
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusSuccess, Reason: "AllChecksPassed", Message: "ok"})).To(Succeed())
+		})
+
+		It("records the restart count alongside the image", func() {
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				return &corev1.ContainerStatus{Name: "adapter", Image: "quay.io/hyperfleet/adapter:v1", RestartCount: 3}, nil
+			}
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithAdapterImageAnnotation(true), reporter.WithRestartCountAnnotation(true))
+
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusSuccess, Reason: "AllChecksPassed", Message: "ok"})).To(Succeed())
+			Expect(mock.Annotations).To(Equal(map[string]string{
+				k8s.AdapterImageAnnotation: "quay.io/hyperfleet/adapter:v1",
+				k8s.RestartCountAnnotation: "3",
+			}))
+		})
+
+		It("records a zero restart count", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithRestartCountAnnotation(true))
+
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusSuccess, Reason: "AllChecksPassed", Message: "ok"})).To(Succeed())
+			Expect(mock.Annotations).To(Equal(map[string]string{k8s.RestartCountAnnotation: "0"}))
+		})
+
+		It("does not annotate by default", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock)
+
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusSuccess, Reason: "AllChecksPassed", Message: "ok"})).To(Succeed())
+			Expect(mock.Annotations).To(BeEmpty())
+		})
+	})
+
+	Describe("additional conditions", func() {
+		It("sets the adapter's conditions together with the reported condition", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock)
+
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{
+				Status:  result.StatusSuccess,
+				Reason:  "AllChecksPassed",
+				Message: "ok",
+				Conditions: []result.Condition{
+					{Type: "DNSReady", Status: "True", Reason: "DNSConfigured", Message: "DNS resolves"},
+					{Type: "Available", Status: "False", Reason: "Ignored", Message: "set from the status"},
+					{Type: "QuotaAvailable", Status: "False", Reason: "QuotaExceeded", Message: "CPU quota exceeded"},
+				},
+			})).To(Succeed())
+
+			Expect(mock.LastUpdatedConditions).To(Equal([]k8s.JobCondition{
+				{Type: "Available", Status: "True", Reason: "AllChecksPassed", Message: "ok"},
+				{Type: "DNSReady", Status: "True", Reason: "DNSConfigured", Message: "DNS resolves"},
+				{Type: "QuotaAvailable", Status: "False", Reason: "QuotaExceeded", Message: "CPU quota exceeded"},
+			}))
+		})
+
+		It("parses conditions from the result file", func() {
+			resultsPath := filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"failure","reason":"QuotaExceeded","message":"no quota",`+
+				`"conditions":[{"type":"DNSReady","status":"True","reason":"DNSConfigured","message":"DNS resolves"}]}`), 0644)).To(Succeed())
+			r := reporter.NewReporterWithClient(resultsPath, time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock)
+
+			Expect(r.HandleTermination(ctx, &corev1.ContainerStateTerminated{ExitCode: 0, Reason: "Completed"})).To(Succeed())
+
+			Expect(mock.LastUpdatedConditions).To(HaveLen(2))
+			Expect(mock.LastUpdatedConditions[0].Status).To(Equal("False"))
+			Expect(mock.LastUpdatedConditions[1].Type).To(Equal("DNSReady"))
+		})
+	})
+
+	Describe("notification", func() {
+		var callback *fakeCallbackClient
+
+		BeforeEach(func() {
+			callback = &fakeCallbackClient{}
+		})
+
+		It("posts a Slack message when the run fails", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithJobReference("test-job", "test-ns"),
+				reporter.WithNotification(callback, reporter.NotifyFormatSlack, false))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"failure","reason":"DNSFailed","message":"no records"}`))).To(Succeed())
+
+			Expect(callback.outcomes).To(ConsistOf(reporter.SlackMessage{
+				Text: "*Validation failed: Job test-ns/test-job*\nAvailable=False (reason: DNSFailed): no records",
+			}))
+		})
+
+		It("posts a Teams message card", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithJobReference("test-job", "test-ns"),
+				reporter.WithNotification(callback, reporter.NotifyFormatTeams, false))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{invalid`))).NotTo(Succeed())
+
+			Expect(callback.outcomes).To(HaveLen(1))
+			card := callback.outcomes[0].(reporter.TeamsMessageCard)
+			Expect(card.Type).To(Equal("MessageCard"))
+			Expect(card.Title).To(Equal("Validation failed: Job test-ns/test-job"))
+			Expect(card.Text).To(ContainSubstring("reason: InvalidResultSyntax"))
+		})
+
+		It("does not notify on success unless enabled", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithNotification(callback, reporter.NotifyFormatSlack, false))
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`))).To(Succeed())
+			Expect(callback.outcomes).To(BeEmpty())
+
+			r = reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithNotification(callback, reporter.NotifyFormatSlack, true))
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`))).To(Succeed())
+			Expect(callback.outcomes).To(ConsistOf(HaveField("Text", ContainSubstring("Validation succeeded"))))
+		})
+	})
+
+	Describe("email notification", func() {
+		var mailer *fakeEmailClient
+
+		BeforeEach(func() {
+			mailer = &fakeEmailClient{}
+		})
+
+		It("emails a summary once per Job when the run fails with a listed reason", func() {
+			newReporter := func() *reporter.StatusReporter {
+				return reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+					reporter.WithJobReference("test-job", "test-ns"),
+					reporter.WithEmailNotification(mailer, []string{"DNSFailed"}))
+			}
+
+			Expect(newReporter().RunFromReader(ctx, strings.NewReader(`{"status":"failure","reason":"DNSFailed","message":"no records"}`))).To(Succeed())
+			Expect(newReporter().RunFromReader(ctx, strings.NewReader(`{"status":"failure","reason":"DNSFailed","message":"no records"}`))).To(Succeed())
+
+			Expect(mailer.subjects).To(Equal([]string{"Validation failed: Job test-ns/test-job (DNSFailed)"}))
+			Expect(mailer.bodies[0]).To(ContainSubstring("Condition:  Available=False\n"))
+			Expect(mailer.bodies[0]).To(ContainSubstring("Message:    no records\n"))
+			Expect(mock.Annotations).To(HaveKey(k8s.EmailNotificationAnnotation))
+		})
+
+		It("does not email for other reasons", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithEmailNotification(mailer, reporter.DefaultEmailReasons))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"failure","reason":"DNSFailed","message":"no records"}`))).To(Succeed())
+
+			Expect(mailer.subjects).To(BeEmpty())
+			Expect(mock.Annotations).NotTo(HaveKey(k8s.EmailNotificationAnnotation))
+		})
+
+		It("releases the claim when delivery fails", func() {
+			mailer.err = errors.New("connection refused")
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithEmailNotification(mailer, []string{"DNSFailed"}))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"failure","reason":"DNSFailed","message":"no records"}`))).To(Succeed())
+
+			Expect(mailer.subjects).To(HaveLen(1))
+			Expect(mock.Annotations).NotTo(HaveKey(k8s.EmailNotificationAnnotation))
+		})
+	})
+
+	Describe("message bus", func() {
+		var bus *fakeCallbackClient
+
+		BeforeEach(func() {
+			bus = &fakeCallbackClient{}
+		})
+
+		It("publishes the outcome with the adapter result", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithJobReference("test-job", "test-ns"),
+				reporter.WithMessageBus(bus, reporter.MessageBusNATS))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`))).To(Succeed())
+
+			Expect(bus.outcomes).To(HaveLen(1))
+			payload := bus.outcomes[0].(reporter.CallbackPayload)
+			Expect(payload.JobName).To(Equal("test-job"))
+			Expect(payload.Reason).To(Equal("AllChecksPassed"))
+			Expect(payload.Result).NotTo(BeNil())
+			Expect(payload.Result.Message).To(Equal("ok"))
+		})
+
+		It("wraps the payload in a Kafka record keyed by the Job", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithJobReference("test-job", "test-ns"),
+				reporter.WithMessageBus(bus, reporter.MessageBusKafkaREST))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"failure","reason":"DNSFailed","message":"no records"}`))).To(Succeed())
+
+			Expect(bus.outcomes).To(HaveLen(1))
+			records := bus.outcomes[0].(reporter.KafkaRecords)
+			Expect(records.Records).To(HaveLen(1))
+			Expect(records.Records[0].Key).To(Equal("test-ns/test-job"))
+			Expect(records.Records[0].Value).To(HaveField("Reason", "DNSFailed"))
+		})
+
+		It("ignores publish failures", func() {
+			bus.err = errors.New("connection refused")
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithMessageBus(bus, reporter.MessageBusNATS))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`))).To(Succeed())
+		})
+	})
+
+	Describe("result archive", func() {
+		var store *fakeObjectUploader
+
+		BeforeEach(func() {
+			store = &fakeObjectUploader{}
+			mock.GetJobUIDFunc = func(ctx context.Context) (string, error) {
+				return "job-uid", nil
+			}
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				return &corev1.ContainerStatus{Name: "adapter"}, nil
+			}
+			mock.GetContainerLogTailFunc = func(ctx context.Context, podName, containerName string, lines int64) (string, error) {
+				return fmt.Sprintf("%s/%s last %d lines", podName, containerName, lines), nil
+			}
+		})
+
+		It("uploads the outcome, result, raw result and log tail keyed by Job UID and annotates the result URL", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithResultArchive(store, "audit/", 200))
+
+			raw := `{"status": "success", "reason": "AllChecksPassed", "message": "ok", "details": {"checks": 3}}`
+			Expect(r.RunFromReader(ctx, strings.NewReader(raw))).To(Succeed())
+
+			Expect(store.objects).To(HaveKey("audit/job-uid/outcome.json"))
+			Expect(store.objects).To(HaveKeyWithValue("audit/job-uid/result.json", ContainSubstring(`"checks":3`)))
+			Expect(store.objects).To(HaveKeyWithValue("audit/job-uid/result.raw", raw))
+			Expect(store.objects).To(HaveKeyWithValue("audit/job-uid/adapter.log", "test-pod/adapter last 200 lines"))
+			Expect(mock.Annotations).To(HaveKeyWithValue(k8s.ResultArchiveAnnotation, "s3://bucket/audit/job-uid/result.json"))
+		})
+
+		It("annotates the outcome URL when there is no adapter result", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithResultArchive(store, "", 0))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{invalid`))).NotTo(Succeed())
+
+			Expect(store.objects).To(HaveLen(1))
+			Expect(mock.Annotations).To(HaveKeyWithValue(k8s.ResultArchiveAnnotation, "s3://bucket/job-uid/outcome.json"))
+		})
+
+		It("still archives the result when the log cannot be read", func() {
+			mock.GetContainerLogTailFunc = func(ctx context.Context, podName, containerName string, lines int64) (string, error) {
+				return "", errors.New("forbidden")
+			}
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithResultArchive(store, "", 50))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`))).To(Succeed())
+
+			Expect(store.objects).To(HaveKey("job-uid/result.json"))
+			Expect(store.objects).NotTo(HaveKey("job-uid/adapter.log"))
+			Expect(mock.Annotations).To(HaveKey(k8s.ResultArchiveAnnotation))
+		})
+	})
+
+	Describe("sinks", func() {
+		const successResult = `{"status":"success","reason":"AllChecksPassed","message":"ok"}`
+
+		It("publishes to a sink once without a retry policy", func() {
+			sink := &fakeSink{name: "flaky", failures: 1}
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithSink(sink, reporter.SinkPolicy{Fatal: true}))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(successResult))).To(MatchError(ContainSubstring("flaky failed")))
+			Expect(sink.calls.Load()).To(Equal(int32(1)))
+		})
+
+		It("retries each sink under its own policy", func() {
+			flaky := &fakeSink{name: "flaky", failures: 2}
+			down := &fakeSink{name: "down", failures: 10}
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithSink(flaky, reporter.SinkPolicy{Fatal: true, Retry: retry.Policy{MaxRetries: 2, Interval: time.Millisecond}}),
+				reporter.WithSink(down, reporter.SinkPolicy{Retry: retry.Policy{MaxRetries: 1, Interval: time.Millisecond}}))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(successResult))).To(Succeed())
+			Expect(flaky.calls.Load()).To(Equal(int32(3)))
+			Expect(down.calls.Load()).To(Equal(int32(2)))
+		})
+
+		It("delivers to the sinks after the run context is cancelled", func() {
+			var sinkCtxErr error
+			sink := &fakeSink{name: "callback", publish: func(ctx context.Context, outcome reporter.Outcome) error {
+				sinkCtxErr = ctx.Err()
+				_, hasDeadline := ctx.Deadline()
+				Expect(hasDeadline).To(BeTrue())
+				return nil
+			}}
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithSink(sink, reporter.SinkPolicy{Fatal: true}))
+			cancelled, cancel := context.WithCancel(ctx)
+			cancel()
+
+			Expect(r.RunFromReader(cancelled, strings.NewReader(successResult))).To(Succeed())
+			Expect(sinkCtxErr).NotTo(HaveOccurred())
+		})
+
+		It("delivers to the sinks only after the Job condition is written", func() {
+			conditionWritten := func(ctx context.Context, outcome reporter.Outcome) error {
+				if mock.LastUpdatedCondition.Reason != "AllChecksPassed" {
+					return errors.New("sink ran before the Job condition was written")
+				}
+				return nil
+			}
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithSink(&fakeSink{name: "first", publish: conditionWritten}, reporter.SinkPolicy{Fatal: true}),
+				reporter.WithSink(&fakeSink{name: "second", publish: conditionWritten}, reporter.SinkPolicy{Fatal: true}))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(successResult))).To(Succeed())
+		})
+
+		It("gives every sink the same outcome and adapter result", func() {
+			// Run with -race: the sinks read the reported result concurrently
+			first, second := &fakeSink{name: "first"}, &fakeSink{name: "second"}
+			callback := &fakeCallbackClient{}
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithSink(first, reporter.SinkPolicy{}),
+				reporter.WithSink(second, reporter.SinkPolicy{}),
+				reporter.WithCallback(callback, false),
+				reporter.WithCallbackResult(true),
+				reporter.WithResultAnnotation(k8s.ResultAnnotation, true))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"success","reason":"AllChecksPassed","message":"ok","details":{"checks":3}}`))).To(Succeed())
+
+			Expect(first.outcome.Reason).To(Equal("AllChecksPassed"))
+			Expect(second.outcome).To(Equal(first.outcome))
+			Expect(callback.outcomes).To(HaveLen(1))
+			payload := callback.outcomes[0].(reporter.CallbackPayload)
+			Expect(payload.Outcome).To(Equal(first.outcome))
+			Expect(string(payload.Result.Details)).To(MatchJSON(`{"checks":3}`))
+			Expect(mock.Annotations).To(HaveKey(k8s.ResultAnnotation))
+		})
+
+		It("invokes the sinks concurrently", func() {
+			// Each sink waits for the other to start, which only succeeds when they run concurrently
+			var wg sync.WaitGroup
+			wg.Add(2)
+			wait := func(ctx context.Context, outcome reporter.Outcome) error {
+				wg.Done()
+				done := make(chan struct{})
+				go func() { wg.Wait(); close(done) }()
+				select {
+				case <-done:
+					return nil
+				case <-time.After(2 * time.Second):
+					return errors.New("sinks ran sequentially")
+				}
+			}
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithSink(&fakeSink{name: "first", publish: wait}, reporter.SinkPolicy{Fatal: true}),
+				reporter.WithSink(&fakeSink{name: "second", publish: wait}, reporter.SinkPolicy{Fatal: true}))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(successResult))).To(Succeed())
+		})
+
+		It("fails the run on any sink failure with the any policy", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithSink(&fakeSink{name: "ok"}, reporter.SinkPolicy{}),
+				reporter.WithSink(&fakeSink{name: "broken", failures: 1}, reporter.SinkPolicy{}),
+				reporter.WithSinkFailurePolicy(reporter.SinkFailurePolicyAny))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(successResult))).To(MatchError(ContainSubstring("broken failed")))
+		})
+
+		It("fails the run only when every sink failed with the all policy", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithSink(&fakeSink{name: "ok"}, reporter.SinkPolicy{Fatal: true}),
+				reporter.WithSink(&fakeSink{name: "broken", failures: 1}, reporter.SinkPolicy{Fatal: true}),
+				reporter.WithSinkFailurePolicy(reporter.SinkFailurePolicyAll))
+			Expect(r.RunFromReader(ctx, strings.NewReader(successResult))).To(Succeed())
+
+			r = reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithSink(&fakeSink{name: "down", failures: 1}, reporter.SinkPolicy{}),
+				reporter.WithSink(&fakeSink{name: "broken", failures: 1}, reporter.SinkPolicy{}),
+				reporter.WithSinkFailurePolicy(reporter.SinkFailurePolicyAll))
+			err := r.RunFromReader(ctx, strings.NewReader(successResult))
+			Expect(err).To(MatchError(ContainSubstring("down failed")))
+			Expect(err).To(MatchError(ContainSubstring("broken failed")))
+		})
+	})
+
+	Describe("Argo Workflows", func() {
+		It("writes the outcome as output parameter files", func() {
+			dir := filepath.Join(GinkgoT().TempDir(), "outputs")
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithArgoOutputs(dir))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"failure","reason":"DNSFailed","message":"no records","details":{"zone":"example.com"}}`))).To(Succeed())
+
+			read := func(name string) string {
+				data, err := os.ReadFile(filepath.Join(dir, name))
+				Expect(err).NotTo(HaveOccurred())
+				return string(data)
+			}
+			Expect(read(reporter.ArgoOutputStatus)).To(Equal("False"))
+			Expect(read(reporter.ArgoOutputReason)).To(Equal("DNSFailed"))
+			Expect(read(reporter.ArgoOutputMessage)).To(Equal("no records"))
+			Expect(read(reporter.ArgoOutputOutcome)).To(ContainSubstring(`"zone":"example.com"`))
+		})
+
+		It("sets the node message in addition to the Job condition", func() {
+			node := &fakeWorkflowNodeClient{}
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithArgoNodeStatus(node, "provision-123", false))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`))).To(Succeed())
+
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("AllChecksPassed"))
+			Expect(node.messages).To(HaveKeyWithValue("provision-123", "Available=True AllChecksPassed: ok"))
+		})
+
+		It("reports only to the node when it is the primary sink", func() {
+			node := &fakeWorkflowNodeClient{}
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithArgoNodeStatus(node, "provision-123", true))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`))).To(Succeed())
+
+			Expect(mock.LastUpdatedCondition.Type).To(BeEmpty())
+			Expect(node.messages).To(HaveKeyWithValue("provision-123", "Available=True AllChecksPassed: ok"))
+		})
+
+		It("fails the run when the primary node update fails", func() {
+			node := &fakeWorkflowNodeClient{err: errors.New("workflows.argoproj.io is forbidden")}
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithArgoNodeStatus(node, "provision-123", true))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`))).To(MatchError(ContainSubstring("forbidden")))
+		})
+	})
+
+	Describe("fleet manager", func() {
+		It("reports the outcome and result details for the cluster", func() {
+			client := &fakeFleetManagerClient{}
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithJobReference("validate-cluster-1", "hyperfleet"),
+				reporter.WithFleetManager(client, "cluster-1", false))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"failure","reason":"DNSFailed","message":"no records","details":{"zone": "example.com"}}`))).To(Succeed())
+
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("DNSFailed"))
+			Expect(client.reports).To(HaveLen(1))
+			report := client.reports[0]
+			Expect(report.ClusterID).To(Equal("cluster-1"))
+			Expect(report.ConditionType).To(Equal("Available"))
+			Expect(report.Status).To(Equal("False"))
+			Expect(report.Reason).To(Equal("DNSFailed"))
+			Expect(report.Message).To(Equal("no records"))
+			Expect(report.JobNamespace).To(Equal("hyperfleet"))
+			Expect(report.JobName).To(Equal("validate-cluster-1"))
+			Expect(report.ObservedTime).NotTo(BeZero())
+			Expect(report.DetailsJSON).To(Equal(`{"zone":"example.com"}`))
+		})
+
+		It("ignores a failed call unless it is fatal", func() {
+			const successResult = `{"status":"success","reason":"AllChecksPassed","message":"ok"}`
+			client := &fakeFleetManagerClient{err: errors.New("fleet manager returned gRPC status 14: unavailable")}
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithFleetManager(client, "cluster-1", false))
+			Expect(r.RunFromReader(ctx, strings.NewReader(successResult))).To(Succeed())
+
+			r = reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithFleetManager(client, "cluster-1", true))
+			Expect(r.RunFromReader(ctx, strings.NewReader(successResult))).To(MatchError(ContainSubstring("gRPC status 14")))
+		})
+	})
+
+	Describe("result annotation", func() {
+		It("writes the reported reason and message to the annotation", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithResultAnnotation(k8s.ResultAnnotation, false))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"success","reason":"AllChecksPassed","message":"ok","details":{"checks":3}}`))).To(Succeed())
+
+			Expect(mock.Annotations).To(HaveKeyWithValue(k8s.ResultAnnotation,
+				`{"conditionType":"Available","status":"True","reason":"AllChecksPassed","message":"ok"}`))
+		})
+
+		It("includes a digest of the compacted details", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithResultAnnotation("example.com/result", true))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"failure","reason":"DNSFailed","message":"no","details": { "checks": 3 }}`))).To(Succeed())
+
+			var annotation reporter.ResultAnnotation
+			Expect(json.Unmarshal([]byte(mock.Annotations["example.com/result"]), &annotation)).To(Succeed())
+			sum := sha256.Sum256([]byte(`{"checks":3}`))
+			Expect(annotation.DetailsDigest).To(Equal("sha256:" + hex.EncodeToString(sum[:])))
+			Expect(annotation.Status).To(Equal("False"))
+		})
+
+		It("omits the digest when the result could not be parsed", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithResultAnnotation(k8s.ResultAnnotation, true))
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{invalid`))).NotTo(Succeed())
+
+			Expect(mock.Annotations[k8s.ResultAnnotation]).To(ContainSubstring(`"reason":"InvalidResultSyntax"`))
+			Expect(mock.Annotations[k8s.ResultAnnotation]).NotTo(ContainSubstring("detailsDigest"))
+		})
+	})
+
+	Describe("result ConfigMap", func() {
+		It("stores the outcome and the full adapter result", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithJobReference("test-job", "test-ns"), reporter.WithResultConfigMap())
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{"status":"success","reason":"AllChecksPassed","message":"ok","details":{"checks":3}}`))).To(Succeed())
+
+			data := mock.ConfigMaps["test-job"+reporter.ResultConfigMapSuffix]
+			var stored result.AdapterResult
+			Expect(json.Unmarshal([]byte(data[reporter.ResultConfigMapResultKey]), &stored)).To(Succeed())
+			Expect(stored.Reason).To(Equal("AllChecksPassed"))
+			Expect(stored.Details).To(MatchJSON(`{"checks":3}`))
+			var outcome reporter.Outcome
+			Expect(json.Unmarshal([]byte(data[reporter.ResultConfigMapOutcomeKey]), &outcome)).To(Succeed())
+			Expect(outcome.Status).To(Equal("True"))
+			Expect(outcome.JobName).To(Equal("test-job"))
+		})
+
+		It("stores only the outcome when no adapter result was reported", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithJobReference("test-job", "test-ns"), reporter.WithResultConfigMap())
+
+			Expect(r.RunFromReader(ctx, strings.NewReader(`{invalid`))).NotTo(Succeed())
+
+			data := mock.ConfigMaps["test-job"+reporter.ResultConfigMapSuffix]
+			Expect(data).To(HaveKey(reporter.ResultConfigMapOutcomeKey))
+			Expect(data).NotTo(HaveKey(reporter.ResultConfigMapResultKey))
+		})
+	})
+
+	Describe("pod condition", func() {
+		It("sets the reported condition on the pod", func() {
+			var podName string
+			mock.UpdatePodConditionFunc = func(ctx context.Context, name string, condition k8s.JobCondition) error {
+				podName = name
+				return nil
+			}
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithPodCondition(true))
+
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusSuccess, Reason: "AllChecksPassed", Message: "ok"})).To(Succeed())
+			Expect(podName).To(Equal("test-pod"))
+			Expect(mock.LastPodCondition).To(Equal(mock.LastUpdatedCondition))
+		})
+
+		It("ignores a failed pod update", func() {
+			mock.UpdatePodConditionFunc = func(ctx context.Context, name string, condition k8s.JobCondition) error {
+				return errors.New("forbidden")
+			}
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock,
+				reporter.WithPodCondition(true))
+
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusSuccess, Reason: "AllChecksPassed", Message: "ok"})).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Status).To(Equal("True"))
+		})
+
+		It("does not set a pod condition by default", func() {
+			r := reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock)
+
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusSuccess, Reason: "AllChecksPassed", Message: "ok"})).To(Succeed())
+			Expect(mock.LastPodCondition).To(BeZero())
+		})
+	})
+
+	Describe("events", func() {
+		var r *reporter.StatusReporter
+
+		BeforeEach(func() {
+			r = reporter.NewReporterWithClient("/results/result.json", time.Second, 5*time.Minute, "Available", "test-pod", "adapter", mock)
+		})
+
+		It("records a Normal event for a successful result", func() {
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusSuccess, Reason: "AllChecksPassed", Message: "ok"})).To(Succeed())
+
+			Expect(mock.Events).To(HaveLen(1))
+			Expect(mock.Events[0].Type).To(Equal(corev1.EventTypeNormal))
+			Expect(mock.Events[0].Reason).To(Equal(reporter.EventReasonResultReceived))
+			Expect(mock.Events[0].Message).To(ContainSubstring("reason=AllChecksPassed"))
+		})
+
+		It("records a Warning event for a failed result", func() {
+			Expect(r.UpdateFromResult(ctx, &result.AdapterResult{Status: result.StatusFailure, Reason: "DNSFailed", Message: "no"})).To(Succeed())
+
+			Expect(mock.Events).To(ConsistOf(HaveField("Type", corev1.EventTypeWarning)))
+		})
+
+		It("records a Warning event for a parse failure", func() {
+			Expect(r.UpdateFromError(ctx, &result.SyntaxError{Err: errors.New("unexpected EOF")})).To(HaveOccurred())
+
+			Expect(mock.Events).To(ConsistOf(testhelpers.MockEvent{
+				Type:    corev1.EventTypeWarning,
+				Reason:  reporter.ReasonInvalidResultSyntax,
+				Message: mock.LastUpdatedCondition.Message,
+			}))
+		})
+
+		It("records a Warning event for an OOMKilled adapter", func() {
+			Expect(r.UpdateFromTerminatedContainer(ctx, &corev1.ContainerStateTerminated{Reason: reporter.ContainerReasonOOMKilled, ExitCode: 137})).To(HaveOccurred())
+
+			Expect(mock.Events).To(ConsistOf(HaveField("Reason", reporter.ReasonAdapterOOMKilled)))
+		})
+
+		It("does not record an event for other container exits", func() {
+			Expect(r.UpdateFromTerminatedContainer(ctx, &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1})).To(HaveOccurred())
+
+			Expect(mock.Events).To(BeEmpty())
+		})
+
+		It("records a Warning event for a timeout", func() {
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				return &corev1.ContainerStatus{Name: "adapter", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}, nil
+			}
+
+			Expect(r.UpdateFromTimeout(ctx)).To(HaveOccurred())
+
+			Expect(mock.Events).To(ConsistOf(HaveField("Reason", reporter.ReasonAdapterTimeout)))
+		})
+	})
+
+	Describe("stop polling on termination", func() {
+		var logBuf *bytes.Buffer
+
+		BeforeEach(func() {
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				return &corev1.ContainerStatus{
+					Name:  "adapter",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 2}},
+				}, nil
+			}
+			logBuf = &bytes.Buffer{}
+			log.SetOutput(logBuf)
+			DeferCleanup(func() { log.SetOutput(os.Stderr) })
+		})
+
+		It("stops the result file poller when the adapter exits without a result file", func() {
+			resultsPath := filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 20*time.Millisecond, 5*time.Second, 50*time.Millisecond,
+				"Available", "test-pod", "adapter", mock, reporter.WithStopPollingOnTermination(true))
+
+			Expect(r.Run(ctx)).To(HaveOccurred())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
+			Expect(logBuf.String()).To(ContainSubstring("stopping result file polling"))
+		})
+
+		It("keeps the poller running when a result file exists", func() {
+			resultsPath := filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"failure","reason":"ChecksFailed","message":"failed"}`), 0644)).To(Succeed())
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 20*time.Millisecond, 5*time.Second, 50*time.Millisecond,
+				"Available", "test-pod", "adapter", mock, reporter.WithStopPollingOnTermination(true))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(mock.LastUpdatedCondition.Reason).To(Equal("ChecksFailed"))
+			Expect(logBuf.String()).NotTo(ContainSubstring("stopping result file polling"))
+		})
+	})
+
+	Describe("pod name prefix", func() {
+		var resultsPath string
+
+		BeforeEach(func() {
+			resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+			Expect(os.WriteFile(resultsPath, []byte(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`), 0644)).To(Succeed())
+		})
+
+		It("resolves the pod name before inspecting the pod", func() {
+			var inspected string
+			mock.FindPodByPrefixFunc = func(ctx context.Context, prefix string) (string, error) {
+				return prefix + "abc12", nil
+			}
+			mock.GetAdapterContainerStatusFunc = func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
+				inspected = podName
+				return &corev1.ContainerStatus{Name: "adapter", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}, nil
+			}
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 20*time.Millisecond, 5*time.Second, 50*time.Millisecond,
+				"Available", "validator-", "adapter", mock, reporter.WithPodNamePrefix(true))
+
+			Expect(r.Run(ctx)).To(Succeed())
+			Expect(inspected).To(Equal("validator-abc12"))
+		})
+
+		It("fails the run when the prefix does not select a single pod", func() {
+			mock.FindPodByPrefixFunc = func(ctx context.Context, prefix string) (string, error) {
+				return "", errors.New("multiple pods found")
+			}
+			r := reporter.NewReporterWithClientAndIntervals(resultsPath, 20*time.Millisecond, 5*time.Second, 50*time.Millisecond,
+				"Available", "validator-", "adapter", mock, reporter.WithPodNamePrefix(true))
+
+			Expect(r.Run(ctx)).To(MatchError(ContainSubstring("failed to resolve pod name")))
+		})
+	})
+
+})
+
+type fakeCallbackClient struct {
+	outcomes []any
+	err      error
+}
+
+func (f *fakeCallbackClient) Post(ctx context.Context, payload any) error {
+	f.outcomes = append(f.outcomes, payload)
+	return f.err
+}
+
+type fakeEmailClient struct {
+	subjects []string
+	bodies   []string
+	err      error
+}
+
+func (f *fakeEmailClient) Send(ctx context.Context, subject, body string) error {
+	f.subjects = append(f.subjects, subject)
+	f.bodies = append(f.bodies, body)
+	return f.err
+}
+
+// fakeSink fails its first failures deliveries, or delegates to publish when set
+type fakeSink struct {
+	name     string
+	failures int32
+	publish  func(ctx context.Context, outcome reporter.Outcome) error
+	calls    atomic.Int32
+	outcome  reporter.Outcome
+}
+
+func (f *fakeSink) Name() string {
+	return f.name
+}
+
+func (f *fakeSink) Publish(ctx context.Context, outcome reporter.Outcome) error {
+	if f.publish != nil {
+		return f.publish(ctx, outcome)
+	}
+	f.outcome = outcome
+	if f.calls.Add(1) <= f.failures {
+		return errors.New("unavailable")
+	}
+	return nil
+}
+
+type fakeWorkflowNodeClient struct {
+	messages map[string]string
+	err      error
+}
+
+func (f *fakeWorkflowNodeClient) PatchNodeMessage(ctx context.Context, nodeID, message string) error {
+	if f.err != nil {
+		return f.err
+	}
+	if f.messages == nil {
+		f.messages = map[string]string{}
+	}
+	f.messages[nodeID] = message
+	return nil
+}
+
+type fakeObjectUploader struct {
+	objects map[string]string
+}
+
+func (f *fakeObjectUploader) Put(ctx context.Context, key, contentType string, body []byte) (string, error) {
+	if f.objects == nil {
+		f.objects = map[string]string{}
+	}
+	f.objects[key] = string(body)
+	return "s3://bucket/" + key, nil
+}
+
+type fakeAggregatorClient struct {
+	runs map[string]any
+	err  error
+}
+
+func (f *fakeAggregatorClient) PatchRun(ctx context.Context, key string, run any) error {
+	if f.runs == nil {
+		f.runs = map[string]any{}
+	}
+	f.runs[key] = run
+	return f.err
+}
+
+type fakeFleetManagerClient struct {
+	reports []fleet.ValidationReport
+	err     error
+}
+
+func (f *fakeFleetManagerClient) ReportClusterValidation(ctx context.Context, report fleet.ValidationReport) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.reports = append(f.reports, report)
+	return nil
+}
+
+type fakeHeartbeat struct {
+	mu       sync.Mutex
+	renewals int
+	released bool
+	err      error
+
+	// releaseDeadline records whether Release was called with a deadline
+	releaseDeadline bool
+}
+
+func (f *fakeHeartbeat) Renew(ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.renewals++
+	return f.err
+}
+
+func (f *fakeHeartbeat) Release(ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.released = true
+	_, f.releaseDeadline = ctx.Deadline()
+	return nil
+}
+
+func (f *fakeHeartbeat) state() (int, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.renewals, f.released
+}
@@ -0,0 +1,192 @@
+package reporter
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+)
+
+const (
+	// ResultEndpointPath is where adapters POST their result
+	ResultEndpointPath = "/result"
+
+	// resultServerShutdownTimeout bounds the graceful shutdown of the result endpoint
+	resultServerShutdownTimeout = 5 * time.Second
+)
+
+// listenForResults opens the listeners of the result endpoint so bind errors fail the run up front
+func (r *StatusReporter) listenForResults() ([]net.Listener, error) {
+	var listeners []net.Listener
+	if r.resultHTTPAddr != "" {
+		listener, err := net.Listen("tcp", r.resultHTTPAddr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to listen for results on %s: %w", r.resultHTTPAddr, err)
+		}
+		listeners = append(listeners, listener)
+	}
+	if r.resultSocketPath != "" {
+		listener, err := listenUnixSocket(r.resultSocketPath)
+		if err != nil {
+			for _, l := range listeners {
+				_ = l.Close()
+			}
+			return nil, err
+		}
+		listeners = append(listeners, listener)
+	}
+	return listeners, nil
+}
+
+// listenUnixSocket listens on a unix domain socket, replacing a stale socket left by a previous
+// container run. The socket is world-writable because the adapter usually runs as another user;
+// access is limited by the volume it lives in. Closing the listener removes the socket file.
+func listenUnixSocket(path string) (net.Listener, error) {
+	if info, err := os.Lstat(path); err == nil && info.Mode()&os.ModeSocket != 0 {
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("failed to remove stale result socket path=%s: %w", path, err)
+		}
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for results on socket path=%s: %w", path, err)
+	}
+	if err := os.Chmod(path, 0o666); err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("failed to set result socket permissions path=%s: %w", path, err)
+	}
+	return listener, nil
+}
+
+// serveResults accepts adapter results on POST /result until the run finishes. A payload is
+// handled exactly like a parsed result file: it is parsed with the same parser, an invalid
+// payload is reported as a failure, and an intermediate reason keeps the reporter waiting (and is
+// reported as progress with progress updates).
+// The response acknowledges whether the result was accepted.
+func (r *StatusReporter) serveResults(ctx context.Context, listeners []net.Listener, channels *pollChannels, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	var delivered atomic.Bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+ResultEndpointPath, func(w http.ResponseWriter, req *http.Request) {
+		r.handleResult(ctx, w, req, channels, &delivered)
+	})
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: resultServerShutdownTimeout}
+
+	go func() {
+		select {
+		case <-channels.done:
+		case <-ctx.Done():
+		}
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), resultServerShutdownTimeout)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	var serving sync.WaitGroup
+	for _, listener := range listeners {
+		if !r.quietStartup {
+			log.Printf("Accepting results on %s %s%s", listener.Addr().Network(), listener.Addr(), ResultEndpointPath)
+		}
+		serving.Add(1)
+		go func() {
+			defer serving.Done()
+			if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Printf("Warning: result endpoint on %s stopped: %v", listener.Addr(), err)
+			}
+		}()
+	}
+	serving.Wait()
+}
+
+// handleResult parses one submitted result and hands it to the run
+func (r *StatusReporter) handleResult(ctx context.Context, w http.ResponseWriter, req *http.Request, channels *pollChannels, delivered *atomic.Bool) {
+	if !r.authorizedResult(w, req) {
+		return
+	}
+	if delivered.Load() {
+		http.Error(w, "a result was already accepted", http.StatusConflict)
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, req.Body, result.MaxResultSize))
+	if err != nil {
+		status := http.StatusBadRequest
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		http.Error(w, fmt.Sprintf("failed to read result: %v", err), status)
+		return
+	}
+
+	r.phases.mark(&r.phases.resultFound)
+	adapterResult, err := r.parser.Parse(data)
+	if err == nil && r.isIntermediate(adapterResult) {
+		log.Printf("Intermediate result received: status=%s, reason=%s, message=%s; waiting for a terminal result",
+			adapterResult.Status, adapterResult.Reason, adapterResult.Message)
+		if r.progressUpdates {
+			r.reportProgress(ctx, adapterResult)
+		}
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+	if !delivered.CompareAndSwap(false, true) {
+		http.Error(w, "a result was already accepted", http.StatusConflict)
+		return
+	}
+
+	if err != nil {
+		log.Printf("Received result could not be parsed: %v", err)
+		select {
+		case channels.error <- err:
+		case <-channels.done:
+		}
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	log.Printf("Result received successfully: status=%s, reason=%s", adapterResult.Status, adapterResult.Reason)
+	select {
+	case channels.result <- adapterResult:
+	case <-channels.done:
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// authorizedResult checks the bearer token of a result posted over TCP when a token file is set,
+// writing the error response when the request is refused. The unix socket is protected by the
+// permissions of the volume it lives in.
+func (r *StatusReporter) authorizedResult(w http.ResponseWriter, req *http.Request) bool {
+	if r.resultHTTPTokenFile == "" {
+		return true
+	}
+	if addr, ok := req.Context().Value(http.LocalAddrContextKey).(net.Addr); ok && addr.Network() == "unix" {
+		return true
+	}
+
+	token, err := os.ReadFile(r.resultHTTPTokenFile)
+	if err != nil {
+		log.Printf("Warning: failed to read result token file path=%s: %v", r.resultHTTPTokenFile, err)
+		http.Error(w, "result token unavailable", http.StatusInternalServerError)
+		return false
+	}
+	expected := "Bearer " + strings.TrimSpace(string(token))
+	if subtle.ConstantTimeCompare([]byte(req.Header.Get("Authorization")), []byte(expected)) != 1 {
+		http.Error(w, "missing or invalid bearer token", http.StatusUnauthorized)
+		return false
+	}
+	return true
+}
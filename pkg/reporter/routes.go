@@ -0,0 +1,23 @@
+package reporter
+
+import (
+	"path"
+)
+
+// ConditionRoute sends results whose reason matches Pattern to a different condition type.
+// Pattern uses path.Match syntax, so "DNS*" routes every reason starting with "DNS".
+type ConditionRoute struct {
+	Pattern       string
+	ConditionType string
+}
+
+// conditionTypeFor returns the condition type of the first route matching the reason, or the
+// configured condition type when none matches
+func (r *StatusReporter) conditionTypeFor(reason string) string {
+	for _, route := range r.conditionRoutes {
+		if matched, err := path.Match(route.Pattern, reason); err == nil && matched {
+			return route.ConditionType
+		}
+	}
+	return r.conditionType
+}
@@ -0,0 +1,183 @@
+package reporter
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/retry"
+)
+
+const (
+	// Combined failure policies of the outcome sinks
+	SinkFailurePolicyFatal = "fatal" // only failures of sinks marked fatal fail the run
+	SinkFailurePolicyAny   = "any"   // any sink failure fails the run
+	SinkFailurePolicyAll   = "all"   // the run fails only when every sink failed
+
+	// DefaultSinkMaxRetries is how often the sinks without a retrying client of their own (outcome
+	// socket, aggregator, result annotation and ConfigMap, Argo) retry a failed delivery
+	DefaultSinkMaxRetries = 3
+)
+
+// sinkPublishTimeout bounds the delivery to all sinks, retries included. Sinks publish on a context
+// detached from the run's, so a run cancelled by SIGTERM still delivers its outcome.
+const sinkPublishTimeout = 2 * time.Minute
+
+// Sink delivers the run outcome to one target. The Job condition update is the primary sink: it
+// runs first and its failure is the report error. The other sinks then run concurrently, each
+// retrying with its own backoff, so a slow sink does not delay the others.
+type Sink interface {
+	Name() string
+	Publish(ctx context.Context, outcome Outcome) error
+}
+
+// SinkPolicy controls the retries of a sink and whether its failure fails the run
+type SinkPolicy struct {
+	// Fatal fails the run when the sink's delivery fails (under SinkFailurePolicyFatal)
+	Fatal bool
+
+	// Retry bounds the retries of failed deliveries; the zero value publishes once
+	Retry retry.Policy
+}
+
+// WithSink adds a sink that receives the run outcome after the Job status is updated
+func WithSink(sink Sink, policy SinkPolicy) Option {
+	return func(r *StatusReporter) {
+		r.publishers = append(r.publishers, outcomePublisher{
+			name:    sink.Name(),
+			fatal:   policy.Fatal,
+			retry:   &policy.Retry,
+			publish: sink.Publish,
+		})
+	}
+}
+
+// WithSinkRetry sets the retry policy of the built-in sinks without a retrying client of their own
+// (by default DefaultSinkMaxRetries retries starting at retry.DefaultInterval)
+func WithSinkRetry(policy retry.Policy) Option {
+	return func(r *StatusReporter) {
+		r.sinkRetry = policy
+	}
+}
+
+// WithSinkFailurePolicy sets how sink failures combine into the run result: SinkFailurePolicyFatal
+// (the default), SinkFailurePolicyAny or SinkFailurePolicyAll
+func WithSinkFailurePolicy(policy string) Option {
+	return func(r *StatusReporter) {
+		r.sinkFailurePolicy = policy
+	}
+}
+
+// conditionSink returns the primary sink: the Job, unless replaced by an option
+func (r *StatusReporter) conditionSink() Sink {
+	if r.primarySink != nil {
+		return r.primarySink
+	}
+	return jobConditionSink{client: r.k8sClient}
+}
+
+// jobConditionSink writes the outcome conditions to the Job
+type jobConditionSink struct {
+	client K8sClientInterface
+}
+
+func (s jobConditionSink) Name() string {
+	return "job status"
+}
+
+func (s jobConditionSink) Publish(ctx context.Context, outcome Outcome) error {
+	if len(outcome.Conditions) == 1 {
+		return s.client.UpdateJobStatus(ctx, outcome.Conditions[0])
+	}
+	return s.client.UpdateJobConditions(ctx, outcome.Conditions)
+}
+
+// outcomePublisher is a sink built from a publish function
+type outcomePublisher struct {
+	name  string
+	fatal bool
+	// retry bounds the retries of failed deliveries; nil publishes once
+	retry   *retry.Policy
+	publish func(ctx context.Context, outcome Outcome) error
+}
+
+func (p outcomePublisher) Name() string {
+	return p.name
+}
+
+func (p outcomePublisher) Publish(ctx context.Context, outcome Outcome) error {
+	return p.publish(ctx, outcome)
+}
+
+// deliver publishes the outcome, retrying failed attempts under the sink's retry policy
+func (p outcomePublisher) deliver(ctx context.Context, outcome Outcome) error {
+	var policy retry.Policy
+	if p.retry != nil {
+		policy = *p.retry
+	}
+	return retry.Do(ctx, p.name, policy, func(ctx context.Context) error {
+		return p.Publish(ctx, outcome)
+	})
+}
+
+// publishOutcome delivers the run outcome to the configured sinks concurrently. It returns
+// reportErr, joined with the sink errors that fail the run under the sink failure policy.
+func (r *StatusReporter) publishOutcome(ctx context.Context, reportErr error) error {
+	if len(r.publishers) == 0 || r.reportedCondition == nil {
+		return reportErr
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), sinkPublishTimeout)
+	defer cancel()
+
+	outcome := r.outcome(reportErr)
+	sinkErrs := make([]error, len(r.publishers))
+	var wg sync.WaitGroup
+	for i, p := range r.publishers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sinkErrs[i] = p.deliver(ctx, outcome)
+		}()
+	}
+	wg.Wait()
+
+	failed := 0
+	for _, err := range sinkErrs {
+		if err != nil {
+			failed++
+		}
+	}
+
+	errs := []error{reportErr}
+	for i, p := range r.publishers {
+		err := sinkErrs[i]
+		if err == nil {
+			log.Printf("Outcome delivered via %s: reason=%s", p.name, outcome.Reason)
+			continue
+		}
+		if !r.sinkFailureFailsRun(p, failed) {
+			log.Printf("Warning: %v (best-effort, ignoring)", err)
+			continue
+		}
+		log.Printf("Error: %v", err)
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// sinkFailureFailsRun reports whether the failure of the sink fails the run, given how many sinks
+// failed in total
+func (r *StatusReporter) sinkFailureFailsRun(p outcomePublisher, failed int) bool {
+	switch r.sinkFailurePolicy {
+	case SinkFailurePolicyAny:
+		return true
+	case SinkFailurePolicyAll:
+		return failed == len(r.publishers)
+	default:
+		return p.fatal
+	}
+}
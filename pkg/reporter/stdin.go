@@ -0,0 +1,70 @@
+package reporter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"time"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+)
+
+// RunFromReader reads a single adapter result from rd (e.g. stdin), updates the Job condition from
+// it and returns. Unlike Run, nothing is polled and the adapter container is not monitored, so the
+// reporter can be used from scripts and wrapper entrypoints. A result that cannot be read or
+// parsed is reported like an invalid result file.
+func (r *StatusReporter) RunFromReader(ctx context.Context, rd io.Reader) error {
+	r.startTime = time.Now()
+	r.phases.reset(r.startTime)
+	r.finalReported = false
+	r.terminalWritten.Store(false)
+	r.reportedCorrelation = r.correlation
+	r.startProgressing(ctx)
+	stopHeartbeat := r.startHeartbeat(ctx)
+	defer stopHeartbeat()
+
+	var reportErr error
+	adapterResult, err := r.readResult(rd)
+	if err != nil {
+		reportErr = r.UpdateFromError(ctx, err)
+	} else {
+		log.Printf("Result parsed successfully: status=%s, reason=%s", adapterResult.Status, adapterResult.Reason)
+		reportErr = r.UpdateFromResult(ctx, adapterResult)
+	}
+
+	if r.timingOutputPath != "" {
+		if err := r.writeTiming(r.timingOutputPath); err != nil {
+			log.Printf("Warning: failed to write timing data: %v", err)
+		}
+	}
+
+	return r.publishOutcome(ctx, reportErr)
+}
+
+// readResult reads and parses one result from rd. An intermediate result is an error since no
+// later result will follow.
+func (r *StatusReporter) readResult(rd io.Reader) (*result.AdapterResult, error) {
+	data, err := io.ReadAll(io.LimitReader(rd, result.MaxResultSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read result: %w", err)
+	}
+	if len(data) > result.MaxResultSize {
+		return nil, fmt.Errorf("result too large: max=%d", result.MaxResultSize)
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, errors.New("result is empty")
+	}
+	r.phases.mark(&r.phases.resultFound)
+
+	adapterResult, err := r.parser.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	if r.isIntermediate(adapterResult) {
+		return nil, fmt.Errorf("%w: reason=%s", errNonTerminalResult, adapterResult.Reason)
+	}
+	return adapterResult, nil
+}
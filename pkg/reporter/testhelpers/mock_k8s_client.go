@@ -2,17 +2,43 @@ package testhelpers
 
 import (
 	"context"
+	"sync"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
 )
 
 // MockK8sClient is a mock implementation of k8s client operations for testing
 type MockK8sClient struct {
-	UpdateJobStatusFunc           func(ctx context.Context, condition k8s.JobCondition) error
-	GetAdapterContainerStatusFunc func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error)
-	LastUpdatedCondition          k8s.JobCondition
+	UpdateJobStatusFunc              func(ctx context.Context, condition k8s.JobCondition) error
+	GetAdapterContainerStatusFunc    func(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error)
+	GetFailedInitContainerStatusFunc func(ctx context.Context, podName string) (*corev1.ContainerStatus, error)
+	GetJobActiveDeadlineSecondsFunc  func(ctx context.Context) (*int64, error)
+	GetPodFunc                       func(ctx context.Context, podName string) (*corev1.Pod, error)
+	GetContainerMemoryLimitFunc      func(ctx context.Context, podName, containerName string) (*resource.Quantity, error)
+	FindPodByPrefixFunc              func(ctx context.Context, prefix string) (string, error)
+	AnnotateJobFunc                  func(ctx context.Context, annotations map[string]string) error
+	AnnotateConditionTargetFunc      func(ctx context.Context, annotations map[string]string) error
+	ClaimJobAnnotationFunc           func(ctx context.Context, key, value string) (bool, error)
+	RemoveJobAnnotationFunc          func(ctx context.Context, key string) error
+	UpdatePodConditionFunc           func(ctx context.Context, podName string, condition k8s.JobCondition) error
+	UpdateJobConditionsFunc          func(ctx context.Context, conditions []k8s.JobCondition) error
+	ApplyJobConfigMapFunc            func(ctx context.Context, name string, data map[string]string) error
+	GetJobUIDFunc                    func(ctx context.Context) (string, error)
+	GetContainerLogTailFunc          func(ctx context.Context, podName, containerName string, lines int64) (string, error)
+	LastUpdatedCondition             k8s.JobCondition
+	LastUpdatedConditions            []k8s.JobCondition
+	LastPodCondition                 k8s.JobCondition
+	Events                           []MockEvent
+	Annotations                      map[string]string
+	TargetAnnotations                map[string]string
+	ConfigMaps                       map[string]map[string]string
+
+	// mu guards Annotations, TargetAnnotations and ConfigMaps, which outcome sinks write concurrently
+	mu sync.Mutex
 }
 
 func NewMockK8sClient() *MockK8sClient {
@@ -27,9 +53,155 @@ func (m *MockK8sClient) UpdateJobStatus(ctx context.Context, condition k8s.JobCo
 	return nil
 }
 
+func (m *MockK8sClient) UpdateJobConditions(ctx context.Context, conditions []k8s.JobCondition) error {
+	m.LastUpdatedCondition = conditions[0]
+	m.LastUpdatedConditions = conditions
+	if m.UpdateJobConditionsFunc != nil {
+		return m.UpdateJobConditionsFunc(ctx, conditions)
+	}
+	return nil
+}
+
 func (m *MockK8sClient) GetAdapterContainerStatus(ctx context.Context, podName, containerName string) (*corev1.ContainerStatus, error) {
 	if m.GetAdapterContainerStatusFunc != nil {
 		return m.GetAdapterContainerStatusFunc(ctx, podName, containerName)
 	}
 	return nil, nil
 }
+
+func (m *MockK8sClient) GetFailedInitContainerStatus(ctx context.Context, podName string) (*corev1.ContainerStatus, error) {
+	if m.GetFailedInitContainerStatusFunc != nil {
+		return m.GetFailedInitContainerStatusFunc(ctx, podName)
+	}
+	return nil, nil
+}
+
+func (m *MockK8sClient) GetJobActiveDeadlineSeconds(ctx context.Context) (*int64, error) {
+	if m.GetJobActiveDeadlineSecondsFunc != nil {
+		return m.GetJobActiveDeadlineSecondsFunc(ctx)
+	}
+	return nil, nil
+}
+
+func (m *MockK8sClient) GetPod(ctx context.Context, podName string) (*corev1.Pod, error) {
+	if m.GetPodFunc != nil {
+		return m.GetPodFunc(ctx, podName)
+	}
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: podName}}, nil
+}
+
+func (m *MockK8sClient) GetContainerMemoryLimit(ctx context.Context, podName, containerName string) (*resource.Quantity, error) {
+	if m.GetContainerMemoryLimitFunc != nil {
+		return m.GetContainerMemoryLimitFunc(ctx, podName, containerName)
+	}
+	return nil, nil
+}
+
+func (m *MockK8sClient) FindPodByPrefix(ctx context.Context, prefix string) (string, error) {
+	if m.FindPodByPrefixFunc != nil {
+		return m.FindPodByPrefixFunc(ctx, prefix)
+	}
+	return prefix, nil
+}
+
+func (m *MockK8sClient) AnnotateJob(ctx context.Context, annotations map[string]string) error {
+	if m.AnnotateJobFunc != nil {
+		return m.AnnotateJobFunc(ctx, annotations)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.Annotations == nil {
+		m.Annotations = map[string]string{}
+	}
+	for k, v := range annotations {
+		m.Annotations[k] = v
+	}
+	return nil
+}
+
+func (m *MockK8sClient) AnnotateConditionTarget(ctx context.Context, annotations map[string]string) error {
+	if m.AnnotateConditionTargetFunc != nil {
+		return m.AnnotateConditionTargetFunc(ctx, annotations)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.TargetAnnotations == nil {
+		m.TargetAnnotations = map[string]string{}
+	}
+	for k, v := range annotations {
+		m.TargetAnnotations[k] = v
+	}
+	return nil
+}
+
+func (m *MockK8sClient) ClaimJobAnnotation(ctx context.Context, key, value string) (bool, error) {
+	if m.ClaimJobAnnotationFunc != nil {
+		return m.ClaimJobAnnotationFunc(ctx, key, value)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.Annotations[key]; ok {
+		return false, nil
+	}
+	if m.Annotations == nil {
+		m.Annotations = map[string]string{}
+	}
+	m.Annotations[key] = value
+	return true, nil
+}
+
+func (m *MockK8sClient) RemoveJobAnnotation(ctx context.Context, key string) error {
+	if m.RemoveJobAnnotationFunc != nil {
+		return m.RemoveJobAnnotationFunc(ctx, key)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.Annotations, key)
+	return nil
+}
+
+func (m *MockK8sClient) UpdatePodCondition(ctx context.Context, podName string, condition k8s.JobCondition) error {
+	m.LastPodCondition = condition
+	if m.UpdatePodConditionFunc != nil {
+		return m.UpdatePodConditionFunc(ctx, podName, condition)
+	}
+	return nil
+}
+
+// MockEvent is an Event recorded through the mock client
+type MockEvent struct {
+	Type    string
+	Reason  string
+	Message string
+}
+
+func (m *MockK8sClient) RecordEvent(ctx context.Context, eventType, reason, message string) {
+	m.Events = append(m.Events, MockEvent{Type: eventType, Reason: reason, Message: message})
+}
+
+func (m *MockK8sClient) ApplyJobConfigMap(ctx context.Context, name string, data map[string]string) error {
+	if m.ApplyJobConfigMapFunc != nil {
+		return m.ApplyJobConfigMapFunc(ctx, name, data)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.ConfigMaps == nil {
+		m.ConfigMaps = map[string]map[string]string{}
+	}
+	m.ConfigMaps[name] = data
+	return nil
+}
+
+func (m *MockK8sClient) GetJobUID(ctx context.Context) (string, error) {
+	if m.GetJobUIDFunc != nil {
+		return m.GetJobUIDFunc(ctx)
+	}
+	return "", nil
+}
+
+func (m *MockK8sClient) GetContainerLogTail(ctx context.Context, podName, containerName string, lines int64) (string, error) {
+	if m.GetContainerLogTailFunc != nil {
+		return m.GetContainerLogTailFunc(ctx, podName, containerName, lines)
+	}
+	return "", nil
+}
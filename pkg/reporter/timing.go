@@ -0,0 +1,99 @@
+package reporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// phaseTimes records when each phase of a run was first reached. Phases are marked from the
+// poller, monitor and main goroutines, so access is guarded by a mutex.
+type phaseTimes struct {
+	mu               sync.Mutex
+	start            time.Time
+	containerRunning time.Time
+	resultFound      time.Time
+	reported         time.Time
+}
+
+// Timing is the per-run timing data written to the timing output file. Phases that were never
+// reached are omitted; durations are measured from the start of the run.
+type Timing struct {
+	Start              time.Time  `json:"start"`
+	ContainerRunning   *time.Time `json:"containerRunning,omitempty"`
+	ResultFound        *time.Time `json:"resultFound,omitempty"`
+	Reported           *time.Time `json:"reported,omitempty"`
+	ContainerRunningMs *int64     `json:"containerRunningMs,omitempty"`
+	ResultFoundMs      *int64     `json:"resultFoundMs,omitempty"`
+	ReportedMs         *int64     `json:"reportedMs,omitempty"`
+	TotalMs            int64      `json:"totalMs"`
+}
+
+// reset starts a new run
+func (p *phaseTimes) reset(start time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.start = start
+	p.containerRunning = time.Time{}
+	p.resultFound = time.Time{}
+	p.reported = time.Time{}
+}
+
+// mark records the current time for a phase unless it was already reached
+func (p *phaseTimes) mark(phase *time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if phase.IsZero() {
+		*phase = time.Now()
+	}
+}
+
+// snapshot returns the timing data for the run so far
+func (p *phaseTimes) snapshot() Timing {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	t := Timing{Start: p.start.UTC(), TotalMs: time.Since(p.start).Milliseconds()}
+	t.ContainerRunning, t.ContainerRunningMs = p.phase(p.containerRunning)
+	t.ResultFound, t.ResultFoundMs = p.phase(p.resultFound)
+	t.Reported, t.ReportedMs = p.phase(p.reported)
+	return t
+}
+
+func (p *phaseTimes) phase(at time.Time) (*time.Time, *int64) {
+	if at.IsZero() {
+		return nil, nil
+	}
+	utc := at.UTC()
+	ms := at.Sub(p.start).Milliseconds()
+	return &utc, &ms
+}
+
+// writeTiming writes the run's timing data as JSON, replacing the file atomically
+func (r *StatusReporter) writeTiming(path string) error {
+	data, err := json.MarshalIndent(r.phases.snapshot(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal timing data: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".timing-*")
+	if err != nil {
+		return fmt.Errorf("failed to create timing file in %s: %w", filepath.Dir(path), err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write timing file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write timing file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write timing file path=%s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package reporter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/wrapper"
+)
+
+// ReasonAdapterStartFailed is reported when the wrapped adapter command could not be started
+const ReasonAdapterStartFailed = "AdapterStartFailed"
+
+// RunWrapped runs command as the adapter, passing its output through, and reports its result once
+// it exits: the result it printed to stdout, else the result file, else its exit code, as for an
+// adapter container. Signals received meanwhile are forwarded to it. The report is made even when
+// ctx is cancelled, since the adapter's exit is what ends the run. An adapter still running after
+// the maximum wait time is killed and reported as AdapterTimeout.
+func (r *StatusReporter) RunWrapped(ctx context.Context, command []string) error {
+	r.startTime = time.Now()
+	r.phases.reset(r.startTime)
+	r.finalReported = false
+	r.terminalWritten.Store(false)
+	r.reportedCorrelation = r.correlation
+	reportCtx := context.WithoutCancel(ctx)
+	r.startProgressing(reportCtx)
+	stopHeartbeat := r.startHeartbeat(reportCtx)
+	defer stopHeartbeat()
+
+	log.Printf("Running adapter command: %s", strings.Join(command, " "))
+	timeoutCtx, cancel := context.WithTimeout(ctx, r.maxWaitTime)
+	defer cancel()
+	exit, err := wrapper.Run(timeoutCtx, command, os.Stdout, os.Stderr, result.MaxResultSize)
+
+	var reportErr error
+	switch {
+	case err != nil:
+		reportErr = r.updateFromStartFailure(reportCtx, err)
+	case errors.Is(timeoutCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil:
+		log.Printf("Timeout waiting for adapter command (max wait: %s); killed it: %s", r.maxWaitTime, exit.Reason)
+		reportErr = r.updateFromTimeoutCondition(reportCtx)
+	default:
+		reportErr = r.reportExit(reportCtx, exit)
+	}
+
+	if r.timingOutputPath != "" {
+		if err := r.writeTiming(r.timingOutputPath); err != nil {
+			log.Printf("Warning: failed to write timing data: %v", err)
+		}
+	}
+
+	return r.publishOutcome(reportCtx, reportErr)
+}
+
+// reportExit reports the exited adapter from the result it printed or, like a terminated adapter
+// container, from its result file or exit code
+func (r *StatusReporter) reportExit(ctx context.Context, exit *wrapper.Exit) error {
+	log.Printf("Adapter command exited: %s", exit.Reason)
+	if adapterResult := r.outputResult(exit.Output); adapterResult != nil {
+		log.Printf("Using result from adapter output: status=%s, reason=%s", adapterResult.Status, adapterResult.Reason)
+		return r.UpdateFromResult(ctx, adapterResult)
+	}
+
+	reason := "Completed"
+	if exit.Code != 0 {
+		reason = exit.Reason
+	}
+	return r.HandleTermination(ctx, &corev1.ContainerStateTerminated{ExitCode: int32(exit.Code), Reason: reason})
+}
+
+// outputResult parses the adapter's stdout as a result: all of it, or else its last line so the
+// result may follow log output. It returns nil when neither holds a terminal result.
+func (r *StatusReporter) outputResult(output []byte) *result.AdapterResult {
+	output = bytes.TrimSpace(output)
+	if len(output) == 0 {
+		return nil
+	}
+
+	adapterResult, err := r.parser.Parse(output)
+	if err != nil {
+		lastLine := output[bytes.LastIndexByte(output, '\n')+1:]
+		adapterResult, err = r.parser.Parse(lastLine)
+	}
+	if err != nil {
+		log.Printf("Adapter output holds no result (%v)", err)
+		return nil
+	}
+	if r.isIntermediate(adapterResult) {
+		log.Printf("Adapter output holds an intermediate result (reason=%s)", adapterResult.Reason)
+		return nil
+	}
+	r.phases.mark(&r.phases.resultFound)
+	return adapterResult
+}
+
+// updateFromStartFailure reports an adapter command that could not be started
+func (r *StatusReporter) updateFromStartFailure(ctx context.Context, startErr error) error {
+	log.Printf("Failed to run adapter: %v", startErr)
+
+	condition := k8s.JobCondition{
+		Type:    r.conditionTypeFor(ReasonAdapterStartFailed),
+		Status:  ConditionStatusFalse,
+		Reason:  ReasonAdapterStartFailed,
+		Message: fmt.Sprintf("Adapter could not be started: %v", startErr),
+	}
+	if err := r.updateJobStatus(ctx, condition); err != nil {
+		return fmt.Errorf("failed to update job status: %w", err)
+	}
+
+	log.Printf("Job status updated: %s=False (reason: %s)", condition.Type, ReasonAdapterStartFailed)
+	return startErr
+}
@@ -0,0 +1,70 @@
+//go:build unix
+
+package reporter_test
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/reporter"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/reporter/testhelpers"
+)
+
+var _ = Describe("StatusReporter wrapping the adapter", func() {
+	var (
+		mock        *testhelpers.MockK8sClient
+		ctx         context.Context
+		resultsPath string
+		r           *reporter.StatusReporter
+	)
+
+	BeforeEach(func() {
+		mock = testhelpers.NewMockK8sClient()
+		ctx = context.Background()
+		resultsPath = filepath.Join(GinkgoT().TempDir(), "adapter-result.json")
+		r = reporter.NewReporterWithClient(resultsPath, time.Second, time.Minute, "Available", "", "adapter", mock)
+	})
+
+	It("reports the result printed after the adapter's log output", func() {
+		err := r.RunWrapped(ctx, []string{"sh", "-c", `echo checking quota; echo '{"status":"failure","reason":"QuotaExceeded","message":"no CPU"}'; exit 1`})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+		Expect(mock.LastUpdatedCondition.Reason).To(Equal("QuotaExceeded"))
+	})
+
+	It("falls back to the result file", func() {
+		err := r.RunWrapped(ctx, []string{"sh", "-c", `echo '{"status":"success","reason":"AllChecksPassed","message":"ok"}' > "$0"`, resultsPath})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusTrue))
+		Expect(mock.LastUpdatedCondition.Reason).To(Equal("AllChecksPassed"))
+	})
+
+	It("falls back to the exit code", func() {
+		err := r.RunWrapped(ctx, []string{"sh", "-c", "echo not a result; exit 2"})
+		Expect(err).To(HaveOccurred())
+		Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
+		Expect(mock.LastUpdatedCondition.Message).To(Equal("Adapter container exited with code 2: exit status 2"))
+	})
+
+	It("kills the adapter and reports a timeout after the maximum wait time", func() {
+		r = reporter.NewReporterWithClient(resultsPath, time.Second, 200*time.Millisecond, "Available", "", "adapter", mock)
+
+		start := time.Now()
+		err := r.RunWrapped(ctx, []string{"sleep", "30"})
+		Expect(err).To(MatchError(ContainSubstring("timeout")))
+		Expect(time.Since(start)).To(BeNumerically("<", 10*time.Second))
+		Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+		Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterTimeout))
+	})
+
+	It("reports an adapter that could not be started", func() {
+		err := r.RunWrapped(ctx, []string{"/nonexistent/adapter"})
+		Expect(err).To(HaveOccurred())
+		Expect(mock.LastUpdatedCondition.Status).To(Equal(reporter.ConditionStatusFalse))
+		Expect(mock.LastUpdatedCondition.Reason).To(Equal(reporter.ReasonAdapterStartFailed))
+	})
+})
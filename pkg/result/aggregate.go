@@ -0,0 +1,123 @@
+package result
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// ReasonAllResultsSucceeded is the reason of an aggregate of successful results
+	ReasonAllResultsSucceeded = "AllResultsSucceeded"
+
+	// ReasonMultipleFailures is the reason of an aggregate with more than one failed result
+	ReasonMultipleFailures = "MultipleFailures"
+
+	// ReasonResultsUndetermined is the reason of an aggregate whose unsuccessful results are all
+	// unknown, more than one of them
+	ReasonResultsUndetermined = "ResultsUndetermined"
+)
+
+// Aggregate combines the results of several files into one: a success only if all succeeded,
+// otherwise a failure whose message lists each failed file. A single failure keeps its own
+// reason, and the aggregate's severity is the highest of the failures. The details of each file
+// are kept under its file name, and the conditions of all files are merged, the first file
+// reporting a condition type winning. The first file that could not be parsed is returned as the
+// error instead, with its path.
+func Aggregate(files []FileResult) (*AdapterResult, error) {
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no results to aggregate")
+	}
+	for _, f := range files {
+		if f.Err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Base(f.Path), f.Err)
+		}
+	}
+	if len(files) == 1 {
+		return files[0].Result, nil
+	}
+
+	var failed []FileResult
+	for _, f := range files {
+		if !f.Result.IsSuccess() {
+			failed = append(failed, f)
+		}
+	}
+	if len(failed) == 0 {
+		aggregate := &AdapterResult{
+			Status:  StatusSuccess,
+			Reason:  ReasonAllResultsSucceeded,
+			Message: fmt.Sprintf("All %d results succeeded", len(files)),
+		}
+		if err := mergeDetailsAndConditions(aggregate, files); err != nil {
+			return nil, err
+		}
+		return aggregate, nil
+	}
+
+	// Only results that could not be determined leave the aggregate undetermined rather than failed
+	aggregate := &AdapterResult{Status: StatusUnknown, Reason: ReasonMultipleFailures}
+	for _, f := range failed {
+		if !f.Result.IsUnknown() {
+			aggregate.Status = StatusFailure
+		}
+	}
+	switch {
+	case len(failed) == 1:
+		aggregate.Reason = failed[0].Result.Reason
+	case aggregate.IsUnknown():
+		aggregate.Reason = ReasonResultsUndetermined
+	}
+	parts := make([]string, 0, len(failed))
+	highest := -1
+	for _, f := range failed {
+		parts = append(parts, fmt.Sprintf("%s: %s: %s", filepath.Base(f.Path), f.Result.Reason, f.Result.Message))
+		if rank, ok := SeverityRank(f.Result.EffectiveSeverity()); ok && rank > highest {
+			highest = rank
+			aggregate.Severity = f.Result.EffectiveSeverity()
+		}
+	}
+	outcome := "failed"
+	if aggregate.IsUnknown() {
+		outcome = "undetermined"
+	}
+	aggregate.Message = fmt.Sprintf("%d of %d results %s: %s", len(failed), len(files), outcome, strings.Join(parts, "; "))
+	if err := mergeDetailsAndConditions(aggregate, files); err != nil {
+		return nil, err
+	}
+
+	// Applies the message length limit
+	if err := aggregate.Validate(); err != nil {
+		return nil, err
+	}
+	return aggregate, nil
+}
+
+// mergeDetailsAndConditions sets the details of the aggregate to an object holding the details of
+// each file under its file name, and its conditions to those of all files
+func mergeDetailsAndConditions(aggregate *AdapterResult, files []FileResult) error {
+	details := make(map[string]json.RawMessage)
+	seen := make(map[string]bool)
+	for _, f := range files {
+		if len(f.Result.Details) > 0 {
+			details[filepath.Base(f.Path)] = f.Result.Details
+		}
+		for _, c := range f.Result.Conditions {
+			if !seen[c.Type] {
+				seen[c.Type] = true
+				aggregate.Conditions = append(aggregate.Conditions, c)
+			}
+		}
+	}
+	if len(details) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("failed to merge result details: %w", err)
+	}
+	aggregate.Details = data
+	return nil
+}
@@ -0,0 +1,52 @@
+package result
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// WithAllowedBase rejects result files whose path, after resolving symlinks, lies outside the
+// base directory, so a symlink in the results volume cannot point the reporter at other files
+func WithAllowedBase(base string) ParserOption {
+	return func(p *Parser) {
+		p.allowedBase = base
+	}
+}
+
+// IsWithinBase reports whether path lies under base once symlinks in both are resolved. Trailing
+// components that do not exist yet, such as a result file the adapter has not written or a glob
+// pattern, are compared as written.
+func IsWithinBase(base, path string) bool {
+	rel, err := filepath.Rel(ResolveSymlinks(base), ResolveSymlinks(path))
+	return err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// ResolveSymlinks resolves the symlinks of the longest existing prefix of the cleaned path and
+// appends the remaining components unchanged
+func ResolveSymlinks(path string) string {
+	path = filepath.Clean(path)
+	var rest []string
+	for current := path; ; current = filepath.Dir(current) {
+		if resolved, err := filepath.EvalSymlinks(current); err == nil {
+			return filepath.Join(append([]string{resolved}, rest...)...)
+		}
+		if parent := filepath.Dir(current); parent == current {
+			return path
+		}
+		rest = append([]string{filepath.Base(current)}, rest...)
+	}
+}
+
+// checkAllowedBase resolves the symlinks of an existing result file and ensures it lies under
+// the allowed base
+func (p *Parser) checkAllowedBase(path string) (string, error) {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read result file path=%s: %w", path, err)
+	}
+	if !IsWithinBase(p.allowedBase, resolved) {
+		return "", fmt.Errorf("result file path=%s resolves to %s outside the allowed results base %s", path, resolved, p.allowedBase)
+	}
+	return resolved, nil
+}
@@ -0,0 +1,53 @@
+package result
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+)
+
+// DefaultChecksumSuffix is appended to the result file path to locate its companion checksum file
+const DefaultChecksumSuffix = ".sha256"
+
+// WithChecksumVerification verifies the result file against the SHA-256 digest in the companion
+// file at path+suffix before parsing. The companion holds the hex digest, optionally followed by
+// the file name as written by sha256sum. A missing companion fails the parse when strict is true
+// and is logged and ignored otherwise.
+func WithChecksumVerification(suffix string, strict bool) ParserOption {
+	return func(p *Parser) {
+		p.checksumSuffix = suffix
+		p.checksumStrict = strict
+	}
+}
+
+// verifyChecksum compares the digest of data with the companion checksum file of path
+func (p *Parser) verifyChecksum(path string, data []byte) error {
+	checksumPath := path + p.checksumSuffix
+	content, err := os.ReadFile(checksumPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && !p.checksumStrict {
+			log.Printf("Warning: checksum file %s not found; parsing the result file unverified", checksumPath)
+			return nil
+		}
+		return &ResultError{Field: "checksum", Message: fmt.Sprintf("failed to read checksum file %s: %v", checksumPath, err)}
+	}
+
+	fields := strings.Fields(string(content))
+	if len(fields) == 0 {
+		return &ResultError{Field: "checksum", Message: fmt.Sprintf("checksum file %s is empty", checksumPath)}
+	}
+
+	sum := sha256.Sum256(data)
+	actual := hex.EncodeToString(sum[:])
+	if !strings.EqualFold(fields[0], actual) {
+		return &ResultError{
+			Field:   "checksum",
+			Message: fmt.Sprintf("checksum mismatch: expected %s, got %s (result file may be truncated or corrupted)", fields[0], actual),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package result
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Result formats
+const (
+	// FormatAuto picks the format from the file extension, or from the content when the
+	// extension is not a registered format
+	FormatAuto = "auto"
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+// Decoder converts result data in one format into the JSON form of an AdapterResult
+type Decoder func(data []byte) ([]byte, error)
+
+var (
+	formatsMu sync.RWMutex
+	formats   = map[string]Decoder{
+		FormatJSON: decodeJSON,
+		FormatYAML: yaml.YAMLToJSON,
+	}
+)
+
+// RegisterFormat makes a result format available under name (lowercase, e.g. "toml"). In auto
+// mode it is used for files with a matching extension. Registering an existing name replaces it.
+func RegisterFormat(name string, decoder Decoder) {
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+	formats[strings.ToLower(name)] = decoder
+}
+
+// UnregisterFormat removes a format added with RegisterFormat; the built-in JSON and YAML formats
+// are kept
+func UnregisterFormat(name string) {
+	name = strings.ToLower(name)
+	if name == FormatJSON || name == FormatYAML {
+		return
+	}
+	formatsMu.Lock()
+	defer formatsMu.Unlock()
+	delete(formats, name)
+}
+
+// Formats returns the names of the registered formats, sorted
+func Formats() []string {
+	formatsMu.RLock()
+	defer formatsMu.RUnlock()
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// WithFormat selects the result format by registered name, or FormatAuto. JSON is used by default.
+func WithFormat(name string) ParserOption {
+	return func(p *Parser) {
+		p.format = strings.ToLower(name)
+	}
+}
+
+// decoder returns the decoder for the parser's format; path, when known, drives auto-detection
+func (p *Parser) decoder(path string, data []byte) (Decoder, error) {
+	name := p.format
+	if name == "" {
+		name = FormatJSON
+	}
+	if name == FormatAuto {
+		name = detectFormat(path, data)
+	}
+
+	formatsMu.RLock()
+	defer formatsMu.RUnlock()
+	decoder, ok := formats[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown result format %q", name)
+	}
+	return decoder, nil
+}
+
+// detectFormat picks a format from the file extension when it names a registered format, and
+// otherwise treats content starting with '{' as JSON and anything else as YAML
+func detectFormat(path string, data []byte) string {
+	ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(path), "."))
+	if ext == "yml" {
+		ext = FormatYAML
+	}
+	if ext != "" {
+		formatsMu.RLock()
+		_, ok := formats[ext]
+		formatsMu.RUnlock()
+		if ok {
+			return ext
+		}
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return FormatJSON
+	}
+	return FormatYAML
+}
+
+// decodeJSON passes JSON through unchanged; it is validated when unmarshaled
+func decodeJSON(data []byte) ([]byte, error) {
+	return data, nil
+}
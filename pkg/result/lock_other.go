@@ -0,0 +1,12 @@
+//go:build !unix
+
+package result
+
+import (
+	"os"
+)
+
+// lockShared is not supported on this platform
+func lockShared(f *os.File) error {
+	return errLockUnsupported
+}
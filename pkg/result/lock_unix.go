@@ -0,0 +1,26 @@
+//go:build unix
+
+package result
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// lockShared blocks until a shared advisory lock is held on the file; closing the file releases it
+func lockShared(f *os.File) error {
+	for {
+		err := syscall.Flock(int(f.Fd()), syscall.LOCK_SH)
+		switch {
+		case err == nil:
+			return nil
+		case errors.Is(err, syscall.EINTR):
+			continue
+		case errors.Is(err, syscall.ENOTSUP), errors.Is(err, syscall.EOPNOTSUPP), errors.Is(err, syscall.ENOLCK), errors.Is(err, syscall.EINVAL):
+			return errLockUnsupported
+		default:
+			return err
+		}
+	}
+}
@@ -0,0 +1,59 @@
+//go:build unix
+
+package result_test
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/result"
+)
+
+var _ = Describe("Parser with shared lock", func() {
+	var (
+		parser *result.Parser
+		path   string
+	)
+
+	BeforeEach(func() {
+		parser = result.NewParser(result.WithSharedLock(true))
+		path = filepath.Join(GinkgoT().TempDir(), "result.json")
+		Expect(os.WriteFile(path, []byte(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`), 0644)).To(Succeed())
+	})
+
+	It("reads an unlocked result file", func() {
+		r, err := parser.ParseFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.Reason).To(Equal("AllChecksPassed"))
+	})
+
+	It("waits for the writer's exclusive lock to be released", func() {
+		Expect(os.Truncate(path, 0)).To(Succeed())
+		writer, err := os.OpenFile(path, os.O_RDWR, 0)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(writer.Close)
+		Expect(syscall.Flock(int(writer.Fd()), syscall.LOCK_EX)).To(Succeed())
+
+		parsed := make(chan *result.AdapterResult, 1)
+		go func() {
+			r, _ := parser.ParseFile(path)
+			parsed <- r
+		}()
+		Consistently(parsed, 200*time.Millisecond).ShouldNot(Receive())
+
+		Expect(writer.Truncate(0)).To(Succeed())
+		_, err = writer.WriteAt([]byte(`{"status":"failure","reason":"WrittenUnderLock","message":"done"}`), 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(syscall.Flock(int(writer.Fd()), syscall.LOCK_UN)).To(Succeed())
+
+		var r *result.AdapterResult
+		Eventually(parsed).Should(Receive(&r))
+		Expect(r).NotTo(BeNil())
+		Expect(r.Reason).To(Equal("WrittenUnderLock"))
+	})
+})
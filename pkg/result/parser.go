@@ -1,23 +1,84 @@
 package result
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 )
 
 const (
-	// maxResultFileSize limits result file size to prevent memory exhaustion
-	maxResultFileSize = 1 * 1024 * 1024 // 1MB
+	// MaxResultSize limits the size of a result to prevent memory exhaustion
+	MaxResultSize = 1 * 1024 * 1024 // 1MB
 )
 
+// errLockUnsupported is returned by lockShared when the filesystem or platform has no advisory locks
+var errLockUnsupported = errors.New("file locking not supported")
+
+// utf8BOM is the UTF-8 byte order mark some editors and Windows tools prepend to text files
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
 // Parser handles parsing adapter result files
-type Parser struct{}
+type Parser struct {
+	singleLineMessage bool
+	pointers          FieldPointers
+	sharedLock        bool
+	checksumSuffix    string
+	checksumStrict    bool
+	requireContent    bool
+	format            string
+	ndjson            bool
+	allowedBase       string
+}
+
+// ParserOption configures optional Parser behavior
+type ParserOption func(*Parser)
+
+// WithSingleLineMessage collapses newlines and tabs in the result message into single spaces
+func WithSingleLineMessage(enabled bool) ParserOption {
+	return func(p *Parser) {
+		p.singleLineMessage = enabled
+	}
+}
+
+// WithRequiredReasonMessage rejects results without an explicit reason and message instead of
+// filling in DefaultReason and DefaultMessage
+func WithRequiredReasonMessage(enabled bool) ParserOption {
+	return func(p *Parser) {
+		p.requireContent = enabled
+	}
+}
+
+// WithSharedLock takes a shared advisory lock (flock) on the result file while reading it, so an
+// adapter that holds an exclusive lock while writing is never read mid-write. Where locks are not
+// supported the file is read without one.
+func WithSharedLock(enabled bool) ParserOption {
+	return func(p *Parser) {
+		p.sharedLock = enabled
+	}
+}
+
+// WithNDJSON parses the result as newline-delimited JSON records appended by the adapter: records
+// are progress until one is marked "final": true (see AdapterResult.Final). Each record is validated.
+func WithNDJSON(enabled bool) ParserOption {
+	return func(p *Parser) {
+		p.ndjson = enabled
+	}
+}
 
 // NewParser creates a new result parser
-func NewParser() *Parser {
-	return &Parser{}
+func NewParser(opts ...ParserOption) *Parser {
+	p := &Parser{}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
 }
 
 // ParseFile reads and parses a result file from the given path
@@ -27,6 +88,12 @@ func (p *Parser) ParseFile(path string) (*AdapterResult, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to resolve path=%s: %w", path, err)
 	}
+	if p.allowedBase != "" {
+		// Read through the resolved path so a symlink swapped after the check is not followed
+		if cleanedPath, err = p.checkAllowedBase(cleanedPath); err != nil {
+			return nil, err
+		}
+	}
 
 	// Check file size before reading to prevent memory exhaustion
 	fileInfo, err := os.Stat(cleanedPath)
@@ -34,33 +101,227 @@ func (p *Parser) ParseFile(path string) (*AdapterResult, error) {
 		return nil, fmt.Errorf("failed to read result file path=%s: %w", cleanedPath, err)
 	}
 
-	if fileInfo.Size() == 0 {
+	// A locked writer may still be filling a just-created file; its size is checked after reading
+	if fileInfo.Size() == 0 && !p.sharedLock {
 		return nil, fmt.Errorf("result file is empty: path=%s", cleanedPath)
 	}
 
-	if fileInfo.Size() > maxResultFileSize {
-		return nil, fmt.Errorf("result file too large: path=%s size=%d max=%d", cleanedPath, fileInfo.Size(), maxResultFileSize)
+	if fileInfo.Size() > MaxResultSize {
+		return nil, fmt.Errorf("result file too large: path=%s size=%d max=%d", cleanedPath, fileInfo.Size(), MaxResultSize)
 	}
 
-	data, err := os.ReadFile(cleanedPath)
+	var data []byte
+	if p.sharedLock {
+		data, err = readLocked(cleanedPath)
+	} else {
+		data, err = os.ReadFile(cleanedPath)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to read result file path=%s: %w", cleanedPath, err)
 	}
+	if len(data) > MaxResultSize {
+		return nil, fmt.Errorf("result file too large: path=%s size=%d max=%d", cleanedPath, len(data), MaxResultSize)
+	}
+
+	if p.checksumSuffix != "" {
+		if err := p.verifyChecksum(cleanedPath, data); err != nil {
+			return nil, fmt.Errorf("invalid result format: %w", err)
+		}
+	}
+
+	// A file holding only a byte order mark and/or whitespace passes the size check above
+	// but carries no content; report it as empty rather than as a confusing JSON error
+	data = bytes.TrimPrefix(data, utf8BOM)
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, fmt.Errorf("result file is empty: path=%s", cleanedPath)
+	}
+
+	return p.parse(cleanedPath, data)
+}
+
+// readLocked reads the file under a shared advisory lock, falling back to an unlocked read
+// when locks are not supported
+func readLocked(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	if err := lockShared(f); err != nil {
+		if !errors.Is(err, errLockUnsupported) {
+			return nil, fmt.Errorf("failed to lock result file: %w", err)
+		}
+		log.Printf("Warning: file locking is not supported for path=%s; reading without a lock", path)
+	}
+
+	// The file may have grown while waiting for the lock; read at most one byte past the limit
+	return io.ReadAll(io.LimitReader(f, MaxResultSize+1))
+}
+
+// FileResult is the outcome of parsing a single file in ParseFiles
+type FileResult struct {
+	Path   string
+	Result *AdapterResult
+	Err    error
+}
+
+// ParseFiles parses the given files using at most concurrency workers (values below 1 mean one).
+// Results are returned in the same order as paths regardless of completion order, so callers
+// can apply deterministic selection or aggregation policies.
+func (p *Parser) ParseFiles(paths []string, concurrency int) []FileResult {
+	results := make([]FileResult, len(paths))
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if concurrency > len(paths) {
+		concurrency = len(paths)
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				adapterResult, err := p.ParseFile(paths[i])
+				results[i] = FileResult{Path: paths[i], Result: adapterResult, Err: err}
+			}
+		}()
+	}
+
+	for i := range paths {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
 
-	return p.Parse(data)
+	return results
 }
 
-// Parse parses result data from JSON bytes
+// Parse parses result data in the parser's format (JSON by default)
 func (p *Parser) Parse(data []byte) (*AdapterResult, error) {
+	return p.parse("", data)
+}
+
+// parse parses the data as a single result, or as a stream of records in NDJSON mode, and keeps
+// a copy of the data in the result
+func (p *Parser) parse(path string, data []byte) (*AdapterResult, error) {
+	var result *AdapterResult
+	var err error
+	if p.ndjson {
+		result, err = p.parseStream(path, data)
+	} else {
+		result, err = p.parseRecord(path, data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.Raw = bytes.Clone(data)
+	return result, nil
+}
+
+// parseStream parses newline-delimited JSON records and returns the first record marked final,
+// or the last record when none is. A trailing line without a newline that does not parse yet is
+// taken to be still being written and is skipped when an earlier record parsed.
+func (p *Parser) parseStream(path string, data []byte) (*AdapterResult, error) {
+	var last *AdapterResult
+	lines := bytes.Split(data, []byte("\n"))
+	for i, line := range lines {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		record, err := p.parseRecord(path, line)
+		if err != nil {
+			var syntaxErr *SyntaxError
+			if i == len(lines)-1 && last != nil && errors.As(err, &syntaxErr) {
+				break
+			}
+			return nil, fmt.Errorf("record %d: %w", i+1, err)
+		}
+
+		var marker struct {
+			Final bool `json:"final"`
+		}
+		_ = json.Unmarshal(line, &marker)
+		record.Final = marker.Final
+		if record.Final {
+			return record, nil
+		}
+		last = record
+	}
+
+	if last == nil {
+		return nil, &SyntaxError{Err: errors.New("no result records")}
+	}
+	return last, nil
+}
+
+// parseRecord decodes the data into JSON and parses it; path, when known, drives format auto-detection
+func (p *Parser) parseRecord(path string, data []byte) (*AdapterResult, error) {
+	decode, err := p.decoder(path, data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid result format: %w", err)
+	}
+	data, err = decode(data)
+	if err != nil {
+		return nil, &SyntaxError{Err: err}
+	}
+	data, apiVersion, err := convertToV1(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid result format: %w", err)
+	}
+
 	var result AdapterResult
 
-	if err := json.Unmarshal(data, &result); err != nil {
-		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	if !p.pointers.IsZero() {
+		extracted, err := p.pointers.extract(data)
+		if err != nil {
+			var syntaxErr *SyntaxError
+			if errors.As(err, &syntaxErr) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("invalid result format: %w", err)
+		}
+		result = *extracted
+	} else if err := json.Unmarshal(data, &result); err != nil {
+		return nil, decodeError(err)
+	}
+
+	result.APIVersion = apiVersion
+
+	if p.requireContent {
+		if strings.TrimSpace(result.Reason) == "" {
+			return nil, fmt.Errorf("invalid result format: %w", &ResultError{Field: "reason", Message: "is required"})
+		}
+		if strings.TrimSpace(result.Message) == "" {
+			return nil, fmt.Errorf("invalid result format: %w", &ResultError{Field: "message", Message: "is required"})
+		}
 	}
 
 	if err := result.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid result format: %w", err)
 	}
 
+	if p.singleLineMessage {
+		result.Message = collapseWhitespace(result.Message)
+		for i := range result.Conditions {
+			result.Conditions[i].Message = collapseWhitespace(result.Conditions[i].Message)
+		}
+	}
+
 	return &result, nil
 }
+
+// decodeError classifies a JSON decoding error: malformed or truncated JSON is a SyntaxError,
+// while well-formed JSON that does not fit the result schema, such as a field of the wrong
+// type, is a format error
+func decodeError(err error) error {
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return &SyntaxError{Err: err}
+	}
+	return fmt.Errorf("invalid result format: %w", err)
+}
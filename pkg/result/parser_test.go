@@ -1,6 +1,11 @@
 package result_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -61,6 +66,28 @@ var _ = Describe("Parser", func() {
 				Expect(r).NotTo(BeNil())
 				Expect(r.Status).To(Equal(result.StatusFailure))
 			})
+
+			It("keeps the raw file content", func() {
+				content := `{"status": "success", "reason": "TestPassed", "message": "Test completed"}`
+				tmpFile := filepath.Join(tmpDir, "result.json")
+				Expect(os.WriteFile(tmpFile, []byte(content), 0644)).To(Succeed())
+
+				r, err := parser.ParseFile(tmpFile)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(r.Raw)).To(Equal(content))
+			})
+		})
+
+		Context("with a byte order mark", func() {
+			It("parses a result prefixed with a UTF-8 BOM", func() {
+				tmpFile := filepath.Join(tmpDir, "bom-result.json")
+				content := "\xEF\xBB\xBF" + `{"status":"success","reason":"TestPassed","message":"Test completed"}`
+				Expect(os.WriteFile(tmpFile, []byte(content), 0644)).To(Succeed())
+
+				r, err := parser.ParseFile(tmpFile)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r.Reason).To(Equal("TestPassed"))
+			})
 		})
 
 		Context("with invalid files", func() {
@@ -74,6 +101,26 @@ var _ = Describe("Parser", func() {
 				Expect(err.Error()).To(ContainSubstring("result file is empty"))
 			})
 
+			It("returns empty error for BOM-only file", func() {
+				tmpFile := filepath.Join(tmpDir, "bom.json")
+				err := os.WriteFile(tmpFile, []byte("\xEF\xBB\xBF"), 0644)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = parser.ParseFile(tmpFile)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("result file is empty"))
+			})
+
+			It("returns empty error for BOM followed by whitespace", func() {
+				tmpFile := filepath.Join(tmpDir, "bom-ws.json")
+				err := os.WriteFile(tmpFile, []byte("\xEF\xBB\xBF  \n\t"), 0644)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = parser.ParseFile(tmpFile)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("result file is empty"))
+			})
+
 			It("returns error for invalid JSON", func() {
 				content := `{invalid json}`
 				tmpFile := filepath.Join(tmpDir, "invalid.json")
@@ -115,6 +162,219 @@ var _ = Describe("Parser", func() {
 		})
 	})
 
+	Describe("ParseFile with an allowed base", func() {
+		const content = `{"status":"success","reason":"AllChecksPassed","message":"ok"}`
+		var base string
+
+		BeforeEach(func() {
+			base = GinkgoT().TempDir()
+		})
+
+		It("parses a result under the base", func() {
+			path := filepath.Join(base, "result.json")
+			Expect(os.WriteFile(path, []byte(content), 0644)).To(Succeed())
+
+			r, err := result.NewParser(result.WithAllowedBase(base)).ParseFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Reason).To(Equal("AllChecksPassed"))
+		})
+
+		It("rejects a symlink that leads outside the base", func() {
+			outside := filepath.Join(GinkgoT().TempDir(), "result.json")
+			Expect(os.WriteFile(outside, []byte(content), 0644)).To(Succeed())
+			path := filepath.Join(base, "result.json")
+			Expect(os.Symlink(outside, path)).To(Succeed())
+
+			_, err := result.NewParser(result.WithAllowedBase(base)).ParseFile(path)
+			Expect(err).To(MatchError(ContainSubstring("outside the allowed results base")))
+		})
+
+		It("reports a missing result as not existing", func() {
+			_, err := result.NewParser(result.WithAllowedBase(base)).ParseFile(filepath.Join(base, "result.json"))
+			Expect(err).To(MatchError(os.ErrNotExist))
+		})
+	})
+
+	Describe("ParseFile with checksum verification", func() {
+		const content = `{"status":"success","reason":"AllChecksPassed","message":"ok"}`
+		var path string
+
+		BeforeEach(func() {
+			path = filepath.Join(GinkgoT().TempDir(), "result.json")
+			Expect(os.WriteFile(path, []byte(content), 0644)).To(Succeed())
+		})
+
+		writeChecksum := func(data string) {
+			sum := sha256.Sum256([]byte(data))
+			Expect(os.WriteFile(path+result.DefaultChecksumSuffix, []byte(hex.EncodeToString(sum[:])+"  result.json\n"), 0644)).To(Succeed())
+		}
+
+		It("parses a result matching its checksum", func() {
+			writeChecksum(content)
+			parser := result.NewParser(result.WithChecksumVerification(result.DefaultChecksumSuffix, true))
+
+			r, err := parser.ParseFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Reason).To(Equal("AllChecksPassed"))
+		})
+
+		It("rejects a result that does not match its checksum", func() {
+			writeChecksum(content + " ")
+			parser := result.NewParser(result.WithChecksumVerification(result.DefaultChecksumSuffix, false))
+
+			_, err := parser.ParseFile(path)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("checksum mismatch"))
+		})
+
+		It("rejects a result without a checksum file when strict", func() {
+			parser := result.NewParser(result.WithChecksumVerification(result.DefaultChecksumSuffix, true))
+
+			_, err := parser.ParseFile(path)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to read checksum file"))
+		})
+
+		It("parses a result without a checksum file when lenient", func() {
+			parser := result.NewParser(result.WithChecksumVerification(result.DefaultChecksumSuffix, false))
+
+			_, err := parser.ParseFile(path)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("ParseFiles", func() {
+		var tmpDir string
+
+		BeforeEach(func() {
+			tmpDir = GinkgoT().TempDir()
+		})
+
+		It("returns results in input order", func() {
+			var paths []string
+			for i := 0; i < 20; i++ {
+				path := filepath.Join(tmpDir, fmt.Sprintf("result-%02d.json", i))
+				content := fmt.Sprintf(`{"status":"success","reason":"Check%02d","message":"ok"}`, i)
+				Expect(os.WriteFile(path, []byte(content), 0644)).To(Succeed())
+				paths = append(paths, path)
+			}
+
+			results := parser.ParseFiles(paths, 4)
+
+			Expect(results).To(HaveLen(20))
+			for i, r := range results {
+				Expect(r.Path).To(Equal(paths[i]))
+				Expect(r.Err).NotTo(HaveOccurred())
+				Expect(r.Result.Reason).To(Equal(fmt.Sprintf("Check%02d", i)))
+			}
+		})
+
+		It("reports per-file errors without affecting other files", func() {
+			good := filepath.Join(tmpDir, "good.json")
+			bad := filepath.Join(tmpDir, "bad.json")
+			Expect(os.WriteFile(good, []byte(`{"status":"failure","reason":"Failed","message":"failed"}`), 0644)).To(Succeed())
+			Expect(os.WriteFile(bad, []byte(`{bad json`), 0644)).To(Succeed())
+
+			results := parser.ParseFiles([]string{bad, good}, 0)
+
+			Expect(results[0].Err).To(HaveOccurred())
+			Expect(results[1].Err).NotTo(HaveOccurred())
+			Expect(results[1].Result.Status).To(Equal(result.StatusFailure))
+		})
+
+		It("handles an empty path list", func() {
+			Expect(parser.ParseFiles(nil, 4)).To(BeEmpty())
+		})
+	})
+
+	Describe("Aggregate", func() {
+		success := func(path, reason string) result.FileResult {
+			return result.FileResult{Path: path, Result: &result.AdapterResult{Status: result.StatusSuccess, Reason: reason, Message: "ok"}}
+		}
+		failure := func(path, reason, severity string) result.FileResult {
+			return result.FileResult{Path: path, Result: &result.AdapterResult{Status: result.StatusFailure, Reason: reason, Message: "broken", Severity: severity}}
+		}
+
+		It("returns a single result unchanged", func() {
+			r, err := result.Aggregate([]result.FileResult{failure("/results/a.json", "Broken", "")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Reason).To(Equal("Broken"))
+		})
+
+		It("succeeds only when all results succeeded", func() {
+			r, err := result.Aggregate([]result.FileResult{success("/results/a.json", "A"), success("/results/b.json", "B")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.IsSuccess()).To(BeTrue())
+			Expect(r.Reason).To(Equal(result.ReasonAllResultsSucceeded))
+		})
+
+		It("lists the failures and keeps the highest severity", func() {
+			r, err := result.Aggregate([]result.FileResult{
+				failure("/results/a.json", "QuotaExceeded", result.SeverityLow),
+				success("/results/b.json", "B"),
+				failure("/results/c.json", "DNSMissing", result.SeverityHigh),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.IsSuccess()).To(BeFalse())
+			Expect(r.Reason).To(Equal(result.ReasonMultipleFailures))
+			Expect(r.Severity).To(Equal(result.SeverityHigh))
+			Expect(r.Message).To(Equal("2 of 3 results failed: a.json: QuotaExceeded: broken; c.json: DNSMissing: broken"))
+		})
+
+		It("is unknown when no result failed but some are unknown", func() {
+			unknown := result.FileResult{Path: "/results/b.json", Result: &result.AdapterResult{Status: result.StatusUnknown, Reason: "Unreachable", Message: "no answer"}}
+
+			r, err := result.Aggregate([]result.FileResult{success("/results/a.json", "A"), unknown})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.IsUnknown()).To(BeTrue())
+			Expect(r.Reason).To(Equal("Unreachable"))
+
+			r, err = result.Aggregate([]result.FileResult{unknown, failure("/results/c.json", "DNSMissing", "")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Status).To(Equal(result.StatusFailure))
+		})
+
+		It("reports several unknown results as undetermined rather than failed", func() {
+			b := result.FileResult{Path: "/results/b.json", Result: &result.AdapterResult{Status: result.StatusUnknown, Reason: "Unreachable", Message: "no answer"}}
+			c := result.FileResult{Path: "/results/c.json", Result: &result.AdapterResult{Status: result.StatusUnknown, Reason: "Throttled", Message: "retry later"}}
+
+			r, err := result.Aggregate([]result.FileResult{success("/results/a.json", "A"), b, c})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.IsUnknown()).To(BeTrue())
+			Expect(r.Reason).To(Equal(result.ReasonResultsUndetermined))
+			Expect(r.Message).To(Equal("2 of 3 results undetermined: b.json: Unreachable: no answer; c.json: Throttled: retry later"))
+		})
+
+		It("keeps the details of each file and merges their conditions", func() {
+			a := success("/results/a.json", "A")
+			a.Result.Details = json.RawMessage(`{"zone":"us-east-1"}`)
+			a.Result.Conditions = []result.Condition{{Type: "DNSReady", Status: "True", Reason: "Resolved"}}
+			b := failure("/results/b.json", "QuotaExceeded", "")
+			b.Result.Details = json.RawMessage(`{"cpu":0}`)
+			b.Result.Conditions = []result.Condition{
+				{Type: "QuotaReady", Status: "False", Reason: "QuotaExceeded"},
+				{Type: "DNSReady", Status: "False", Reason: "Ignored"},
+			}
+
+			r, err := result.Aggregate([]result.FileResult{a, b})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Details).To(MatchJSON(`{"a.json":{"zone":"us-east-1"},"b.json":{"cpu":0}}`))
+			Expect(r.Conditions).To(HaveLen(2))
+			Expect(r.Conditions[0].Reason).To(Equal("Resolved"))
+			Expect(r.Conditions[1].Type).To(Equal("QuotaReady"))
+		})
+
+		It("returns the parse error of a file with its name", func() {
+			_, err := result.Aggregate([]result.FileResult{
+				success("/results/a.json", "A"),
+				{Path: "/results/b.json", Err: &result.SyntaxError{Err: errors.New("unexpected end of JSON input")}},
+			})
+			Expect(err).To(MatchError(HavePrefix("b.json: ")))
+			var syntaxErr *result.SyntaxError
+			Expect(errors.As(err, &syntaxErr)).To(BeTrue())
+		})
+	})
+
 	Describe("Parse", func() {
 		Context("with valid data", func() {
 			It("parses valid JSON", func() {
@@ -132,6 +392,23 @@ var _ = Describe("Parser", func() {
 				Expect(r.Reason).To(Equal(result.DefaultReason))
 				Expect(r.Message).To(Equal(result.DefaultMessage))
 			})
+
+			It("preserves newlines in the message by default", func() {
+				data := []byte(`{"status":"success","reason":"OK","message":"line one\nline two"}`)
+				r, err := parser.Parse(data)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r.Message).To(Equal("line one\nline two"))
+			})
+		})
+
+		Context("with single-line messages enabled", func() {
+			It("collapses newlines and tabs into single spaces", func() {
+				singleLineParser := result.NewParser(result.WithSingleLineMessage(true))
+				data := []byte(`{"status":"failure","reason":"Failed","message":"  check A failed\n\tcheck B failed\r\n  "}`)
+				r, err := singleLineParser.Parse(data)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(r.Message).To(Equal("check A failed check B failed"))
+			})
 		})
 
 		Context("with invalid data", func() {
@@ -140,14 +417,316 @@ var _ = Describe("Parser", func() {
 				_, err := parser.Parse(data)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("failed to parse JSON"))
+
+				var syntaxErr *result.SyntaxError
+				Expect(errors.As(err, &syntaxErr)).To(BeTrue())
+			})
+
+			It("returns a format error for well-formed JSON with a field of the wrong type", func() {
+				data := []byte(`{"status":"success","reason":"Test","message":["not","a","string"]}`)
+				_, err := parser.Parse(data)
+				Expect(err).To(MatchError(ContainSubstring("invalid result format")))
+
+				var syntaxErr *result.SyntaxError
+				Expect(errors.As(err, &syntaxErr)).To(BeFalse())
+			})
+
+			It("returns a syntax error for truncated JSON", func() {
+				_, err := parser.Parse([]byte(`{"status":"success","reason":`))
+
+				var syntaxErr *result.SyntaxError
+				Expect(errors.As(err, &syntaxErr)).To(BeTrue())
 			})
 
 			It("returns error for invalid status value", func() {
-				data := []byte(`{"status":"unknown","reason":"Test","message":"Test"}`)
+				data := []byte(`{"status":"pending","reason":"Test","message":"Test"}`)
 				_, err := parser.Parse(data)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("invalid result format"))
+
+				var syntaxErr *result.SyntaxError
+				Expect(errors.As(err, &syntaxErr)).To(BeFalse())
+				var resultErr *result.ResultError
+				Expect(errors.As(err, &resultErr)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("Parse with required reason and message", func() {
+		BeforeEach(func() {
+			parser = result.NewParser(result.WithRequiredReasonMessage(true))
+		})
+
+		It("accepts a result with reason and message", func() {
+			r, err := parser.Parse([]byte(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Reason).To(Equal("AllChecksPassed"))
+		})
+
+		It("rejects a result without a reason", func() {
+			_, err := parser.Parse([]byte(`{"status":"success","message":"ok"}`))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("reason: is required"))
+		})
+
+		It("rejects a result with a blank message", func() {
+			_, err := parser.Parse([]byte(`{"status":"success","reason":"AllChecksPassed","message":"  "}`))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("message: is required"))
+		})
+	})
+
+	Describe("Parse with field pointers", func() {
+		It("extracts fields from nested locations", func() {
+			pointerParser := result.NewParser(result.WithFieldPointers(result.FieldPointers{
+				Status:  "/outcome/state",
+				Reason:  "/outcome/checks/0/code",
+				Message: "/summary~1text",
+				Details: "/outcome/checks",
+			}))
+			data := []byte(`{"outcome":{"state":"failure","checks":[{"code":"DNSFailed","count":2}]},"summary/text":"DNS check failed"}`)
+
+			r, err := pointerParser.Parse(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Status).To(Equal(result.StatusFailure))
+			Expect(r.Reason).To(Equal("DNSFailed"))
+			Expect(r.Message).To(Equal("DNS check failed"))
+			Expect(string(r.Details)).To(MatchJSON(`[{"code":"DNSFailed","count":2}]`))
+		})
+
+		It("reads fields without a pointer from their top-level keys", func() {
+			pointerParser := result.NewParser(result.WithFieldPointers(result.FieldPointers{Status: "/result/status"}))
+			data := []byte(`{"result":{"status":"success"},"reason":"OK"}`)
+
+			r, err := pointerParser.Parse(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.IsSuccess()).To(BeTrue())
+			Expect(r.Reason).To(Equal("OK"))
+			Expect(r.Message).To(Equal(result.DefaultMessage))
+		})
+
+		It("extracts severity, conditions, correlation ID and observed generation", func() {
+			pointerParser := result.NewParser(result.WithFieldPointers(result.FieldPointers{
+				Status:             "/outcome/state",
+				Severity:           "/outcome/severity",
+				Conditions:         "/outcome/conditions",
+				CorrelationID:      "/meta/correlation",
+				ObservedGeneration: "/meta/generation",
+			}))
+			data := []byte(`{"outcome":{"state":"failure","severity":"high",` +
+				`"conditions":[{"type":"DNSReady","status":"False","reason":"DNSFailed","message":"no records"}]},` +
+				`"meta":{"correlation":"reconcile-7f3a","generation":4},"reason":"DNSFailed","message":"DNS check failed"}`)
+
+			r, err := pointerParser.Parse(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Severity).To(Equal(result.SeverityHigh))
+			Expect(r.Conditions).To(Equal([]result.Condition{{Type: "DNSReady", Status: "False", Reason: "DNSFailed", Message: "no records"}}))
+			Expect(r.CorrelationID).To(Equal("reconcile-7f3a"))
+			Expect(r.ObservedGeneration).To(Equal(int64(4)))
+		})
+
+		It("reads the other fields from their top-level keys when only the status has a pointer", func() {
+			pointerParser := result.NewParser(result.WithFieldPointers(result.FieldPointers{Status: "/result/status"}))
+			data := []byte(`{"result":{"status":"failure"},"reason":"DNSFailed","severity":"low","correlationId":"c-1",` +
+				`"observedGeneration":2,"conditions":[{"type":"DNSReady","status":"False"}]}`)
+
+			r, err := pointerParser.Parse(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Severity).To(Equal(result.SeverityLow))
+			Expect(r.CorrelationID).To(Equal("c-1"))
+			Expect(r.ObservedGeneration).To(Equal(int64(2)))
+			Expect(r.Conditions).To(HaveLen(1))
+		})
+
+		It("returns a format error for an observed generation that is not an integer", func() {
+			pointerParser := result.NewParser(result.WithFieldPointers(result.FieldPointers{Status: "/status"}))
+
+			_, err := pointerParser.Parse([]byte(`{"status":"success","observedGeneration":"4"}`))
+			Expect(err).To(MatchError(ContainSubstring("must be an integer")))
+		})
+
+		It("returns a format error when the status is missing or not a string", func() {
+			pointerParser := result.NewParser(result.WithFieldPointers(result.FieldPointers{Status: "/result/status"}))
+
+			_, err := pointerParser.Parse([]byte(`{"result":{}}`))
+			Expect(err).To(MatchError(ContainSubstring("invalid result format")))
+
+			_, err = pointerParser.Parse([]byte(`{"result":{"status":true}}`))
+			Expect(err).To(MatchError(ContainSubstring("must be a string")))
+		})
+
+		It("returns a syntax error for malformed JSON", func() {
+			pointerParser := result.NewParser(result.WithFieldPointers(result.FieldPointers{Status: "/status"}))
+
+			_, err := pointerParser.Parse([]byte(`{bad json`))
+			var syntaxErr *result.SyntaxError
+			Expect(errors.As(err, &syntaxErr)).To(BeTrue())
+		})
+	})
+
+	Describe("Parse with apiVersion", func() {
+		It("parses results with and without the v1 apiVersion", func() {
+			r, err := parser.Parse([]byte(`{"apiVersion":"adapter.hyperfleet.io/v1","status":"success","reason":"A","message":"ok"}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.APIVersion).To(Equal(result.APIVersionV1))
+
+			r, err = parser.Parse([]byte(`{"status":"success","reason":"A","message":"ok"}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.APIVersion).To(BeEmpty())
+		})
+
+		It("rejects an unsupported apiVersion", func() {
+			_, err := parser.Parse([]byte(`{"apiVersion":"adapter.hyperfleet.io/v9","status":"success","reason":"A","message":"ok"}`))
+			Expect(err).To(MatchError(ContainSubstring(`unsupported version "adapter.hyperfleet.io/v9"`)))
+			var resultErr *result.ResultError
+			Expect(errors.As(err, &resultErr)).To(BeTrue())
+			Expect(resultErr.Field).To(Equal("apiVersion"))
+		})
+
+		It("converts a registered version to v1", func() {
+			result.RegisterAPIVersion("adapter.hyperfleet.io/v2test", func(data []byte) ([]byte, error) {
+				var v2 struct {
+					Outcome struct {
+						Passed  bool   `json:"passed"`
+						Code    string `json:"code"`
+						Summary string `json:"summary"`
+					} `json:"outcome"`
+				}
+				if err := json.Unmarshal(data, &v2); err != nil {
+					return nil, err
+				}
+				status := result.StatusFailure
+				if v2.Outcome.Passed {
+					status = result.StatusSuccess
+				}
+				return json.Marshal(result.AdapterResult{Status: status, Reason: v2.Outcome.Code, Message: v2.Outcome.Summary})
 			})
+			DeferCleanup(result.UnregisterAPIVersion, "adapter.hyperfleet.io/v2test")
+			Expect(result.APIVersions()).To(ContainElements(result.APIVersionV1, "adapter.hyperfleet.io/v2test"))
+
+			r, err := parser.Parse([]byte(`{"apiVersion":"adapter.hyperfleet.io/v2test","outcome":{"passed":false,"code":"QuotaExceeded","summary":"no CPU"}}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Status).To(Equal(result.StatusFailure))
+			Expect(r.Reason).To(Equal("QuotaExceeded"))
+			Expect(r.APIVersion).To(Equal("adapter.hyperfleet.io/v2test"))
+		})
+	})
+
+	Describe("Parse with NDJSON", func() {
+		var streamParser *result.Parser
+
+		BeforeEach(func() {
+			streamParser = result.NewParser(result.WithNDJSON(true))
+		})
+
+		It("returns the last record as progress when none is final", func() {
+			r, err := streamParser.Parse([]byte(`{"status":"success","reason":"Step1","message":"a"}` + "\n" +
+				`{"status":"success","reason":"Step2","message":"b"}` + "\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Reason).To(Equal("Step2"))
+			Expect(r.Final).To(BeFalse())
+		})
+
+		It("returns the first final record", func() {
+			r, err := streamParser.Parse([]byte(`{"status":"success","reason":"Step1","message":"a"}` + "\n" +
+				`{"status":"failure","reason":"Done","message":"b","final":true}` + "\n" +
+				`{"status":"success","reason":"Late","message":"c"}` + "\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Reason).To(Equal("Done"))
+			Expect(r.Final).To(BeTrue())
+		})
+
+		It("skips a trailing record that is still being written", func() {
+			r, err := streamParser.Parse([]byte(`{"status":"success","reason":"Step1","message":"a"}` + "\n" + `{"status":"fail`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Reason).To(Equal("Step1"))
+		})
+
+		It("validates each record", func() {
+			_, err := streamParser.Parse([]byte(`{"status":"pending","reason":"Step1","message":"a"}` + "\n" +
+				`{"status":"success","reason":"Done","message":"b","final":true}` + "\n"))
+			Expect(err).To(MatchError(ContainSubstring("record 1: invalid result format")))
+		})
+	})
+
+	Describe("Parse with formats", func() {
+		const yamlResult = "status: failure\nreason: QuotaExceeded\nmessage: CPU quota exhausted\ndetails:\n  region: us-east1\n"
+
+		It("parses YAML when selected", func() {
+			r, err := result.NewParser(result.WithFormat(result.FormatYAML)).Parse([]byte(yamlResult))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Reason).To(Equal("QuotaExceeded"))
+			Expect(string(r.Details)).To(MatchJSON(`{"region":"us-east1"}`))
+		})
+
+		It("rejects YAML with the default JSON format", func() {
+			_, err := parser.Parse([]byte(yamlResult))
+			var syntaxErr *result.SyntaxError
+			Expect(errors.As(err, &syntaxErr)).To(BeTrue())
+		})
+
+		It("detects the format from the file extension", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "result.yml")
+			Expect(os.WriteFile(path, []byte(yamlResult), 0644)).To(Succeed())
+
+			r, err := result.NewParser(result.WithFormat(result.FormatAuto)).ParseFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Status).To(Equal(result.StatusFailure))
+		})
+
+		It("detects the format from the content", func() {
+			autoParser := result.NewParser(result.WithFormat(result.FormatAuto))
+
+			r, err := autoParser.Parse([]byte(yamlResult))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Reason).To(Equal("QuotaExceeded"))
+
+			_, err = autoParser.Parse([]byte(`{"status":`))
+			var syntaxErr *result.SyntaxError
+			Expect(errors.As(err, &syntaxErr)).To(BeTrue())
+		})
+
+		It("uses a registered format", func() {
+			result.RegisterFormat("kv", func(data []byte) ([]byte, error) {
+				fields := map[string]string{}
+				for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+					key, value, _ := strings.Cut(line, "=")
+					fields[key] = value
+				}
+				return json.Marshal(fields)
+			})
+			DeferCleanup(result.UnregisterFormat, "kv")
+			Expect(result.Formats()).To(ContainElement("kv"))
+
+			path := filepath.Join(GinkgoT().TempDir(), "result.kv")
+			Expect(os.WriteFile(path, []byte("status=success\nreason=Done\nmessage=ok\n"), 0644)).To(Succeed())
+
+			r, err := result.NewParser(result.WithFormat(result.FormatAuto)).ParseFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(r.Reason).To(Equal("Done"))
+		})
+
+		It("keeps the built-in formats when unregistering them", func() {
+			result.UnregisterFormat(result.FormatJSON)
+			Expect(result.Formats()).To(ContainElements(result.FormatJSON, result.FormatYAML))
+		})
+
+		It("returns an error for an unknown format", func() {
+			_, err := result.NewParser(result.WithFormat("toml")).Parse([]byte(`{}`))
+			Expect(err).To(MatchError(ContainSubstring(`unknown result format "toml"`)))
+		})
+	})
+
+	Describe("ValidatePointer", func() {
+		It("accepts valid pointers", func() {
+			Expect(result.ValidatePointer("")).To(Succeed())
+			Expect(result.ValidatePointer("/a/0/b~0c~1d")).To(Succeed())
+		})
+
+		It("rejects pointers without a leading slash or with bad escapes", func() {
+			Expect(result.ValidatePointer("status")).NotTo(Succeed())
+			Expect(result.ValidatePointer("/a~2")).NotTo(Succeed())
+			Expect(result.ValidatePointer("/a~")).NotTo(Succeed())
 		})
 	})
 })
@@ -0,0 +1,261 @@
+package result
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FieldPointers holds JSON Pointers (RFC 6901) locating each result field in an adapter's
+// own output format. An empty pointer selects the field at its default top-level location.
+type FieldPointers struct {
+	Status             string
+	Reason             string
+	Message            string
+	Details            string
+	Severity           string
+	Conditions         string
+	CorrelationID      string
+	ObservedGeneration string
+}
+
+// IsZero reports whether no pointer is configured, in which case the flat result contract applies
+func (fp FieldPointers) IsZero() bool {
+	return fp == FieldPointers{}
+}
+
+// WithFieldPointers extracts the result fields from the locations given by JSON Pointers
+// instead of the flat result contract
+func WithFieldPointers(pointers FieldPointers) ParserOption {
+	return func(p *Parser) {
+		p.pointers = pointers
+	}
+}
+
+// ValidatePointer checks that pointer is a syntactically valid JSON Pointer
+func ValidatePointer(pointer string) error {
+	_, err := splitPointer(pointer)
+	return err
+}
+
+// DetailsValue returns the value the JSON Pointer refers to within the result details.
+// found is false when the details are absent or do not contain the referenced value.
+func (r *AdapterResult) DetailsValue(pointer string) (value any, found bool, err error) {
+	if len(r.Details) == 0 {
+		if _, err := splitPointer(pointer); err != nil {
+			return nil, false, err
+		}
+		return nil, false, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(r.Details))
+	decoder.UseNumber()
+	var doc any
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, false, fmt.Errorf("failed to parse details: %w", err)
+	}
+	return resolvePointer(doc, pointer)
+}
+
+// extract builds a result from data using the configured field pointers
+func (fp FieldPointers) extract(data []byte) (*AdapterResult, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var doc any
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, decodeError(err)
+	}
+
+	var result AdapterResult
+	var err error
+	if result.Status, err = stringAt(doc, "status", pointerOrDefault(fp.Status, "/status")); err != nil {
+		return nil, err
+	}
+	if result.Reason, err = stringAt(doc, "reason", pointerOrDefault(fp.Reason, "/reason")); err != nil {
+		return nil, err
+	}
+	if result.Message, err = stringAt(doc, "message", pointerOrDefault(fp.Message, "/message")); err != nil {
+		return nil, err
+	}
+
+	if result.Severity, err = stringAt(doc, "severity", pointerOrDefault(fp.Severity, "/severity")); err != nil {
+		return nil, err
+	}
+	if result.CorrelationID, err = stringAt(doc, "correlationId", pointerOrDefault(fp.CorrelationID, "/correlationId")); err != nil {
+		return nil, err
+	}
+	if result.ObservedGeneration, err = int64At(doc, "observedGeneration", pointerOrDefault(fp.ObservedGeneration, "/observedGeneration")); err != nil {
+		return nil, err
+	}
+
+	details, err := rawAt(doc, "details", pointerOrDefault(fp.Details, "/details"))
+	if err != nil {
+		return nil, err
+	}
+	result.Details = details
+
+	conditions, err := rawAt(doc, "conditions", pointerOrDefault(fp.Conditions, "/conditions"))
+	if err != nil {
+		return nil, err
+	}
+	if conditions != nil {
+		if err := json.Unmarshal(conditions, &result.Conditions); err != nil {
+			return nil, &ResultError{Field: "conditions", Message: err.Error()}
+		}
+	}
+
+	// The NDJSON stream marker is part of the framing rather than the adapter's own format, so it
+	// is always read from the top level
+	if result.Final, err = boolAt(doc, "final", "/final"); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func pointerOrDefault(pointer, defaultPointer string) string {
+	if pointer == "" {
+		return defaultPointer
+	}
+	return pointer
+}
+
+// stringAt resolves a string field; a missing value yields an empty string
+func stringAt(doc any, field, pointer string) (string, error) {
+	value, found, err := resolvePointer(doc, pointer)
+	if err != nil {
+		return "", &ResultError{Field: field, Message: err.Error()}
+	}
+	if !found || value == nil {
+		return "", nil
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return "", &ResultError{Field: field, Message: fmt.Sprintf("value at pointer %q must be a string", pointer)}
+	}
+	return s, nil
+}
+
+// int64At resolves an integer field; a missing value yields zero
+func int64At(doc any, field, pointer string) (int64, error) {
+	value, found, err := resolvePointer(doc, pointer)
+	if err != nil {
+		return 0, &ResultError{Field: field, Message: err.Error()}
+	}
+	if !found || value == nil {
+		return 0, nil
+	}
+
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, &ResultError{Field: field, Message: fmt.Sprintf("value at pointer %q must be an integer", pointer)}
+	}
+	n, err := number.Int64()
+	if err != nil {
+		return 0, &ResultError{Field: field, Message: fmt.Sprintf("value at pointer %q must be an integer", pointer)}
+	}
+	return n, nil
+}
+
+// boolAt resolves a boolean field; a missing value yields false
+func boolAt(doc any, field, pointer string) (bool, error) {
+	value, found, err := resolvePointer(doc, pointer)
+	if err != nil {
+		return false, &ResultError{Field: field, Message: err.Error()}
+	}
+	if !found || value == nil {
+		return false, nil
+	}
+
+	b, ok := value.(bool)
+	if !ok {
+		return false, &ResultError{Field: field, Message: fmt.Sprintf("value at pointer %q must be a boolean", pointer)}
+	}
+	return b, nil
+}
+
+// rawAt resolves a field as raw JSON; a missing or null value yields nil
+func rawAt(doc any, field, pointer string) (json.RawMessage, error) {
+	value, found, err := resolvePointer(doc, pointer)
+	if err != nil {
+		return nil, &ResultError{Field: field, Message: err.Error()}
+	}
+	if !found || value == nil {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, &ResultError{Field: field, Message: err.Error()}
+	}
+	return raw, nil
+}
+
+// resolvePointer returns the value pointer refers to in doc. found is false when the
+// referenced member or index does not exist.
+func resolvePointer(doc any, pointer string) (value any, found bool, err error) {
+	tokens, err := splitPointer(pointer)
+	if err != nil {
+		return nil, false, err
+	}
+
+	value = doc
+	for _, token := range tokens {
+		switch node := value.(type) {
+		case map[string]any:
+			if value, found = node[token]; !found {
+				return nil, false, nil
+			}
+		case []any:
+			index, ok := arrayIndex(token)
+			if !ok || index >= len(node) {
+				return nil, false, nil
+			}
+			value = node[index]
+		default:
+			return nil, false, nil
+		}
+	}
+
+	return value, true, nil
+}
+
+// pointerUnescaper decodes "~1" and "~0" in a single left-to-right pass, as RFC 6901 requires
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// splitPointer splits a JSON Pointer into unescaped reference tokens
+func splitPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q: must be empty or start with '/'", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		for j := 0; j < len(token); j++ {
+			if token[j] == '~' && (j+1 == len(token) || (token[j+1] != '0' && token[j+1] != '1')) {
+				return nil, fmt.Errorf("invalid JSON pointer %q: '~' must be followed by '0' or '1'", pointer)
+			}
+		}
+		tokens[i] = pointerUnescaper.Replace(token)
+	}
+	return tokens, nil
+}
+
+// arrayIndex parses an RFC 6901 array index (decimal, no leading zeros)
+func arrayIndex(token string) (int, bool) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, false
+	}
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 {
+		return 0, false
+	}
+	return index, true
+}
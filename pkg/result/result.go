@@ -2,8 +2,11 @@ package result
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"unicode"
 	"unicode/utf8"
 )
 
@@ -11,11 +14,19 @@ const (
 	StatusSuccess = "success"
 	StatusFailure = "failure"
 
+	// StatusUnknown reports that the adapter could not determine the outcome, as opposed to a
+	// failure; it sets the condition to Unknown
+	StatusUnknown = "unknown"
+
 	DefaultReason  = "NoReasonProvided"
 	DefaultMessage = "No message provided"
 
 	maxReasonLength  = 128
 	maxMessageLength = 1024
+
+	// MaxCorrelationIDLength is the longest correlation ID accepted, so it always fits in the
+	// condition message
+	MaxCorrelationIDLength = 128
 )
 
 // ResultError represents a validation error for adapter result validation
@@ -28,9 +39,27 @@ func (e *ResultError) Error() string {
 	return e.Field + ": " + e.Message
 }
 
+// SyntaxError is returned when result data is not well-formed JSON, as opposed to
+// a ResultError which reports well-formed data that violates the result contract
+type SyntaxError struct {
+	Err error
+}
+
+func (e *SyntaxError) Error() string {
+	return "failed to parse JSON: " + e.Err.Error()
+}
+
+func (e *SyntaxError) Unwrap() error {
+	return e.Err
+}
+
 // AdapterResult represents the result contract that any adapter must produce
 type AdapterResult struct {
-	// Status must be either StatusSuccess or StatusFailure
+	// APIVersion is the schema version the adapter wrote (APIVersionV1 when empty); other
+	// registered versions are converted to v1 when parsed
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Status must be StatusSuccess, StatusFailure or StatusUnknown
 	Status string `json:"status"`
 
 	// Reason is a machine-readable identifier (e.g., "AllChecksPassed", "DNSConfigured")
@@ -39,8 +68,52 @@ type AdapterResult struct {
 	// Message is a human-readable description
 	Message string `json:"message"`
 
+	// Severity optionally ranks a failure (one of Severities)
+	Severity string `json:"severity,omitempty"`
+
 	// Details contains optional adapter-specific data as raw JSON
 	Details json.RawMessage `json:"details,omitempty"`
+
+	// Final marks the terminal record of an NDJSON result stream; earlier records are progress
+	Final bool `json:"final,omitempty"`
+
+	// Conditions optionally sets further Job conditions, each with its own type, alongside the
+	// condition reported from Status
+	Conditions []Condition `json:"conditions,omitempty"`
+
+	// CorrelationID optionally identifies the reconcile attempt the result belongs to
+	CorrelationID string `json:"correlationId,omitempty"`
+
+	// ObservedGeneration optionally records the generation of the reconciled resource the adapter
+	// validated
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Raw is the data the result was parsed from, as the adapter wrote it; it is nil for results
+	// built by the reporter, such as aggregates
+	Raw []byte `json:"-"`
+}
+
+// Condition is an additional Job condition returned by the adapter
+type Condition struct {
+	// Type is the condition type (e.g., "DNSReady")
+	Type string `json:"type"`
+
+	// Status must be one of ConditionStatuses
+	Status string `json:"status"`
+
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// ConditionStatuses are the valid statuses of an additional condition
+var ConditionStatuses = []string{"True", "False", "Unknown"}
+
+// ReservedConditionTypes are the condition types the Job controller owns; adapters may not set them
+var ReservedConditionTypes = []string{"Complete", "Failed", "FailureTarget", "SuccessCriteriaMet", "Suspended"}
+
+// IsUnknown returns true if the adapter could not determine the outcome
+func (r *AdapterResult) IsUnknown() bool {
+	return r.Status == StatusUnknown
 }
 
 // IsSuccess returns true if the adapter operation succeeded
@@ -50,32 +123,99 @@ func (r *AdapterResult) IsSuccess() bool {
 
 // Validate validates and normalizes the result
 func (r *AdapterResult) Validate() error {
-	if r.Status != StatusSuccess && r.Status != StatusFailure {
+	if r.Status != StatusSuccess && r.Status != StatusFailure && r.Status != StatusUnknown {
 		return &ResultError{
 			Field:   "status",
-			Message: fmt.Sprintf("must be either '%s' or '%s'", StatusSuccess, StatusFailure),
+			Message: fmt.Sprintf("must be one of '%s', '%s' or '%s'", StatusSuccess, StatusFailure, StatusUnknown),
 		}
 	}
 
-	r.Reason = strings.TrimSpace(r.Reason)
-	if r.Reason == "" {
-		r.Reason = DefaultReason
-	}
-	if len(r.Reason) > maxReasonLength {
-		r.Reason = truncateUTF8(r.Reason, maxReasonLength)
+	// Severities outside Severities are kept as written and never rank against MIN_FAILURE_SEVERITY
+	if rank, ok := SeverityRank(r.Severity); ok {
+		r.Severity = Severities[rank]
 	}
 
-	r.Message = strings.TrimSpace(r.Message)
-	if r.Message == "" {
-		r.Message = DefaultMessage
+	r.Reason, r.Message = normalizeReasonMessage(r.Reason, r.Message)
+
+	r.CorrelationID = strings.TrimSpace(r.CorrelationID)
+	if err := ValidateCorrelationID(r.CorrelationID); err != nil {
+		return &ResultError{Field: "correlationId", Message: err.Error()}
 	}
-	if len(r.Message) > maxMessageLength {
-		r.Message = truncateUTF8(r.Message, maxMessageLength)
+	if r.ObservedGeneration < 0 {
+		return &ResultError{Field: "observedGeneration", Message: "must not be negative"}
 	}
 
+	seen := make(map[string]bool, len(r.Conditions))
+	for i := range r.Conditions {
+		c := &r.Conditions[i]
+		field := fmt.Sprintf("conditions[%d]", i)
+		c.Type = strings.TrimSpace(c.Type)
+		if c.Type == "" {
+			return &ResultError{Field: field + ".type", Message: "is required"}
+		}
+		if seen[c.Type] {
+			return &ResultError{Field: field + ".type", Message: fmt.Sprintf("duplicate condition type %q", c.Type)}
+		}
+		if slices.Contains(ReservedConditionTypes, c.Type) {
+			return &ResultError{Field: field + ".type", Message: fmt.Sprintf("%q is reserved for the Job controller", c.Type)}
+		}
+		seen[c.Type] = true
+		if !slices.Contains(ConditionStatuses, c.Status) {
+			return &ResultError{Field: field + ".status", Message: fmt.Sprintf("must be one of %s", strings.Join(ConditionStatuses, ", "))}
+		}
+		c.Reason, c.Message = normalizeReasonMessage(c.Reason, c.Message)
+	}
+
+	return nil
+}
+
+// ValidateCorrelationID checks that a correlation ID fits in the condition message as a single
+// key=value token: no whitespace and at most MaxCorrelationIDLength bytes
+func ValidateCorrelationID(id string) error {
+	if len(id) > MaxCorrelationIDLength {
+		return fmt.Errorf("must be at most %d characters", MaxCorrelationIDLength)
+	}
+	if strings.IndexFunc(id, unicode.IsSpace) >= 0 {
+		return errors.New("must not contain whitespace")
+	}
 	return nil
 }
 
+// normalizeReasonMessage trims reason and message, fills in the defaults when empty and truncates
+// them to their maximum lengths
+func normalizeReasonMessage(reason, message string) (string, string) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		reason = DefaultReason
+	}
+	if len(reason) > maxReasonLength {
+		reason = truncateUTF8(reason, maxReasonLength)
+	}
+
+	message = strings.TrimSpace(message)
+	if message == "" {
+		message = DefaultMessage
+	}
+	if len(message) > maxMessageLength {
+		message = truncateUTF8(message, maxMessageLength)
+	}
+	return reason, message
+}
+
+// collapseWhitespace replaces every run of whitespace (including newlines and tabs) with a single space
+func collapseWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// FitMessage appends suffix to message, truncating message so the combined text stays within
+// the maximum message length
+func FitMessage(message, suffix string) string {
+	if len(suffix) >= maxMessageLength {
+		return truncateUTF8(suffix, maxMessageLength)
+	}
+	return truncateUTF8(message, maxMessageLength-len(suffix)) + suffix
+}
+
 // truncateUTF8 safely truncates a string to maxBytes without splitting multi-byte UTF-8 characters
 func truncateUTF8(s string, maxBytes int) string {
 	if len(s) <= maxBytes {
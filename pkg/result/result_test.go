@@ -47,6 +47,17 @@ var _ = Describe("AdapterResult", func() {
 				}
 				Expect(r.Validate()).To(Succeed())
 			})
+
+			It("accepts an unknown result", func() {
+				r := &result.AdapterResult{
+					Status:  result.StatusUnknown,
+					Reason:  "UpstreamUnreachable",
+					Message: "Could not reach the validation endpoint",
+				}
+				Expect(r.Validate()).To(Succeed())
+				Expect(r.IsSuccess()).To(BeFalse())
+				Expect(r.IsUnknown()).To(BeTrue())
+			})
 		})
 
 		Context("with invalid status", func() {
@@ -58,7 +69,7 @@ var _ = Describe("AdapterResult", func() {
 				}
 				err := r.Validate()
 				Expect(err).To(HaveOccurred())
-				Expect(err.Error()).To(ContainSubstring("must be either 'success' or 'failure'"))
+				Expect(err.Error()).To(ContainSubstring("must be one of 'success', 'failure' or 'unknown'"))
 			})
 		})
 
@@ -151,6 +162,86 @@ var _ = Describe("AdapterResult", func() {
 		})
 	})
 
+	Describe("Validate conditions", func() {
+		var r *result.AdapterResult
+
+		BeforeEach(func() {
+			r = &result.AdapterResult{
+				Status:  result.StatusSuccess,
+				Reason:  "AllChecksPassed",
+				Message: "All validation checks passed",
+				Conditions: []result.Condition{
+					{Type: "DNSReady", Status: "True", Reason: "DNSConfigured", Message: "DNS resolves"},
+					{Type: " QuotaAvailable ", Status: "False"},
+				},
+			}
+		})
+
+		It("accepts conditions and normalizes them", func() {
+			Expect(r.Validate()).To(Succeed())
+			Expect(r.Conditions[1]).To(Equal(result.Condition{
+				Type:    "QuotaAvailable",
+				Status:  "False",
+				Reason:  result.DefaultReason,
+				Message: result.DefaultMessage,
+			}))
+		})
+
+		It("returns error for a condition without a type", func() {
+			r.Conditions[0].Type = ""
+			Expect(r.Validate()).To(MatchError(ContainSubstring("conditions[0].type: is required")))
+		})
+
+		It("returns error for an invalid condition status", func() {
+			r.Conditions[1].Status = "false"
+			Expect(r.Validate()).To(MatchError(ContainSubstring("conditions[1].status: must be one of True, False, Unknown")))
+		})
+
+		It("returns error for duplicate condition types", func() {
+			r.Conditions[1].Type = "DNSReady"
+			Expect(r.Validate()).To(MatchError(ContainSubstring("duplicate condition type")))
+		})
+
+		It("returns error for a condition type owned by the Job controller", func() {
+			r.Conditions[0].Type = "Complete"
+			Expect(r.Validate()).To(MatchError(ContainSubstring(`conditions[0].type: "Complete" is reserved for the Job controller`)))
+		})
+	})
+
+	Describe("Validate correlation", func() {
+		var r *result.AdapterResult
+
+		BeforeEach(func() {
+			r = &result.AdapterResult{
+				Status:             result.StatusSuccess,
+				Reason:             "AllChecksPassed",
+				Message:            "ok",
+				CorrelationID:      " reconcile-7f3a ",
+				ObservedGeneration: 4,
+			}
+		})
+
+		It("accepts and trims the correlation ID", func() {
+			Expect(r.Validate()).To(Succeed())
+			Expect(r.CorrelationID).To(Equal("reconcile-7f3a"))
+		})
+
+		It("returns error for a correlation ID with whitespace", func() {
+			r.CorrelationID = "reconcile 7f3a"
+			Expect(r.Validate()).To(MatchError(ContainSubstring("correlationId: must not contain whitespace")))
+		})
+
+		It("returns error for a correlation ID that is too long", func() {
+			r.CorrelationID = strings.Repeat("a", result.MaxCorrelationIDLength+1)
+			Expect(r.Validate()).To(MatchError(ContainSubstring("correlationId: must be at most")))
+		})
+
+		It("returns error for a negative observed generation", func() {
+			r.ObservedGeneration = -1
+			Expect(r.Validate()).To(MatchError(ContainSubstring("observedGeneration: must not be negative")))
+		})
+	})
+
 	Describe("JSON marshaling", func() {
 		It("unmarshals basic success result", func() {
 			jsonData := `{"status":"success","reason":"TestPassed","message":"Test completed"}`
@@ -185,6 +276,53 @@ var _ = Describe("AdapterResult", func() {
 	})
 })
 
+var _ = Describe("Severity", func() {
+	It("normalizes a known severity", func() {
+		r := &result.AdapterResult{Status: result.StatusFailure, Severity: " High "}
+		Expect(r.Validate()).To(Succeed())
+		Expect(r.Severity).To(Equal(result.SeverityHigh))
+	})
+
+	It("keeps an unknown severity as written", func() {
+		r := &result.AdapterResult{Status: result.StatusFailure, Severity: "P2-Urgent"}
+		Expect(r.Validate()).To(Succeed())
+		Expect(r.Severity).To(Equal("P2-Urgent"))
+
+		_, ok := result.SeverityRank(r.Severity)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("ranks severities in ascending order", func() {
+		low, ok := result.SeverityRank(result.SeverityLow)
+		Expect(ok).To(BeTrue())
+		critical, ok := result.SeverityRank(result.SeverityCritical)
+		Expect(ok).To(BeTrue())
+		Expect(low).To(BeNumerically("<", critical))
+	})
+
+	It("falls back to the severity in the details", func() {
+		r := &result.AdapterResult{Status: result.StatusFailure, Details: json.RawMessage(`{"severity":"Medium"}`)}
+		Expect(r.EffectiveSeverity()).To(Equal(result.SeverityMedium))
+	})
+
+	It("prefers the top-level severity", func() {
+		r := &result.AdapterResult{Status: result.StatusFailure, Severity: result.SeverityLow, Details: json.RawMessage(`{"severity":"critical"}`)}
+		Expect(r.EffectiveSeverity()).To(Equal(result.SeverityLow))
+	})
+})
+
+var _ = Describe("FitMessage", func() {
+	It("appends the suffix to a short message", func() {
+		Expect(result.FitMessage("Adapter finished", " [status=True]")).To(Equal("Adapter finished [status=True]"))
+	})
+
+	It("truncates the message so the suffix fits within the max length", func() {
+		fitted := result.FitMessage(strings.Repeat("A", 2000), " [status=True]")
+		Expect(fitted).To(HaveLen(1024))
+		Expect(fitted).To(HaveSuffix(" [status=True]"))
+	})
+})
+
 var _ = Describe("ResultError", func() {
 	It("formats error message correctly", func() {
 		err := &result.ResultError{Field: "status", Message: "required"}
@@ -0,0 +1,47 @@
+package result
+
+import (
+	"strings"
+)
+
+// Severity levels an adapter may attach to a result, in ascending order
+const (
+	SeverityInfo     = "info"
+	SeverityLow      = "low"
+	SeverityMedium   = "medium"
+	SeverityHigh     = "high"
+	SeverityCritical = "critical"
+
+	// SeverityDetailsPointer locates the severity in the result details when the top-level field is unset
+	SeverityDetailsPointer = "/severity"
+)
+
+// Severities lists the known severity levels from least to most severe
+var Severities = []string{SeverityInfo, SeverityLow, SeverityMedium, SeverityHigh, SeverityCritical}
+
+// SeverityRank returns the position of the severity in Severities, or false if it is unknown
+func SeverityRank(severity string) (int, bool) {
+	severity = strings.ToLower(strings.TrimSpace(severity))
+	for i, s := range Severities {
+		if s == severity {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// EffectiveSeverity returns the result's severity, falling back to a string at
+// SeverityDetailsPointer in the details. It returns "" when neither is set.
+func (r *AdapterResult) EffectiveSeverity() string {
+	if r.Severity != "" {
+		return r.Severity
+	}
+	value, found, err := r.DetailsValue(SeverityDetailsPointer)
+	if err != nil || !found {
+		return ""
+	}
+	if s, ok := value.(string); ok {
+		return strings.ToLower(strings.TrimSpace(s))
+	}
+	return ""
+}
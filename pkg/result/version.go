@@ -0,0 +1,78 @@
+package result
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// APIVersionV1 is the current result schema version; results without an apiVersion are v1
+const APIVersionV1 = "adapter.hyperfleet.io/v1"
+
+// Converter rewrites a result payload of another schema version into the v1 JSON form
+type Converter func(data []byte) ([]byte, error)
+
+var (
+	convertersMu sync.RWMutex
+	converters   = map[string]Converter{}
+)
+
+// RegisterAPIVersion makes a result schema version parseable by converting its payloads to v1.
+// Registering an existing version replaces its converter.
+func RegisterAPIVersion(apiVersion string, convert Converter) {
+	convertersMu.Lock()
+	defer convertersMu.Unlock()
+	converters[apiVersion] = convert
+}
+
+// UnregisterAPIVersion removes a version added with RegisterAPIVersion
+func UnregisterAPIVersion(apiVersion string) {
+	convertersMu.Lock()
+	defer convertersMu.Unlock()
+	delete(converters, apiVersion)
+}
+
+// APIVersions returns the supported result schema versions, sorted
+func APIVersions() []string {
+	convertersMu.RLock()
+	defer convertersMu.RUnlock()
+	versions := []string{APIVersionV1}
+	for version := range converters {
+		versions = append(versions, version)
+	}
+	slices.Sort(versions)
+	return slices.Compact(versions)
+}
+
+// convertToV1 converts a JSON payload to the v1 schema according to its apiVersion and returns
+// the original version. An unknown version is an error rather than a best-effort v1 parse.
+func convertToV1(data []byte) ([]byte, string, error) {
+	var meta struct {
+		APIVersion string `json:"apiVersion"`
+	}
+	// Syntax errors are reported by the v1 parse
+	if err := json.Unmarshal(data, &meta); err != nil || meta.APIVersion == "" || meta.APIVersion == APIVersionV1 {
+		return data, meta.APIVersion, nil
+	}
+
+	convertersMu.RLock()
+	convert, ok := converters[meta.APIVersion]
+	convertersMu.RUnlock()
+	if !ok {
+		return nil, "", &ResultError{
+			Field:   "apiVersion",
+			Message: fmt.Sprintf("unsupported version %q (supported: %s)", meta.APIVersion, strings.Join(APIVersions(), ", ")),
+		}
+	}
+
+	converted, err := convert(data)
+	if err != nil {
+		return nil, "", &ResultError{
+			Field:   "apiVersion",
+			Message: fmt.Sprintf("failed to convert %s result: %v", meta.APIVersion, err),
+		}
+	}
+	return converted, meta.APIVersion, nil
+}
@@ -0,0 +1,83 @@
+// Package retry retries the delivery attempts of the outcome clients (callback, email, message
+// bus, object store, fleet manager) with a doubling delay.
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// DefaultInterval is the initial delay between attempts; it doubles after each failure
+const DefaultInterval = 1 * time.Second
+
+// Policy bounds the retries of an operation
+type Policy struct {
+	// MaxRetries is the number of additional attempts after the first failure
+	MaxRetries int
+
+	// Interval is the initial delay between attempts (DefaultInterval when zero)
+	Interval time.Duration
+}
+
+// permanentError marks an error that another attempt cannot fix
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks err as not retryable, e.g. a request the server rejected; nil stays nil
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether err must not be retried: it was marked with Permanent, or it is a
+// failure to read a file such as a mounted credential, which does not fix itself between attempts
+func IsPermanent(err error) bool {
+	var permanent *permanentError
+	var pathErr *os.PathError
+	return errors.As(err, &permanent) || errors.As(err, &pathErr)
+}
+
+// Do calls attempt until it succeeds, fails permanently, runs out of retries or ctx is done.
+// what names the operation in the returned error, e.g. "callback".
+func Do(ctx context.Context, what string, policy Policy, attempt func(ctx context.Context) error) error {
+	delay := policy.Interval
+	if delay <= 0 {
+		delay = DefaultInterval
+	}
+
+	for n := 1; ; n++ {
+		err := attempt(ctx)
+		if err == nil {
+			return nil
+		}
+		if permanent, ok := err.(*permanentError); ok {
+			return fmt.Errorf("%s failed after %d attempt(s): %w", what, n, permanent.err)
+		}
+		if IsPermanent(err) || n > policy.MaxRetries {
+			return fmt.Errorf("%s failed after %d attempt(s): %w", what, n, err)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%s cancelled: %w (last error: %v)", what, ctx.Err(), err)
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+}
@@ -0,0 +1,75 @@
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/retry"
+)
+
+var _ = Describe("Do", func() {
+	var (
+		ctx      context.Context
+		policy   retry.Policy
+		attempts int
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		policy = retry.Policy{MaxRetries: 2, Interval: time.Millisecond}
+		attempts = 0
+	})
+
+	failing := func(err error, failures int) func(context.Context) error {
+		return func(context.Context) error {
+			attempts++
+			if attempts <= failures {
+				return err
+			}
+			return nil
+		}
+	}
+
+	It("retries until the attempt succeeds", func() {
+		Expect(retry.Do(ctx, "upload", policy, failing(errors.New("unavailable"), 2))).To(Succeed())
+		Expect(attempts).To(Equal(3))
+	})
+
+	It("gives up once the retries are exhausted", func() {
+		err := retry.Do(ctx, "upload", policy, failing(errors.New("unavailable"), 5))
+		Expect(err).To(MatchError("upload failed after 3 attempt(s): unavailable"))
+		Expect(attempts).To(Equal(3))
+	})
+
+	It("does not retry a permanent error", func() {
+		rejected := errors.New("rejected")
+		err := retry.Do(ctx, "upload", policy, failing(retry.Permanent(rejected), 5))
+		Expect(err).To(MatchError("upload failed after 1 attempt(s): rejected"))
+		Expect(errors.Is(err, rejected)).To(BeTrue())
+		Expect(attempts).To(Equal(1))
+	})
+
+	It("does not retry a failure to read a file", func() {
+		_, readErr := os.ReadFile("/nonexistent/token")
+		err := retry.Do(ctx, "upload", policy, failing(fmt.Errorf("failed to read token: %w", readErr), 5))
+		Expect(err).To(MatchError(ContainSubstring("after 1 attempt(s)")))
+		Expect(attempts).To(Equal(1))
+	})
+
+	It("stops waiting when the context is cancelled", func() {
+		cancelled, cancel := context.WithCancel(ctx)
+		cancel()
+		policy.Interval = time.Hour
+
+		err := retry.Do(cancelled, "upload", policy, failing(errors.New("unavailable"), 5))
+		Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("last error: unavailable")))
+		Expect(attempts).To(Equal(1))
+	})
+})
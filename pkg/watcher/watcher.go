@@ -0,0 +1,270 @@
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+	"sync"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	batchlisters "k8s.io/client-go/listers/batch/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/reporter"
+)
+
+const (
+	// DefaultResyncPeriod is how often the informers replay their caches to catch missed events
+	DefaultResyncPeriod = 5 * time.Minute
+)
+
+// Config configures a JobWatcher
+type Config struct {
+	// Namespace is the namespace whose Jobs are watched
+	Namespace string
+
+	// LabelSelector selects the Jobs to report on
+	LabelSelector string
+
+	// ResultsPath is the result path convention; each Job's result is read from
+	// <dir(ResultsPath)>/<job-name>/<base(ResultsPath)>
+	ResultsPath string
+
+	ConditionType                string
+	AdapterContainerName         string
+	PollInterval                 time.Duration
+	MaxWaitTime                  time.Duration
+	ContainerStatusCheckInterval time.Duration
+
+	// ResyncPeriod is the informer resync period (DefaultResyncPeriod when zero)
+	ResyncPeriod time.Duration
+
+	// ReporterOptions builds the options of the reporter created for each Job; it is called once
+	// per Job so that reporters share no option state, such as sink clients or outcome hooks
+	ReporterOptions func() ([]reporter.Option, error)
+
+	// K8sClientOptions builds the options of the Kubernetes client created for each Job; like
+	// ReporterOptions it is called once per Job, so clients share no option state such as an audit log.
+	// Options that need a dynamic client (status target, JobSet roll-up, owner reporting) are not
+	// supported, since the per-Job clients are built on the watcher's clientset alone.
+	K8sClientOptions func() ([]k8s.ClientOption, error)
+}
+
+// JobWatcher discovers Jobs matching a label selector in a namespace and runs a status
+// reporter for each, reusing the per-Job reporting logic of the sidecar mode
+type JobWatcher struct {
+	clientset kubernetes.Interface
+	cfg       Config
+	selector  labels.Selector
+
+	jobLister batchlisters.JobLister
+	podLister corelisters.PodLister
+
+	mu      sync.Mutex
+	started map[string]bool
+	wg      sync.WaitGroup
+}
+
+// New creates a JobWatcher
+func New(clientset kubernetes.Interface, cfg Config) (*JobWatcher, error) {
+	selector, err := labels.Parse(cfg.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid job label selector %q: %w", cfg.LabelSelector, err)
+	}
+
+	if cfg.ResyncPeriod <= 0 {
+		cfg.ResyncPeriod = DefaultResyncPeriod
+	}
+	if cfg.ContainerStatusCheckInterval <= 0 {
+		cfg.ContainerStatusCheckInterval = reporter.DefaultContainerStatusCheckInterval
+	}
+
+	return &JobWatcher{
+		clientset: clientset,
+		cfg:       cfg,
+		selector:  selector,
+		started:   make(map[string]bool),
+	}, nil
+}
+
+// ResultsPathForJob returns the result file path for a Job under the results path convention
+func ResultsPathForJob(resultsPath, jobName string) string {
+	return filepath.Join(filepath.Dir(resultsPath), jobName, filepath.Base(resultsPath))
+}
+
+// Run watches Jobs and their pods until ctx is cancelled, then waits for in-flight reporters to finish
+func (w *JobWatcher) Run(ctx context.Context) error {
+	jobFactory := informers.NewSharedInformerFactoryWithOptions(w.clientset, w.cfg.ResyncPeriod,
+		informers.WithNamespace(w.cfg.Namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.LabelSelector = w.selector.String()
+		}))
+	// Job pods do not carry the Job's labels, so the pod informer only selects pods created by a Job
+	podFactory := informers.NewSharedInformerFactoryWithOptions(w.clientset, w.cfg.ResyncPeriod,
+		informers.WithNamespace(w.cfg.Namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.LabelSelector = batchv1.JobNameLabel
+		}))
+
+	jobInformer := jobFactory.Batch().V1().Jobs()
+	podInformer := podFactory.Core().V1().Pods()
+	w.jobLister = jobInformer.Lister()
+	w.podLister = podInformer.Lister()
+
+	if _, err := jobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj any) { w.handleJob(ctx, obj) },
+		UpdateFunc: func(_, obj any) { w.handleJob(ctx, obj) },
+		DeleteFunc: w.forgetJob,
+	}); err != nil {
+		return fmt.Errorf("failed to add job event handler: %w", err)
+	}
+	if _, err := podInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj any) { w.handlePod(ctx, obj) },
+		UpdateFunc: func(_, obj any) { w.handlePod(ctx, obj) },
+	}); err != nil {
+		return fmt.Errorf("failed to add pod event handler: %w", err)
+	}
+
+	log.Printf("Watching Jobs in namespace=%s selector=%q", w.cfg.Namespace, w.selector.String())
+
+	jobFactory.Start(ctx.Done())
+	podFactory.Start(ctx.Done())
+	defer jobFactory.Shutdown()
+	defer podFactory.Shutdown()
+
+	for _, factory := range []informers.SharedInformerFactory{jobFactory, podFactory} {
+		for informerType, synced := range factory.WaitForCacheSync(ctx.Done()) {
+			// An unsynced cache after cancellation is part of a normal shutdown
+			if !synced && ctx.Err() == nil {
+				return fmt.Errorf("failed to sync %v informer cache", informerType)
+			}
+		}
+	}
+
+	<-ctx.Done()
+	log.Printf("Job watcher stopping; waiting for in-flight reporters")
+	w.wg.Wait()
+	return nil
+}
+
+// handleJob starts reporting for a Job whose pod was seen before the Job itself
+func (w *JobWatcher) handleJob(ctx context.Context, obj any) {
+	job, ok := obj.(*batchv1.Job)
+	if !ok {
+		return
+	}
+
+	pods, err := w.podLister.Pods(job.Namespace).List(labels.SelectorFromSet(labels.Set{batchv1.JobNameLabel: job.Name}))
+	if err != nil {
+		log.Printf("Warning: failed to list pods for job %s/%s: %v", job.Namespace, job.Name, err)
+		return
+	}
+	for _, pod := range pods {
+		if owner := metav1.GetControllerOf(pod); owner != nil && owner.UID == job.UID {
+			w.maybeStart(ctx, job, pod)
+			return
+		}
+	}
+}
+
+// handlePod starts reporting for the Job owning the pod, if that Job is watched
+func (w *JobWatcher) handlePod(ctx context.Context, obj any) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return
+	}
+
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != "Job" {
+		return
+	}
+
+	job, err := w.jobLister.Jobs(pod.Namespace).Get(owner.Name)
+	if err != nil || job.UID != owner.UID {
+		// Not a watched Job (or not in the cache yet; handleJob covers that case)
+		return
+	}
+
+	w.maybeStart(ctx, job, pod)
+}
+
+// maybeStart runs a reporter for the Job unless one was already started or the Job already
+// carries a terminal reported condition (for example, after the watcher restarted). An Unknown
+// condition is progress of a run that was interrupted, so that Job is reported on again.
+func (w *JobWatcher) maybeStart(ctx context.Context, job *batchv1.Job, pod *corev1.Pod) {
+	for _, condition := range job.Status.Conditions {
+		if string(condition.Type) == w.cfg.ConditionType && condition.Status != corev1.ConditionUnknown {
+			return
+		}
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.started[job.Name] || ctx.Err() != nil {
+		return
+	}
+	w.started[job.Name] = true
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		w.report(ctx, job.Name, pod.Name)
+	}()
+}
+
+// forgetJob allows a recreated Job with the same name to be reported on again
+func (w *JobWatcher) forgetJob(obj any) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	job, ok := obj.(*batchv1.Job)
+	if !ok {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.started, job.Name)
+}
+
+// report runs the per-Job status reporter
+func (w *JobWatcher) report(ctx context.Context, jobName, podName string) {
+	resultsPath := ResultsPathForJob(w.cfg.ResultsPath, jobName)
+	log.Printf("Reporting for job=%s/%s pod=%s results=%s", w.cfg.Namespace, jobName, podName, resultsPath)
+
+	opts := []reporter.Option{reporter.WithJobReference(jobName, w.cfg.Namespace)}
+	if w.cfg.ReporterOptions != nil {
+		jobOpts, err := w.cfg.ReporterOptions()
+		if err != nil {
+			log.Printf("Failed to configure reporter for job=%s/%s: %v", w.cfg.Namespace, jobName, err)
+			return
+		}
+		opts = append(opts, jobOpts...)
+	}
+	var clientOpts []k8s.ClientOption
+	if w.cfg.K8sClientOptions != nil {
+		var err error
+		if clientOpts, err = w.cfg.K8sClientOptions(); err != nil {
+			log.Printf("Failed to configure Kubernetes client for job=%s/%s: %v", w.cfg.Namespace, jobName, err)
+			return
+		}
+	}
+	client := k8s.NewClientWithClientset(w.clientset, w.cfg.Namespace, jobName, clientOpts...)
+	rep := reporter.NewReporterWithClientAndIntervals(resultsPath, w.cfg.PollInterval, w.cfg.MaxWaitTime,
+		w.cfg.ContainerStatusCheckInterval, w.cfg.ConditionType, podName, w.cfg.AdapterContainerName, client, opts...)
+
+	if err := rep.Run(ctx); err != nil {
+		log.Printf("Reporter for job=%s/%s finished with error: %v", w.cfg.Namespace, jobName, err)
+		return
+	}
+	log.Printf("Reporter for job=%s/%s finished successfully", w.cfg.Namespace, jobName)
+}
@@ -0,0 +1,219 @@
+package watcher_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/k8s"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/reporter"
+	"github.com/openshift-hyperfleet/status-reporter/pkg/watcher"
+)
+
+var _ = Describe("ResultsPathForJob", func() {
+	It("places the result file in a per-Job directory", func() {
+		Expect(watcher.ResultsPathForJob("/results/adapter-result.json", "job-a")).To(Equal("/results/job-a/adapter-result.json"))
+	})
+})
+
+var _ = Describe("JobWatcher", func() {
+	const namespace = "test-ns"
+
+	var (
+		ctx        context.Context
+		cancel     context.CancelFunc
+		clientset  *fake.Clientset
+		resultsDir string
+		runErr     chan error
+
+		clientOptionCalls atomic.Int32
+	)
+
+	newJob := func(name string, labels map[string]string) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, UID: types.UID(name + "-uid"), Labels: labels},
+		}
+	}
+
+	newPod := func(job *batchv1.Job, state corev1.ContainerState) *corev1.Pod {
+		controller := true
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      job.Name + "-pod",
+				Namespace: namespace,
+				Labels:    map[string]string{batchv1.JobNameLabel: job.Name},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "batch/v1", Kind: "Job", Name: job.Name, UID: job.UID, Controller: &controller,
+				}},
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{Name: "adapter", State: state}},
+			},
+		}
+	}
+
+	jobCondition := func(name string) func() *batchv1.JobCondition {
+		return func() *batchv1.JobCondition {
+			job, err := clientset.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			for i := range job.Status.Conditions {
+				if job.Status.Conditions[i].Type == "Available" {
+					return &job.Status.Conditions[i]
+				}
+			}
+			return nil
+		}
+	}
+
+	startWatcher := func() {
+		w, err := watcher.New(clientset, watcher.Config{
+			Namespace:                    namespace,
+			LabelSelector:                "hyperfleet.io/status-reporter=true",
+			ResultsPath:                  filepath.Join(resultsDir, "adapter-result.json"),
+			ConditionType:                "Available",
+			PollInterval:                 20 * time.Millisecond,
+			MaxWaitTime:                  5 * time.Second,
+			ContainerStatusCheckInterval: 50 * time.Millisecond,
+			ReporterOptions: func() ([]reporter.Option, error) {
+				return []reporter.Option{reporter.WithQuietStartup(true)}, nil
+			},
+			K8sClientOptions: func() ([]k8s.ClientOption, error) {
+				clientOptionCalls.Add(1)
+				return []k8s.ClientOption{k8s.WithRunID("")}, nil
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		runErr = make(chan error, 1)
+		go func() { runErr <- w.Run(ctx) }()
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		resultsDir = GinkgoT().TempDir()
+		clientset = fake.NewClientset()
+		clientOptionCalls.Store(0)
+	})
+
+	AfterEach(func() {
+		cancel()
+		Eventually(runErr, 10*time.Second).Should(Receive(BeNil()))
+	})
+
+	It("reports the result of each labelled Job from its per-Job result file", func() {
+		job := newJob("job-a", map[string]string{"hyperfleet.io/status-reporter": "true"})
+		Expect(os.MkdirAll(filepath.Join(resultsDir, "job-a"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(resultsDir, "job-a", "adapter-result.json"),
+			[]byte(`{"status":"success","reason":"AllChecksPassed","message":"ok"}`), 0644)).To(Succeed())
+		_, err := clientset.BatchV1().Jobs(namespace).Create(ctx, job, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = clientset.CoreV1().Pods(namespace).Create(ctx, newPod(job, corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}), metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		startWatcher()
+
+		Eventually(jobCondition("job-a"), 5*time.Second, 20*time.Millisecond).ShouldNot(BeNil())
+		Expect(jobCondition("job-a")().Reason).To(Equal("AllChecksPassed"))
+	})
+
+	It("reports on a Job whose pod appears after the watcher started", func() {
+		startWatcher()
+
+		job := newJob("job-b", map[string]string{"hyperfleet.io/status-reporter": "true"})
+		_, err := clientset.BatchV1().Jobs(namespace).Create(ctx, job, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		terminated := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}}
+		_, err = clientset.CoreV1().Pods(namespace).Create(ctx, newPod(job, terminated), metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(jobCondition("job-b"), 5*time.Second, 20*time.Millisecond).ShouldNot(BeNil())
+		Expect(jobCondition("job-b")().Reason).To(Equal(reporter.ReasonAdapterExitedWithError))
+	})
+
+	It("builds the Kubernetes client options of each Job separately", func() {
+		startWatcher()
+
+		terminated := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}}
+		for _, name := range []string{"job-d", "job-e"} {
+			job := newJob(name, map[string]string{"hyperfleet.io/status-reporter": "true"})
+			_, err := clientset.BatchV1().Jobs(namespace).Create(ctx, job, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = clientset.CoreV1().Pods(namespace).Create(ctx, newPod(job, terminated), metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		Eventually(jobCondition("job-d"), 5*time.Second, 20*time.Millisecond).ShouldNot(BeNil())
+		Eventually(jobCondition("job-e"), 5*time.Second, 20*time.Millisecond).ShouldNot(BeNil())
+		Expect(clientOptionCalls.Load()).To(BeEquivalentTo(2))
+	})
+
+	Context("after a restart", func() {
+		createWithCondition := func(name string, status corev1.ConditionStatus) {
+			job := newJob(name, map[string]string{"hyperfleet.io/status-reporter": "true"})
+			job.Status.Conditions = []batchv1.JobCondition{{Type: "Available", Status: status, Reason: "Previous"}}
+			_, err := clientset.BatchV1().Jobs(namespace).Create(ctx, job, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			terminated := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}}
+			_, err = clientset.CoreV1().Pods(namespace).Create(ctx, newPod(job, terminated), metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		It("reports on a Job whose condition is still in progress", func() {
+			createWithCondition("job-f", corev1.ConditionUnknown)
+
+			startWatcher()
+
+			Eventually(func() string { return jobCondition("job-f")().Reason }, 5*time.Second, 20*time.Millisecond).
+				Should(Equal(reporter.ReasonAdapterExitedWithError))
+			Expect(jobCondition("job-f")().Status).To(Equal(corev1.ConditionFalse))
+		})
+
+		It("leaves a Job with a terminal condition alone", func() {
+			createWithCondition("job-g", corev1.ConditionTrue)
+
+			startWatcher()
+
+			Consistently(func() string { return jobCondition("job-g")().Reason }, 300*time.Millisecond, 50*time.Millisecond).
+				Should(Equal("Previous"))
+		})
+	})
+
+	It("ignores Jobs that do not match the label selector", func() {
+		job := newJob("job-c", nil)
+		_, err := clientset.BatchV1().Jobs(namespace).Create(ctx, job, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		terminated := corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}}
+		_, err = clientset.CoreV1().Pods(namespace).Create(ctx, newPod(job, terminated), metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		startWatcher()
+
+		Consistently(jobCondition("job-c"), 300*time.Millisecond, 50*time.Millisecond).Should(BeNil())
+	})
+
+	It("lists only pods created by a Job", func() {
+		startWatcher()
+
+		podSelectors := func() []string {
+			var selectors []string
+			for _, action := range clientset.Actions() {
+				if list, ok := action.(k8stesting.ListAction); ok && action.GetResource().Resource == "pods" {
+					selectors = append(selectors, list.GetListRestrictions().Labels.String())
+				}
+			}
+			return selectors
+		}
+		Eventually(podSelectors, 5*time.Second, 20*time.Millisecond).Should(ConsistOf(batchv1.JobNameLabel))
+	})
+})
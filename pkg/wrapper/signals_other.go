@@ -0,0 +1,10 @@
+//go:build !unix
+
+package wrapper
+
+import (
+	"os"
+)
+
+// forwardedSignals are relayed to the adapter process
+var forwardedSignals = []os.Signal{os.Interrupt}
@@ -0,0 +1,11 @@
+//go:build unix
+
+package wrapper
+
+import (
+	"os"
+	"syscall"
+)
+
+// forwardedSignals are relayed to the adapter process
+var forwardedSignals = []os.Signal{syscall.SIGTERM, syscall.SIGINT, syscall.SIGHUP, syscall.SIGQUIT, syscall.SIGUSR1, syscall.SIGUSR2}
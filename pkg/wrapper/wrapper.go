@@ -0,0 +1,106 @@
+// Package wrapper runs the adapter as a child process of the status reporter, for deployments
+// without a sidecar and shared volume.
+package wrapper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+// Exit describes how the adapter process ended
+type Exit struct {
+	// Code is the exit code, or 128 plus the signal number when the process was killed by a signal
+	Code int
+
+	// Reason summarizes the exit, e.g. "exit status 1" or "signal: killed"
+	Reason string
+
+	// Output is the end of the process's stdout, at most the output limit
+	Output []byte
+}
+
+// Run starts command and waits for it to exit. Its stdout is copied to stdout and captured, up to
+// the last outputLimit bytes; its stderr is copied to stderr. Signals sent to the reporter that
+// would normally end it are forwarded to the process instead, so its exit still ends the run.
+// When ctx is done the process is killed. An error is returned only when the process could not
+// be started or waited for.
+func Run(ctx context.Context, command []string, stdout, stderr io.Writer, outputLimit int) (*Exit, error) {
+	if len(command) == 0 {
+		return nil, errors.New("no adapter command given")
+	}
+
+	output := &tailBuffer{limit: outputLimit}
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = io.MultiWriter(stdout, output)
+	cmd.Stderr = stderr
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, forwardedSignals...)
+	defer signal.Stop(signals)
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start adapter command %q: %w", command[0], err)
+	}
+
+	forwardDone := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case sig := <-signals:
+				_ = cmd.Process.Signal(sig)
+			case <-forwardDone:
+				return
+			}
+		}
+	}()
+
+	err := cmd.Wait()
+	close(forwardDone)
+	wg.Wait()
+
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		return nil, fmt.Errorf("failed to wait for adapter command %q: %w", command[0], err)
+	}
+
+	exit := &Exit{Code: cmd.ProcessState.ExitCode(), Reason: cmd.ProcessState.String(), Output: output.Bytes()}
+	if status, ok := cmd.ProcessState.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		exit.Code = 128 + int(status.Signal())
+	}
+	return exit, nil
+}
+
+// tailBuffer keeps the last limit bytes written to it
+type tailBuffer struct {
+	mu    sync.Mutex
+	limit int
+	data  []byte
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.data = append(b.data, p...)
+	if excess := len(b.data) - b.limit; excess > 0 {
+		b.data = append(b.data[:0], b.data[excess:]...)
+	}
+	return len(p), nil
+}
+
+// Bytes returns a copy of the buffered bytes
+func (b *tailBuffer) Bytes() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]byte(nil), b.data...)
+}
@@ -0,0 +1,94 @@
+//go:build unix
+
+package wrapper_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"syscall"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-hyperfleet/status-reporter/pkg/wrapper"
+)
+
+// notifyWriter closes ready on the first write, so a test can wait for the process to start
+type notifyWriter struct {
+	bytes.Buffer
+	once  sync.Once
+	ready chan struct{}
+}
+
+func (w *notifyWriter) Write(p []byte) (int, error) {
+	w.once.Do(func() { close(w.ready) })
+	return w.Buffer.Write(p)
+}
+
+var _ = Describe("Run", func() {
+	var stdout, stderr bytes.Buffer
+
+	BeforeEach(func() {
+		stdout.Reset()
+		stderr.Reset()
+	})
+
+	It("passes the output through and captures stdout", func() {
+		exit, err := wrapper.Run(context.Background(), []string{"sh", "-c", `echo starting; echo oops >&2; echo '{"status":"success"}'`}, &stdout, &stderr, 1024)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exit.Code).To(Equal(0))
+		Expect(string(exit.Output)).To(Equal("starting\n{\"status\":\"success\"}\n"))
+		Expect(stdout.String()).To(Equal(string(exit.Output)))
+		Expect(stderr.String()).To(Equal("oops\n"))
+	})
+
+	It("keeps only the end of a long output", func() {
+		exit, err := wrapper.Run(context.Background(), []string{"sh", "-c", "echo 0123456789abcdef"}, &stdout, &stderr, 8)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(exit.Output)).To(Equal("9abcdef\n"))
+	})
+
+	It("reports the exit code", func() {
+		exit, err := wrapper.Run(context.Background(), []string{"sh", "-c", "exit 3"}, &stdout, &stderr, 1024)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exit.Code).To(Equal(3))
+		Expect(exit.Reason).To(Equal("exit status 3"))
+	})
+
+	It("reports a process killed by a signal like a container runtime", func() {
+		exit, err := wrapper.Run(context.Background(), []string{"sh", "-c", "kill -KILL $$"}, &stdout, &stderr, 1024)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exit.Code).To(Equal(137))
+		Expect(exit.Reason).To(Equal("signal: killed"))
+	})
+
+	It("forwards signals sent to the reporter", func() {
+		out := &notifyWriter{ready: make(chan struct{})}
+		done := make(chan *wrapper.Exit, 1)
+		go func() {
+			defer GinkgoRecover()
+			exit, err := wrapper.Run(context.Background(), []string{"sh", "-c", `trap 'echo stopping; exit 42' USR2; echo ready; while :; do sleep 0.05; done`}, out, &stderr, 1024)
+			Expect(err).NotTo(HaveOccurred())
+			done <- exit
+		}()
+
+		// The test runner handles SIGTERM itself, so another forwarded signal stands in for it
+		Eventually(out.ready).Should(BeClosed())
+		Expect(syscall.Kill(syscall.Getpid(), syscall.SIGUSR2)).To(Succeed())
+
+		var exit *wrapper.Exit
+		Eventually(done, "5s").Should(Receive(&exit))
+		Expect(exit.Code).To(Equal(42))
+		Expect(strings.Fields(string(exit.Output))).To(Equal([]string{"ready", "stopping"}))
+	})
+
+	It("returns an error when the command cannot be started", func() {
+		_, err := wrapper.Run(context.Background(), []string{"/nonexistent/adapter"}, &stdout, &stderr, 1024)
+		Expect(err).To(MatchError(ContainSubstring("failed to start adapter command")))
+
+		_, err = wrapper.Run(context.Background(), nil, &stdout, &stderr, 1024)
+		Expect(err).To(MatchError("no adapter command given"))
+	})
+})